@@ -5,7 +5,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam"
 )
 
+// arnString builds an ARN. If partition is empty, the partition of region is
+// used.
 func arnString(partition, region, service, accountId, resource string) string {
+	if partition == "" {
+		partition = partitionIDForRegion(region)
+	}
+
 	return arn.ARN{
 		Partition: partition,
 		Region:    region,
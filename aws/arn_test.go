@@ -11,3 +11,11 @@ func TestArn_iamRootUser(t *testing.T) {
 		t.Fatalf("Expected ARN: %s, got: %s", expectedArn, arn)
 	}
 }
+
+func TestArn_partitionFromRegion(t *testing.T) {
+	arn := arnString("", "cn-north-1", "ec2", "1234567890", "vpc/vpc-12345678")
+	expected := "arn:aws-cn:ec2:cn-north-1:1234567890:vpc/vpc-12345678"
+	if arn != expected {
+		t.Fatalf("Expected ARN: %s, got: %s", expected, arn)
+	}
+}
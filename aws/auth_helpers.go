@@ -1,10 +1,17 @@
 package aws
 
 import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -12,15 +19,22 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/processcreds"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sso"
+	"github.com/aws/aws-sdk-go/service/sso/ssoiface"
 	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/go-ini/ini"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-multierror"
+	homedir "github.com/mitchellh/go-homedir"
 )
 
 func GetAccountInfo(iamconn *iam.IAM, stsconn *sts.STS, authProviderName string) (string, string, error) {
@@ -123,6 +137,19 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 		},
 	}
 
+	// Profiles may also source credentials from an external process or SSO
+	profile, err := sharedConfigProfile(c)
+	if err != nil {
+		return nil, err
+	}
+	sharedConfigProvider, err := sharedConfigCredentialsProvider(profile)
+	if err != nil {
+		return nil, err
+	}
+	if sharedConfigProvider != nil {
+		providers = append(providers, sharedConfigProvider)
+	}
+
 	// Build isolated HTTP client to avoid issues with globally-shared settings
 	client := cleanhttp.DefaultClient()
 
@@ -176,30 +203,50 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 		}
 	}
 
+	creds := awsCredentials.NewChainCredentials(providers)
+
 	// This is the "normal" flow (i.e. not assuming a role)
-	if c.AssumeRoleARN == "" {
-		return awsCredentials.NewChainCredentials(providers), nil
+	if c.AssumeRoleWithWebIdentity == nil && len(c.AssumeRoles) == 0 {
+		return creds, nil
 	}
 
-	// Otherwise we need to construct and STS client with the main credentials, and verify
-	// that we can assume the defined role.
-	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q)",
-		c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID, c.AssumeRolePolicy)
-
-	creds := awsCredentials.NewChainCredentials(providers)
-	cp, err := creds.Get()
-	if err != nil {
-		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
-			return nil, errors.New(`No valid credential sources found for AWS Provider.
+	if c.AssumeRoleWithWebIdentity != nil {
+		// The web identity token replaces the main credentials
+		creds, err = getWebIdentityCredentials(c, c.AssumeRoleWithWebIdentity)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Otherwise we need to construct an STS client with the main credentials,
+		// and verify that we can assume the defined roles.
+		cp, err := creds.Get()
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
+				return nil, errors.New(`No valid credential sources found for AWS Provider.
   Please see https://terraform.io/docs/providers/aws/index.html for more information on
   providing credentials for the AWS Provider`)
+			}
+
+			return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
 		}
 
-		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
+		log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
+	}
+
+	// Each role is assumed with the credentials of the previous one
+	for _, role := range c.AssumeRoles {
+		creds, err = getAssumeRoleCredentials(c, creds, role)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
+	return creds, nil
+}
 
+// stsConfig returns the configuration of the STS clients used to assume
+// roles with the given credentials.
+func (c *Config) stsConfig(creds *awsCredentials.Credentials) *aws.Config {
 	awsConfig := &aws.Config{
 		Credentials:      creds,
 		Region:           aws.String(c.Region),
@@ -207,26 +254,60 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 		HTTPClient:       cleanhttp.DefaultClient(),
 		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
 	}
+	if endpoint := c.Endpoints["sts"]; endpoint != "" {
+		awsConfig.Endpoint = aws.String(endpoint)
+	}
+	return awsConfig
+}
+
+func getAssumeRoleCredentials(c *Config, creds *awsCredentials.Credentials, role *AssumeRole) (*awsCredentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q, PolicyARNs: %q, Duration: %ds)",
+		role.RoleARN, role.SessionName, role.ExternalID, role.Policy, role.PolicyARNs, role.DurationSeconds)
+
+	stsclient := sts.New(session.New(c.stsConfig(creds)))
 
-	stsclient := sts.New(session.New(awsConfig))
 	assumeRoleProvider := &stscreds.AssumeRoleProvider{
 		Client:  stsclient,
-		RoleARN: c.AssumeRoleARN,
+		RoleARN: role.RoleARN,
 	}
-	if c.AssumeRoleSessionName != "" {
-		assumeRoleProvider.RoleSessionName = c.AssumeRoleSessionName
+	if role.SessionName != "" {
+		assumeRoleProvider.RoleSessionName = role.SessionName
 	}
-	if c.AssumeRoleExternalID != "" {
-		assumeRoleProvider.ExternalID = aws.String(c.AssumeRoleExternalID)
+	if role.ExternalID != "" {
+		assumeRoleProvider.ExternalID = aws.String(role.ExternalID)
 	}
-	if c.AssumeRolePolicy != "" {
-		assumeRoleProvider.Policy = aws.String(c.AssumeRolePolicy)
+	if role.Policy != "" {
+		assumeRoleProvider.Policy = aws.String(role.Policy)
+	}
+	if role.DurationSeconds > 0 {
+		assumeRoleProvider.Duration = time.Duration(role.DurationSeconds) * time.Second
+	}
+	for _, arn := range role.PolicyARNs {
+		assumeRoleProvider.PolicyArns = append(assumeRoleProvider.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(arn),
+		})
 	}
 
-	providers = []awsCredentials.Provider{assumeRoleProvider}
+	keys := make([]string, 0, len(role.Tags))
+	for k := range role.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		assumeRoleProvider.Tags = append(assumeRoleProvider.Tags, &sts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(role.Tags[k]),
+		})
+	}
+
+	if len(role.TransitiveTagKeys) > 0 {
+		assumeRoleProvider.TransitiveTagKeys = aws.StringSlice(role.TransitiveTagKeys)
+	}
+
+	providers := []awsCredentials.Provider{assumeRoleProvider}
 
 	assumeRoleCreds := awsCredentials.NewChainCredentials(providers)
-	_, err = assumeRoleCreds.Get()
+	_, err := assumeRoleCreds.Get()
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
 			return nil, fmt.Errorf("The role %q cannot be assumed.\n\n"+
@@ -234,7 +315,7 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 				"    * The credentials used in order to assume the role are invalid\n"+
 				"    * The credentials do not have appropriate permission to assume the role\n"+
 				"    * The role ARN is not valid",
-				c.AssumeRoleARN)
+				role.RoleARN)
 		}
 
 		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
@@ -243,6 +324,261 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 	return assumeRoleCreds, nil
 }
 
+func getWebIdentityCredentials(c *Config, role *AssumeRoleWithWebIdentity) (*awsCredentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q, TokenFile: %q, Policy: %q, Duration: %ds)",
+		role.RoleARN, role.SessionName, role.WebIdentityTokenFile, role.Policy, role.DurationSeconds)
+
+	sessionName := role.SessionName
+	if sessionName == "" {
+		sessionName = fmt.Sprintf("terraform-%d", time.Now().UnixNano())
+	}
+
+	// AssumeRoleWithWebIdentity requests are not signed
+	client := sts.New(session.New(c.stsConfig(awsCredentials.AnonymousCredentials)))
+	provider := stscreds.NewWebIdentityRoleProviderWithToken(&webIdentitySTS{
+		STSAPI:   client,
+		policy:   role.Policy,
+		duration: role.DurationSeconds,
+	}, role.RoleARN, sessionName, webIdentityTokenFile(role.WebIdentityTokenFile))
+	provider.ExpiryWindow = 10 * time.Second
+
+	creds := awsCredentials.NewCredentials(provider)
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("Error assuming role %q with web identity: %s", role.RoleARN, err)
+	}
+
+	return creds, nil
+}
+
+// webIdentityTokenFile is read again on every refresh, as web identity
+// tokens are usually rotated by the platform that provides them.
+type webIdentityTokenFile string
+
+func (f webIdentityTokenFile) FetchToken(ctx awsCredentials.Context) ([]byte, error) {
+	token, err := stscreds.FetchTokenPath(f).FetchToken(ctx)
+	return bytes.TrimSpace(token), err
+}
+
+// webIdentitySTS adds the session policy and duration, which the SDK's web
+// identity provider does not set, to its AssumeRoleWithWebIdentity requests.
+type webIdentitySTS struct {
+	stsiface.STSAPI
+
+	policy   string
+	duration int
+}
+
+func (c *webIdentitySTS) AssumeRoleWithWebIdentityRequest(input *sts.AssumeRoleWithWebIdentityInput) (*request.Request, *sts.AssumeRoleWithWebIdentityOutput) {
+	if c.policy != "" {
+		input.Policy = aws.String(c.policy)
+	}
+	if c.duration > 0 {
+		input.DurationSeconds = aws.Int64(int64(c.duration))
+	}
+	return c.STSAPI.AssumeRoleWithWebIdentityRequest(input)
+}
+
+// sharedConfigProfile returns the settings of the selected profile, merged
+// from the shared config files and the shared credentials file in the same
+// order of precedence as the AWS CLI. It returns nil if the profile is not
+// defined in any of these files.
+func sharedConfigProfile(c *Config) (map[string]string, error) {
+	profile := c.Profile
+	if profile == "" {
+		profile = os.Getenv("AWS_PROFILE")
+	}
+	if profile == "" {
+		profile = "default"
+	}
+
+	configFiles := c.SharedConfigFiles
+	if len(configFiles) == 0 {
+		configFile := os.Getenv("AWS_CONFIG_FILE")
+		if configFile == "" {
+			configFile = "~/.aws/config"
+		}
+		configFiles = []string{configFile}
+	}
+
+	credsFile := c.CredsFilename
+	if credsFile == "" {
+		credsFile = os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	}
+	if credsFile == "" {
+		credsFile = "~/.aws/credentials"
+	}
+
+	// Profiles are named "profile NAME" in config files, except for the
+	// default one, and just "NAME" in the credentials file
+	configSection := "profile " + profile
+	if profile == "default" {
+		configSection = profile
+	}
+
+	var settings map[string]string
+	merge := func(filename, section string) error {
+		path, err := homedir.Expand(filename)
+		if err != nil {
+			return err
+		}
+		f, err := ini.LooseLoad(path)
+		if err != nil {
+			return fmt.Errorf("Error loading shared config file %q: %s", path, err)
+		}
+		s, err := f.GetSection(section)
+		if err != nil {
+			return nil
+		}
+		if settings == nil {
+			settings = make(map[string]string)
+		}
+		for k, v := range s.KeysHash() {
+			settings[k] = v
+		}
+		return nil
+	}
+
+	for _, configFile := range configFiles {
+		if err := merge(configFile, configSection); err != nil {
+			return nil, err
+		}
+	}
+	if err := merge(credsFile, profile); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// sharedConfigCredentialsProvider returns the provider for profiles sourcing
+// their credentials from an external process or from an SSO session, or nil
+// if the profile does neither.
+func sharedConfigCredentialsProvider(profile map[string]string) (awsCredentials.Provider, error) {
+	if command := profile["credential_process"]; command != "" {
+		var provider *processcreds.ProcessProvider
+		processcreds.NewCredentials(command, func(p *processcreds.ProcessProvider) {
+			p.ExpiryWindow = 10 * time.Second
+			provider = p
+		})
+		return provider, nil
+	}
+
+	if startURL := profile["sso_start_url"]; startURL != "" {
+		var missing []string
+		for _, k := range []string{"sso_region", "sso_account_id", "sso_role_name"} {
+			if profile[k] == "" {
+				missing = append(missing, k)
+			}
+		}
+		if len(missing) > 0 {
+			return nil, fmt.Errorf("SSO profile is missing required settings: %s", strings.Join(missing, ", "))
+		}
+
+		cacheDir, err := homedir.Expand("~/.aws/sso/cache")
+		if err != nil {
+			return nil, err
+		}
+
+		sess, err := session.NewSession(&aws.Config{
+			Region:     aws.String(profile["sso_region"]),
+			HTTPClient: cleanhttp.DefaultClient(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &ssoCredentialsProvider{
+			Client:    sso.New(sess),
+			StartURL:  startURL,
+			AccountID: profile["sso_account_id"],
+			RoleName:  profile["sso_role_name"],
+			CacheDir:  cacheDir,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+const ssoCredentialsProviderName = "SSOProvider"
+
+// ssoCredentialsProvider retrieves role credentials from the SSO portal
+// using the access token cached by `aws sso login`.
+type ssoCredentialsProvider struct {
+	awsCredentials.Expiry
+
+	Client    ssoiface.SSOAPI
+	StartURL  string
+	AccountID string
+	RoleName  string
+	CacheDir  string
+}
+
+type ssoCachedToken struct {
+	AccessToken string `json:"accessToken"`
+	ExpiresAt   string `json:"expiresAt"`
+}
+
+// ssoTokenFilename returns the name of the file the AWS CLI caches the
+// access token for the given start URL in.
+func ssoTokenFilename(startURL string) string {
+	hash := sha1.Sum([]byte(startURL))
+	return hex.EncodeToString(hash[:]) + ".json"
+}
+
+func (p *ssoCredentialsProvider) Retrieve() (awsCredentials.Value, error) {
+	token, err := p.cachedToken()
+	if err != nil {
+		log.Printf("[WARN] %s", err)
+		return awsCredentials.Value{ProviderName: ssoCredentialsProviderName}, err
+	}
+
+	resp, err := p.Client.GetRoleCredentials(&sso.GetRoleCredentialsInput{
+		AccessToken: aws.String(token),
+		AccountId:   aws.String(p.AccountID),
+		RoleName:    aws.String(p.RoleName),
+	})
+	if err != nil {
+		log.Printf("[WARN] Error getting SSO role credentials for %s/%s: %s", p.AccountID, p.RoleName, err)
+		return awsCredentials.Value{ProviderName: ssoCredentialsProviderName},
+			fmt.Errorf("Error getting SSO role credentials: %s", err)
+	}
+
+	// The expiration is given in milliseconds since the epoch
+	expiration := time.Unix(0, aws.Int64Value(resp.RoleCredentials.Expiration)*int64(time.Millisecond))
+	p.SetExpiration(expiration, 10*time.Second)
+
+	return awsCredentials.Value{
+		AccessKeyID:     aws.StringValue(resp.RoleCredentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(resp.RoleCredentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(resp.RoleCredentials.SessionToken),
+		ProviderName:    ssoCredentialsProviderName,
+	}, nil
+}
+
+func (p *ssoCredentialsProvider) cachedToken() (string, error) {
+	filename := filepath.Join(p.CacheDir, ssoTokenFilename(p.StartURL))
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", fmt.Errorf("Error reading cached SSO token for %s, run `aws sso login` to sign in: %s", p.StartURL, err)
+	}
+
+	var token ssoCachedToken
+	if err := json.Unmarshal(b, &token); err != nil {
+		return "", fmt.Errorf("Error parsing cached SSO token %q: %s", filename, err)
+	}
+
+	// Older versions of the AWS CLI wrote a "UTC" suffix instead of "Z"
+	expiresAt, err := time.Parse(time.RFC3339, strings.Replace(token.ExpiresAt, "UTC", "Z", 1))
+	if err != nil {
+		return "", fmt.Errorf("Error parsing expiration of cached SSO token %q: %s", filename, err)
+	}
+	if !expiresAt.After(time.Now()) {
+		return "", fmt.Errorf("The SSO session for %s has expired, run `aws sso login` to sign in again", p.StartURL)
+	}
+
+	return token.AccessToken, nil
+}
+
 func setOptionalEndpoint(cfg *aws.Config) string {
 	endpoint := os.Getenv("AWS_METADATA_URL")
 	if endpoint != "" {
@@ -6,14 +6,20 @@ import (
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sso"
 	"github.com/aws/aws-sdk-go/service/sts"
 )
 
@@ -671,6 +677,384 @@ func TestAWSGetCredentials_shouldBeENV(t *testing.T) {
 	}
 }
 
+func TestAWSGetCredentials_shouldAssumeRoleChain(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	requests, closeSts := awsStsMock(t)
+	defer closeSts()
+
+	cfg := Config{
+		AccessKey:            "base",
+		SecretKey:            "secret",
+		Region:               "us-east-1",
+		SkipMetadataApiCheck: true,
+		Endpoints:            map[string]string{"sts": awsStsMockURL},
+		AssumeRoles: []*AssumeRole{
+			{
+				RoleARN:           "arn:aws:iam::111111111111:role/hub",
+				SessionName:       "ci",
+				DurationSeconds:   1800,
+				PolicyARNs:        []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+				Tags:              map[string]string{"Team": "platform", "Pipeline": "deploy"},
+				TransitiveTagKeys: []string{"Team"},
+			},
+			{
+				RoleARN:    "arn:aws:iam::222222222222:role/workload",
+				ExternalID: "workload-external-id",
+			},
+		},
+	}
+
+	creds, err := GetCredentials(&cfg)
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if v.AccessKeyID != "ASIA-workload" {
+		t.Fatalf("AccessKeyID mismatch, expected: (ASIA-workload), got (%s)", v.AccessKeyID)
+	}
+
+	if len(*requests) != 2 {
+		t.Fatalf("Expected 2 STS requests, got %d", len(*requests))
+	}
+
+	hub := (*requests)[0]
+	expectedHub := map[string]string{
+		"Action":                     "AssumeRole",
+		"RoleArn":                    "arn:aws:iam::111111111111:role/hub",
+		"RoleSessionName":            "ci",
+		"DurationSeconds":            "1800",
+		"PolicyArns.member.1.arn":    "arn:aws:iam::aws:policy/ReadOnlyAccess",
+		"Tags.member.1.Key":          "Pipeline",
+		"Tags.member.1.Value":        "deploy",
+		"Tags.member.2.Key":          "Team",
+		"Tags.member.2.Value":        "platform",
+		"TransitiveTagKeys.member.1": "Team",
+	}
+	for k, expected := range expectedHub {
+		if actual := hub.Form.Get(k); actual != expected {
+			t.Fatalf("%s mismatch in first AssumeRole request, expected: (%s), got (%s)", k, expected, actual)
+		}
+	}
+	if !strings.Contains(hub.Authorization, "Credential=base/") {
+		t.Fatalf("Expected first AssumeRole request to be signed with the main credentials, got %q", hub.Authorization)
+	}
+
+	workload := (*requests)[1]
+	if actual := workload.Form.Get("ExternalId"); actual != "workload-external-id" {
+		t.Fatalf("ExternalId mismatch in second AssumeRole request, expected: (workload-external-id), got (%s)", actual)
+	}
+	if workload.Form.Get("PolicyArns.member.1.arn") != "" || workload.Form.Get("Tags.member.1.Key") != "" {
+		t.Fatalf("Expected no session parameters in second AssumeRole request, got %s", workload.Form.Encode())
+	}
+	if !strings.Contains(workload.Authorization, "Credential=ASIA-hub/") {
+		t.Fatalf("Expected second AssumeRole request to be signed with the hub role credentials, got %q", workload.Authorization)
+	}
+}
+
+func TestAWSGetCredentials_shouldAssumeRoleWithWebIdentity(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	requests, closeSts := awsStsMock(t)
+	defer closeSts()
+
+	tokenFile, err := ioutil.TempFile("", "tf-web-identity-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tokenFile.Name())
+	tokenFile.WriteString("web-identity-token\n")
+	tokenFile.Close()
+
+	cfg := Config{
+		Region:               "us-east-1",
+		SkipMetadataApiCheck: true,
+		Endpoints:            map[string]string{"sts": awsStsMockURL},
+		AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+			RoleARN:              "arn:aws:iam::111111111111:role/ci",
+			SessionName:          "ci",
+			WebIdentityTokenFile: tokenFile.Name(),
+			DurationSeconds:      900,
+		},
+		AssumeRoles: []*AssumeRole{
+			{
+				RoleARN: "arn:aws:iam::222222222222:role/workload",
+			},
+		},
+	}
+
+	creds, err := GetCredentials(&cfg)
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if v.AccessKeyID != "ASIA-workload" {
+		t.Fatalf("AccessKeyID mismatch, expected: (ASIA-workload), got (%s)", v.AccessKeyID)
+	}
+
+	webIdentity := (*requests)[0]
+	expected := map[string]string{
+		"Action":           "AssumeRoleWithWebIdentity",
+		"RoleArn":          "arn:aws:iam::111111111111:role/ci",
+		"RoleSessionName":  "ci",
+		"WebIdentityToken": "web-identity-token",
+		"DurationSeconds":  "900",
+	}
+	for k, e := range expected {
+		if actual := webIdentity.Form.Get(k); actual != e {
+			t.Fatalf("%s mismatch in AssumeRoleWithWebIdentity request, expected: (%s), got (%s)", k, e, actual)
+		}
+	}
+	if webIdentity.Authorization != "" {
+		t.Fatalf("Expected AssumeRoleWithWebIdentity request not to be signed, got %q", webIdentity.Authorization)
+	}
+	if !strings.Contains((*requests)[1].Authorization, "Credential=ASIA-ci/") {
+		t.Fatalf("Expected AssumeRole request to be signed with the web identity credentials, got %q", (*requests)[1].Authorization)
+	}
+}
+
+func TestAWSGetCredentials_shouldErrorAssumingRole(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	_, closeSts := awsStsMock(t)
+	defer closeSts()
+
+	cfg := Config{
+		AccessKey:            "base",
+		SecretKey:            "secret",
+		Region:               "us-east-1",
+		SkipMetadataApiCheck: true,
+		Endpoints:            map[string]string{"sts": awsStsMockURL},
+		AssumeRoles: []*AssumeRole{
+			{
+				RoleARN: "arn:aws:iam::111111111111:role/denied",
+			},
+		},
+	}
+
+	_, err := GetCredentials(&cfg)
+	if err == nil || !strings.Contains(err.Error(), "arn:aws:iam::111111111111:role/denied") {
+		t.Fatalf("Expected an error naming the role that could not be assumed, got: %v", err)
+	}
+}
+
+func TestAWSGetCredentials_shouldUseCredentialProcess(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	dir, err := ioutil.TempDir("", "tf-credential-process")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	process := writeTestFile(t, dir, "process.sh", `#!/bin/sh
+echo '{"Version": 1, "AccessKeyId": "processKey", "SecretAccessKey": "processSecret", "SessionToken": "processToken"}'
+`)
+	os.Chmod(process, 0700)
+
+	// The second config file takes precedence over the first one
+	first := writeTestFile(t, dir, "config", `[profile tf]
+credential_process = false
+`)
+	second := writeTestFile(t, dir, "config.d", fmt.Sprintf(`[profile tf]
+credential_process = %s
+`, process))
+
+	cfg := Config{
+		Profile:              "tf",
+		CredsFilename:        filepath.Join(dir, "credentials"),
+		SharedConfigFiles:    []string{first, second},
+		SkipMetadataApiCheck: true,
+	}
+
+	creds, err := GetCredentials(&cfg)
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	expected := credentials.Value{
+		AccessKeyID:     "processKey",
+		SecretAccessKey: "processSecret",
+		SessionToken:    "processToken",
+		ProviderName:    "ProcessProvider",
+	}
+	if v != expected {
+		t.Fatalf("Credentials mismatch, expected: (%#v), got (%#v)", expected, v)
+	}
+}
+
+func TestSharedConfigCredentialsProvider_credentialProcess(t *testing.T) {
+	cases := []struct {
+		Command       string
+		ExpectedKey   string
+		ExpectedError string
+	}{
+		{
+			Command:     `echo '{"Version": 1, "AccessKeyId": "key", "SecretAccessKey": "secret"}'`,
+			ExpectedKey: "key",
+		},
+		{
+			Command:     `echo '{"Version": 1, "AccessKeyId": "key", "SecretAccessKey": "secret", "Expiration": "2100-01-01T00:00:00Z"}'`,
+			ExpectedKey: "key",
+		},
+		{
+			Command:       `echo '{"Version": 2, "AccessKeyId": "key", "SecretAccessKey": "secret"}'`,
+			ExpectedError: "wrong version in process output",
+		},
+		{
+			Command:       `echo '{"Version": 1}'`,
+			ExpectedError: "missing AccessKeyId",
+		},
+		{
+			Command:       `echo 'not json'`,
+			ExpectedError: "parse failed of credential_process output",
+		},
+		{
+			Command:       `exit 1`,
+			ExpectedError: "error in credential_process",
+		},
+	}
+
+	for _, tc := range cases {
+		p, err := sharedConfigCredentialsProvider(map[string]string{
+			"credential_process": tc.Command,
+		})
+		if err != nil {
+			t.Fatalf("%s: error creating provider: %s", tc.Command, err)
+		}
+		v, err := p.Retrieve()
+		if tc.ExpectedError != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+				t.Fatalf("%s: expected error containing %q, got: %v", tc.Command, tc.ExpectedError, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.Command, err)
+		}
+		if v.AccessKeyID != tc.ExpectedKey {
+			t.Fatalf("%s: AccessKeyID mismatch, expected: (%s), got (%s)", tc.Command, tc.ExpectedKey, v.AccessKeyID)
+		}
+		if p.IsExpired() {
+			t.Fatalf("%s: expected credentials not to be expired", tc.Command)
+		}
+	}
+}
+
+func TestSSOCredentialsProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-sso")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	config := writeTestFile(t, dir, "config", `[profile sso]
+sso_start_url = https://example.awsapps.com/start
+sso_region = eu-west-1
+sso_account_id = 123456789012
+sso_role_name = Admin
+`)
+
+	profile, err := sharedConfigProfile(&Config{
+		Profile:           "sso",
+		CredsFilename:     filepath.Join(dir, "credentials"),
+		SharedConfigFiles: []string{config},
+	})
+	if err != nil {
+		t.Fatalf("Error loading profile: %s", err)
+	}
+	provider, err := sharedConfigCredentialsProvider(profile)
+	if err != nil {
+		t.Fatalf("Error creating provider: %s", err)
+	}
+	p, ok := provider.(*ssoCredentialsProvider)
+	if !ok {
+		t.Fatalf("Expected an SSO credentials provider, got %#v", provider)
+	}
+	if endpoint := p.Client.(*sso.SSO).Endpoint; endpoint != "https://portal.sso.eu-west-1.amazonaws.com" {
+		t.Fatalf("Endpoint mismatch, got %s", endpoint)
+	}
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/federation/credentials" ||
+			r.URL.Query().Get("account_id") != "123456789012" ||
+			r.URL.Query().Get("role_name") != "Admin" {
+			t.Fatalf("Unexpected SSO request: %s", r.URL)
+		}
+		if r.Header.Get("x-amz-sso_bearer_token") != "cached-token" {
+			w.WriteHeader(401)
+			fmt.Fprint(w, `{"message": "Session token not found or invalid"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"roleCredentials": {"accessKeyId": "ssoKey", "secretAccessKey": "ssoSecret", "sessionToken": "ssoToken", "expiration": %d}}`,
+			time.Now().Add(time.Hour).UnixNano()/int64(time.Millisecond))
+	}))
+	defer ts.Close()
+
+	p.Client = sso.New(session.New(&aws.Config{
+		Region:   aws.String("eu-west-1"),
+		Endpoint: aws.String(ts.URL),
+	}))
+	p.CacheDir = dir
+
+	// Not signed in yet
+	if _, err := p.Retrieve(); err == nil || !strings.Contains(err.Error(), "aws sso login") {
+		t.Fatalf("Expected an error asking to sign in, got: %v", err)
+	}
+
+	// Signed in, but the session has expired
+	tokenFile := ssoTokenFilename("https://example.awsapps.com/start")
+	writeTestFile(t, dir, tokenFile, `{"accessToken": "cached-token", "expiresAt": "2019-11-14T04:05:45UTC"}`)
+	if _, err := p.Retrieve(); err == nil || !strings.Contains(err.Error(), "has expired") {
+		t.Fatalf("Expected an error about the expired session, got: %v", err)
+	}
+
+	writeTestFile(t, dir, tokenFile, fmt.Sprintf(`{"accessToken": "cached-token", "expiresAt": %q}`,
+		time.Now().Add(time.Hour).UTC().Format(time.RFC3339)))
+	v, err := p.Retrieve()
+	if err != nil {
+		t.Fatalf("Error retrieving credentials: %s", err)
+	}
+	expected := credentials.Value{
+		AccessKeyID:     "ssoKey",
+		SecretAccessKey: "ssoSecret",
+		SessionToken:    "ssoToken",
+		ProviderName:    "SSOProvider",
+	}
+	if v != expected {
+		t.Fatalf("Credentials mismatch, expected: (%#v), got (%#v)", expected, v)
+	}
+	if p.IsExpired() {
+		t.Fatal("Expected SSO credentials not to be expired")
+	}
+}
+
+func TestSharedConfigCredentialsProvider_incompleteSSOProfile(t *testing.T) {
+	_, err := sharedConfigCredentialsProvider(map[string]string{
+		"sso_start_url": "https://example.awsapps.com/start",
+		"sso_region":    "eu-west-1",
+	})
+	if err == nil || !strings.Contains(err.Error(), "sso_account_id, sso_role_name") {
+		t.Fatalf("Expected an error listing the missing settings, got: %v", err)
+	}
+}
+
 func testGetAccountInfo(t *testing.T, iamSess, stsSess *session.Session, credProviderName string) {
 
 	iamConn := iam.New(iamSess)
@@ -782,6 +1166,12 @@ func awsMetadataApiMock(endpoints []*endpoint) func() {
 		w.Header().Set("Content-Type", "text/plain")
 		w.Header().Add("Server", "MockEC2")
 		log.Printf("[DEBUG] Mocker server received request to %q", r.RequestURI)
+		// Behave like an instance without IMDSv2, so that clients
+		// fall back to requests without a session token
+		if r.Method == "PUT" && r.RequestURI == "/latest/api/token" {
+			w.WriteHeader(404)
+			return
+		}
 		for _, e := range endpoints {
 			if r.RequestURI == e.Uri {
 				fmt.Fprintln(w, e.Body)
@@ -836,7 +1226,7 @@ var instanceIdEndpoint = &endpoint{
 
 var securityCredentialsEndpoints = []*endpoint{
 	&endpoint{
-		Uri:  "/latest/meta-data/iam/security-credentials",
+		Uri:  "/latest/meta-data/iam/security-credentials/",
 		Body: "test_role",
 	},
 	&endpoint{
@@ -932,3 +1322,78 @@ const iamResponse_ListRoles_unauthorized = `<ErrorResponse xmlns="https://iam.am
   </Error>
   <RequestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</RequestId>
 </ErrorResponse>`
+
+type awsStsMockRequest struct {
+	Form          url.Values
+	Authorization string
+}
+
+var awsStsMockURL string
+
+// awsStsMock starts a fake STS endpoint which hands out credentials named
+// after the last path element of the assumed role, e.g. "ASIA-hub", and
+// denies roles named "denied". It records all requests it receives.
+func awsStsMock(t *testing.T) (*[]awsStsMockRequest, func()) {
+	var requests []awsStsMockRequest
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatalf("Error parsing STS request: %s", err)
+		}
+		requests = append(requests, awsStsMockRequest{
+			Form:          r.PostForm,
+			Authorization: r.Header.Get("Authorization"),
+		})
+
+		arn := r.PostForm.Get("RoleArn")
+		role := arn[strings.LastIndex(arn, "/")+1:]
+		if role == "denied" {
+			w.WriteHeader(403)
+			fmt.Fprintf(w, testStsAccessDeniedResponse, arn)
+			return
+		}
+
+		action := r.PostForm.Get("Action")
+		w.Header().Set("Content-Type", "text/xml")
+		fmt.Fprintf(w, testStsAssumeRoleResponse, action, action, role, role, role,
+			time.Now().Add(time.Hour).UTC().Format(time.RFC3339), arn, action, action)
+	}))
+	awsStsMockURL = ts.URL
+
+	return &requests, ts.Close
+}
+
+const testStsAssumeRoleResponse = `<%sResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <%sResult>
+    <Credentials>
+      <AccessKeyId>ASIA-%s</AccessKeyId>
+      <SecretAccessKey>secret-%s</SecretAccessKey>
+      <SessionToken>token-%s</SessionToken>
+      <Expiration>%s</Expiration>
+    </Credentials>
+    <AssumedRoleUser>
+      <Arn>%s</Arn>
+      <AssumedRoleId>AROA3XFRBF535PLBIFPI4:session</AssumedRoleId>
+    </AssumedRoleUser>
+  </%sResult>
+  <ResponseMetadata>
+    <RequestId>c6104cbe-af31-11e0-8154-cbc7ccf896c7</RequestId>
+  </ResponseMetadata>
+</%sResponse>`
+
+const testStsAccessDeniedResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>AccessDenied</Code>
+    <Message>Not authorized to perform sts:AssumeRole on %s</Message>
+  </Error>
+  <RequestId>c6104cbe-af31-11e0-8154-cbc7ccf896c7</RequestId>
+</ErrorResponse>`
+
+func writeTestFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("Error writing %s: %s", path, err)
+	}
+	return path
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 	"log"
-	"regexp"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -305,13 +304,5 @@ func setToMapByKey(s *schema.Set, key string) map[string]interface{} {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredAutoscaling(t *autoscaling.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredKey(*t.Key)
 }
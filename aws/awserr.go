@@ -15,9 +15,14 @@ func isAWSErr(err error, code string, message string) bool {
 	return false
 }
 
-func retryOnAwsCode(code string, f func() (interface{}, error)) (interface{}, error) {
+// defaultAwsRetryTimeout is how long retryOnAwsCode and retryOnAwsCodes keep
+// retrying unless the provider's retry block configures a timeout, see
+// AWSClient.retryTimeout.
+const defaultAwsRetryTimeout = 1 * time.Minute
+
+func retryOnAwsCode(timeout time.Duration, code string, f func() (interface{}, error)) (interface{}, error) {
 	var resp interface{}
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err := resource.Retry(timeout, func() *resource.RetryError {
 		var err error
 		resp, err = f()
 		if err != nil {
@@ -32,9 +37,9 @@ func retryOnAwsCode(code string, f func() (interface{}, error)) (interface{}, er
 	return resp, err
 }
 
-func retryOnAwsCodes(codes []string, f func() (interface{}, error)) (interface{}, error) {
+func retryOnAwsCodes(timeout time.Duration, codes []string, f func() (interface{}, error)) (interface{}, error) {
 	var resp interface{}
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err := resource.Retry(timeout, func() *resource.RetryError {
 		var err error
 		resp, err = f()
 		if err != nil {
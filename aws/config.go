@@ -12,6 +12,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/acm"
@@ -42,6 +43,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
@@ -89,30 +91,34 @@ type Config struct {
 	Region        string
 	MaxRetries    int
 
-	AssumeRoleARN         string
-	AssumeRoleExternalID  string
-	AssumeRoleSessionName string
-	AssumeRolePolicy      string
+	// SharedConfigFiles are read in order, later files taking precedence.
+	SharedConfigFiles []string
+
+	// AssumeRoles are assumed in order, each with the credentials
+	// obtained from the previous one.
+	AssumeRoles               []*AssumeRole
+	AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentity
 
 	AllowedAccountIds   []interface{}
 	ForbiddenAccountIds []interface{}
 
-	CloudFormationEndpoint   string
-	CloudWatchEndpoint       string
-	CloudWatchEventsEndpoint string
-	CloudWatchLogsEndpoint   string
-	DynamoDBEndpoint         string
-	DeviceFarmEndpoint       string
-	Ec2Endpoint              string
-	ElbEndpoint              string
-	IamEndpoint              string
-	KinesisEndpoint          string
-	KmsEndpoint              string
-	RdsEndpoint              string
-	S3Endpoint               string
-	SnsEndpoint              string
-	SqsEndpoint              string
-	Insecure                 bool
+	DefaultTags           map[string]interface{}
+	IgnoreTagsKeys        []interface{}
+	IgnoreTagsKeyPrefixes []interface{}
+
+	RetryMaxAttempts       int
+	RetryMinBackoff        time.Duration
+	RetryMaxBackoff        time.Duration
+	RetryTimeout           time.Duration
+	RetryableErrorCodes    map[string][]string
+	RetryRequestsPerSecond float64
+	RetryBurst             int
+
+	// Endpoints overrides the default endpoint URL of a service client,
+	// keyed by the names listed in endpointServiceNames.
+	Endpoints map[string]string
+
+	Insecure bool
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -122,6 +128,28 @@ type Config struct {
 	S3ForcePathStyle        bool
 }
 
+// AssumeRole describes a role assumed by the provider.
+type AssumeRole struct {
+	RoleARN           string
+	SessionName       string
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	DurationSeconds   int
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
+// AssumeRoleWithWebIdentity describes a role assumed with an OpenID
+// Connect token in place of the main credentials.
+type AssumeRoleWithWebIdentity struct {
+	RoleARN              string
+	SessionName          string
+	WebIdentityTokenFile string
+	Policy               string
+	DurationSeconds      int
+}
+
 type AWSClient struct {
 	cfconn                *cloudformation.CloudFormation
 	cloudfrontconn        *cloudfront.CloudFront
@@ -140,6 +168,7 @@ type AWSClient struct {
 	ecrconn               *ecr.ECR
 	ecsconn               *ecs.ECS
 	efsconn               *efs.EFS
+	eksconn               *eks.EKS
 	elbconn               *elb.ELB
 	elbv2conn             *elbv2.ELBV2
 	emrconn               *emr.EMR
@@ -161,6 +190,9 @@ type AWSClient struct {
 	accountid             string
 	supportedplatforms    []string
 	region                string
+	defaultTags           map[string]interface{}
+	ignoreTagsConfig      *tagsIgnoreConfig
+	retryTimeout          time.Duration
 	rdsconn               *rds.RDS
 	iamconn               *iam.IAM
 	kinesisconn           *kinesis.Kinesis
@@ -186,6 +218,10 @@ type AWSClient struct {
 	batchconn             *batch.Batch
 	athenaconn            *athena.Athena
 	dxconn                *directconnect.DirectConnect
+
+	// regionalClients holds the clients used by resources which override
+	// the provider region, see AWSClient.regionalClient.
+	regionalClients *regionalClients
 }
 
 func (c *AWSClient) S3() *s3.S3 {
@@ -197,17 +233,11 @@ func (c *AWSClient) DynamoDB() *dynamodb.DynamoDB {
 }
 
 func (c *AWSClient) IsGovCloud() bool {
-	if c.region == "us-gov-west-1" {
-		return true
-	}
-	return false
+	return partitionIDForRegion(c.region) == endpoints.AwsUsGovPartitionID
 }
 
 func (c *AWSClient) IsChinaCloud() bool {
-	if c.region == "cn-north-1" {
-		return true
-	}
-	return false
+	return partitionIDForRegion(c.region) == endpoints.AwsCnPartitionID
 }
 
 // Client configures and returns a fully initialized AWSClient
@@ -228,6 +258,10 @@ func (c *Config) Client() (interface{}, error) {
 	// store AWS region in client struct, for region specific operations such as
 	// bucket storage in S3
 	client.region = c.Region
+	// The partition is refined from the account info below
+	client.partition = partitionIDForRegion(c.Region)
+	client.defaultTags = c.DefaultTags
+	client.ignoreTagsConfig = newTagsIgnoreConfig(c.IgnoreTagsKeys, c.IgnoreTagsKeyPrefixes)
 
 	log.Println("[INFO] Building AWS auth structure")
 	creds, err := GetCredentials(c)
@@ -301,39 +335,37 @@ func (c *Config) Client() (interface{}, error) {
 	}
 
 	// if the desired number of retries is non-zero, update the session
-	if c.MaxRetries > 0 {
-		sess = sess.Copy(&aws.Config{MaxRetries: aws.Int(c.MaxRetries)})
+	maxRetries := c.MaxRetries
+	if c.RetryMaxAttempts > 0 {
+		maxRetries = c.RetryMaxAttempts - 1
+	}
+	if maxRetries > 0 {
+		sess = sess.Copy(&aws.Config{MaxRetries: aws.Int(maxRetries)})
 	}
 
-	// This restriction should only be used for Route53 sessions.
-	// Other resources that have restrictions should allow the API to fail, rather
-	// than Terraform abstracting the region for the user. This can lead to breaking
-	// changes if that resource is ever opened up to more regions.
-	r53Sess := sess.Copy(&aws.Config{Region: aws.String("us-east-1")})
+	if c.RetryMinBackoff > 0 || c.RetryMaxBackoff > 0 {
+		if c.RetryMaxBackoff > 0 && c.RetryMinBackoff > c.RetryMaxBackoff {
+			return nil, fmt.Errorf("retry min_backoff (%s) must not be greater than max_backoff (%s)",
+				c.RetryMinBackoff, c.RetryMaxBackoff)
+		}
+		sess = sess.Copy(request.WithRetryer(aws.NewConfig(), newAwsRetryer(maxRetries, c.RetryMinBackoff, c.RetryMaxBackoff)))
+	}
 
-	// Some services have user-configurable endpoints
-	awsCfSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudFormationEndpoint)})
-	awsCwSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEndpoint)})
-	awsCweSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEventsEndpoint)})
-	awsCwlSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchLogsEndpoint)})
-	awsDynamoSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DynamoDBEndpoint)})
-	awsEc2Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.Ec2Endpoint)})
-	awsElbSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ElbEndpoint)})
-	awsIamSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.IamEndpoint)})
-	awsKinesisSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KinesisEndpoint)})
-	awsKmsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KmsEndpoint)})
-	awsRdsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.RdsEndpoint)})
-	awsS3Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.S3Endpoint)})
-	awsSnsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SnsEndpoint)})
-	awsSqsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SqsEndpoint)})
-	awsDeviceFarmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DeviceFarmEndpoint)})
+	if len(c.RetryableErrorCodes) > 0 {
+		sess.Handlers.Retry.PushBackNamed(retryableErrorCodesHandler(c.RetryableErrorCodes))
+	}
 
-	log.Println("[INFO] Initializing DeviceFarm SDK connection")
-	client.devicefarmconn = devicefarm.New(awsDeviceFarmSess)
+	if c.RetryRequestsPerSecond > 0 {
+		log.Printf("[INFO] Limiting AWS API requests to %g per second (burst %d)", c.RetryRequestsPerSecond, c.RetryBurst)
+		sess.Handlers.Send.PushFrontNamed(newTokenBucket(c.RetryRequestsPerSecond, c.RetryBurst).handler())
+	}
 
-	// These two services need to be set up early so we can check on AccountID
-	client.iamconn = iam.New(awsIamSess)
-	client.stsconn = sts.New(sess)
+	client.retryTimeout = defaultAwsRetryTimeout
+	if c.RetryTimeout > 0 {
+		client.retryTimeout = c.RetryTimeout
+	}
+
+	c.initServiceClients(&client, sess)
 
 	if !c.SkipCredsValidation {
 		err = c.ValidateCredentials(client.stsconn)
@@ -355,104 +387,117 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, authErr
 	}
 
-	client.ec2conn = ec2.New(awsEc2Sess)
+	client.supportedplatforms = c.supportedEC2Platforms(client.ec2conn)
+	client.regionalClients = newRegionalClients(c, sess, &client)
 
-	if !c.SkipGetEC2Platforms {
-		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
-		if err != nil {
-			// We intentionally fail *silently* because there's a chance
-			// user just doesn't have ec2:DescribeAccountAttributes permissions
-			log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
-		} else {
-			client.supportedplatforms = supportedPlatforms
-		}
-	}
+	return &client, nil
+}
+
+// initServiceClients creates the service clients of client from sess.
+func (c *Config) initServiceClients(client *AWSClient, sess *session.Session) {
+	// This restriction should only be used for Route53 sessions.
+	// Other resources that have restrictions should allow the API to fail, rather
+	// than Terraform abstracting the region for the user. This can lead to breaking
+	// changes if that resource is ever opened up to more regions.
+	r53Sess := sess.Copy(&aws.Config{Region: aws.String("us-east-1")})
 
-	client.acmconn = acm.New(sess)
-	client.apigateway = apigateway.New(sess)
-	client.appautoscalingconn = applicationautoscaling.New(sess)
-	client.autoscalingconn = autoscaling.New(sess)
-	client.cfconn = cloudformation.New(awsCfSess)
-	client.cloudfrontconn = cloudfront.New(sess)
-	client.cloudtrailconn = cloudtrail.New(sess)
-	client.cloudwatchconn = cloudwatch.New(awsCwSess)
-	client.cloudwatcheventsconn = cloudwatchevents.New(awsCweSess)
-	client.cloudwatchlogsconn = cloudwatchlogs.New(awsCwlSess)
-	client.codecommitconn = codecommit.New(sess)
-	client.codebuildconn = codebuild.New(sess)
-	client.codedeployconn = codedeploy.New(sess)
-	client.configconn = configservice.New(sess)
-	client.cognitoconn = cognitoidentity.New(sess)
-	client.cognitoidpconn = cognitoidentityprovider.New(sess)
-	client.dmsconn = databasemigrationservice.New(sess)
-	client.codepipelineconn = codepipeline.New(sess)
-	client.dsconn = directoryservice.New(sess)
-	client.dynamodbconn = dynamodb.New(awsDynamoSess)
-	client.ecrconn = ecr.New(sess)
-	client.ecsconn = ecs.New(sess)
-	client.efsconn = efs.New(sess)
-	client.elasticacheconn = elasticache.New(sess)
-	client.elasticbeanstalkconn = elasticbeanstalk.New(sess)
-	client.elastictranscoderconn = elastictranscoder.New(sess)
-	client.elbconn = elb.New(awsElbSess)
-	client.elbv2conn = elbv2.New(awsElbSess)
-	client.emrconn = emr.New(sess)
-	client.esconn = elasticsearch.New(sess)
-	client.firehoseconn = firehose.New(sess)
-	client.inspectorconn = inspector.New(sess)
-	client.glacierconn = glacier.New(sess)
-	client.iotconn = iot.New(sess)
-	client.kinesisconn = kinesis.New(awsKinesisSess)
-	client.kmsconn = kms.New(awsKmsSess)
-	client.lambdaconn = lambda.New(sess)
-	client.lightsailconn = lightsail.New(sess)
-	client.opsworksconn = opsworks.New(sess)
-	client.r53conn = route53.New(r53Sess)
-	client.rdsconn = rds.New(awsRdsSess)
-	client.redshiftconn = redshift.New(sess)
-	client.simpledbconn = simpledb.New(sess)
-	client.s3conn = s3.New(awsS3Sess)
-	client.scconn = servicecatalog.New(sess)
-	client.sesConn = ses.New(sess)
-	client.sfnconn = sfn.New(sess)
-	client.snsconn = sns.New(awsSnsSess)
-	client.sqsconn = sqs.New(awsSqsSess)
-	client.ssmconn = ssm.New(sess)
-	client.wafconn = waf.New(sess)
-	client.wafregionalconn = wafregional.New(sess)
-	client.batchconn = batch.New(sess)
-	client.athenaconn = athena.New(sess)
-	client.dxconn = directconnect.New(sess)
+	log.Println("[INFO] Initializing DeviceFarm SDK connection")
+	client.devicefarmconn = devicefarm.New(c.sessionForService(sess, "devicefarm"))
+
+	client.iamconn = iam.New(c.sessionForService(sess, "iam"))
+	client.stsconn = sts.New(c.sessionForService(sess, "sts"))
+	client.ec2conn = ec2.New(c.sessionForService(sess, "ec2"))
+	client.acmconn = acm.New(c.sessionForService(sess, "acm"))
+	client.apigateway = apigateway.New(c.sessionForService(sess, "apigateway"))
+	client.appautoscalingconn = applicationautoscaling.New(c.sessionForService(sess, "applicationautoscaling"))
+	client.autoscalingconn = autoscaling.New(c.sessionForService(sess, "autoscaling"))
+	client.cfconn = cloudformation.New(c.sessionForService(sess, "cloudformation"))
+	client.cloudfrontconn = cloudfront.New(c.sessionForService(sess, "cloudfront"))
+	client.cloudtrailconn = cloudtrail.New(c.sessionForService(sess, "cloudtrail"))
+	client.cloudwatchconn = cloudwatch.New(c.sessionForService(sess, "cloudwatch"))
+	client.cloudwatcheventsconn = cloudwatchevents.New(c.sessionForService(sess, "cloudwatchevents"))
+	client.cloudwatchlogsconn = cloudwatchlogs.New(c.sessionForService(sess, "cloudwatchlogs"))
+	client.codecommitconn = codecommit.New(c.sessionForService(sess, "codecommit"))
+	client.codebuildconn = codebuild.New(c.sessionForService(sess, "codebuild"))
+	client.codedeployconn = codedeploy.New(c.sessionForService(sess, "codedeploy"))
+	client.configconn = configservice.New(c.sessionForService(sess, "configservice"))
+	client.cognitoconn = cognitoidentity.New(c.sessionForService(sess, "cognitoidentity"))
+	client.cognitoidpconn = cognitoidentityprovider.New(c.sessionForService(sess, "cognitoidp"))
+	client.dmsconn = databasemigrationservice.New(c.sessionForService(sess, "dms"))
+	client.codepipelineconn = codepipeline.New(c.sessionForService(sess, "codepipeline"))
+	client.dsconn = directoryservice.New(c.sessionForService(sess, "ds"))
+	client.dynamodbconn = dynamodb.New(c.sessionForService(sess, "dynamodb"))
+	client.ecrconn = ecr.New(c.sessionForService(sess, "ecr"))
+	client.ecsconn = ecs.New(c.sessionForService(sess, "ecs"))
+	client.efsconn = efs.New(c.sessionForService(sess, "efs"))
+	client.eksconn = eks.New(c.sessionForService(sess, "eks"))
+	client.elasticacheconn = elasticache.New(c.sessionForService(sess, "elasticache"))
+	client.elasticbeanstalkconn = elasticbeanstalk.New(c.sessionForService(sess, "elasticbeanstalk"))
+	client.elastictranscoderconn = elastictranscoder.New(c.sessionForService(sess, "elastictranscoder"))
+	client.elbconn = elb.New(c.sessionForService(sess, "elb"))
+	client.elbv2conn = elbv2.New(c.sessionForService(sess, "elb"))
+	client.emrconn = emr.New(c.sessionForService(sess, "emr"))
+	client.esconn = elasticsearch.New(c.sessionForService(sess, "es"))
+	client.firehoseconn = firehose.New(c.sessionForService(sess, "firehose"))
+	client.inspectorconn = inspector.New(c.sessionForService(sess, "inspector"))
+	client.glacierconn = glacier.New(c.sessionForService(sess, "glacier"))
+	client.iotconn = iot.New(c.sessionForService(sess, "iot"))
+	client.kinesisconn = kinesis.New(c.sessionForService(sess, "kinesis"))
+	client.kmsconn = kms.New(c.sessionForService(sess, "kms"))
+	client.lambdaconn = lambda.New(c.sessionForService(sess, "lambda"))
+	client.lightsailconn = lightsail.New(c.sessionForService(sess, "lightsail"))
+	client.opsworksconn = opsworks.New(c.sessionForService(sess, "opsworks"))
+	client.r53conn = route53.New(c.sessionForService(r53Sess, "route53"))
+	client.rdsconn = rds.New(c.sessionForService(sess, "rds"))
+	client.redshiftconn = redshift.New(c.sessionForService(sess, "redshift"))
+	client.simpledbconn = simpledb.New(c.sessionForService(sess, "simpledb"))
+	client.s3conn = s3.New(c.sessionForService(sess, "s3"))
+	client.scconn = servicecatalog.New(c.sessionForService(sess, "servicecatalog"))
+	client.sesConn = ses.New(c.sessionForService(sess, "ses"))
+	client.sfnconn = sfn.New(c.sessionForService(sess, "sfn"))
+	client.snsconn = sns.New(c.sessionForService(sess, "sns"))
+	client.sqsconn = sqs.New(c.sessionForService(sess, "sqs"))
+	client.ssmconn = ssm.New(c.sessionForService(sess, "ssm"))
+	client.wafconn = waf.New(c.sessionForService(sess, "waf"))
+	client.wafregionalconn = wafregional.New(c.sessionForService(sess, "wafregional"))
+	client.batchconn = batch.New(c.sessionForService(sess, "batch"))
+	client.athenaconn = athena.New(c.sessionForService(sess, "athena"))
+	client.dxconn = directconnect.New(c.sessionForService(sess, "directconnect"))
 
 	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
-	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		err, ok := r.Error.(awserr.Error)
-		if !ok || err == nil {
-			return
-		}
-		if err.Code() == kinesis.ErrCodeLimitExceededException {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	client.kinesisconn.Handlers.Retry.PushBack(retryReadOperationsOnCode(kinesis.ErrCodeLimitExceededException))
 
 	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.appautoscalingconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		err, ok := r.Error.(awserr.Error)
-		if !ok || err == nil {
-			return
-		}
-		if err.Code() == applicationautoscaling.ErrCodeFailedResourceAccessException {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	client.appautoscalingconn.Handlers.Retry.PushBack(retryReadOperationsOnCode(applicationautoscaling.ErrCodeFailedResourceAccessException))
+}
 
-	return &client, nil
+// supportedEC2Platforms returns the EC2 platforms supported in the region of
+// conn, or nil if they are not to be or could not be determined.
+func (c *Config) supportedEC2Platforms(conn *ec2.EC2) []string {
+	if c.SkipGetEC2Platforms {
+		return nil
+	}
+
+	supportedPlatforms, err := GetSupportedEC2Platforms(conn)
+	if err != nil {
+		// We intentionally fail *silently* because there's a chance
+		// user just doesn't have ec2:DescribeAccountAttributes permissions
+		log.Printf("[WARN] Unable to get supported EC2 platforms: %s", err)
+		return nil
+	}
+	return supportedPlatforms
+}
+
+// sessionForService returns a copy of sess pointed at the endpoint configured
+// for the given service, or sess itself when none is configured.
+func (c *Config) sessionForService(sess *session.Session, service string) *session.Session {
+	endpoint := c.Endpoints[service]
+	if endpoint == "" {
+		return sess
+	}
+
+	log.Printf("[INFO] Using %s endpoint %q", service, endpoint)
+	return sess.Copy(&aws.Config{Endpoint: aws.String(endpoint)})
 }
 
 func hasEc2Classic(platforms []string) bool {
@@ -467,31 +512,14 @@ func hasEc2Classic(platforms []string) bool {
 // ValidateRegion returns an error if the configured region is not a
 // valid aws region and nil otherwise.
 func (c *Config) ValidateRegion() error {
-	var regions = []string{
-		"ap-northeast-1",
-		"ap-northeast-2",
-		"ap-south-1",
-		"ap-southeast-1",
-		"ap-southeast-2",
-		"ca-central-1",
-		"cn-north-1",
-		"eu-central-1",
-		"eu-west-1",
-		"eu-west-2",
-		"sa-east-1",
-		"us-east-1",
-		"us-east-2",
-		"us-gov-west-1",
-		"us-west-1",
-		"us-west-2",
-	}
+	return validateRegionName(c.Region)
+}
 
-	for _, valid := range regions {
-		if c.Region == valid {
-			return nil
-		}
+func validateRegionName(region string) error {
+	if !isValidRegion(region) {
+		return fmt.Errorf("Not a valid region: %s", region)
 	}
-	return fmt.Errorf("Not a valid region: %s", c.Region)
+	return nil
 }
 
 // Validate credentials early and fail before we do any graph walking.
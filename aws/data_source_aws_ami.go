@@ -248,7 +248,7 @@ func dataSourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] aws_ami - Single AMI found: %s", *image.ImageId)
-	return amiDescriptionAttributes(d, image)
+	return amiDescriptionAttributes(d, meta.(*AWSClient), image)
 }
 
 // Returns the most recent AMI out of a slice of images.
@@ -257,7 +257,7 @@ func mostRecentAmi(images []*ec2.Image) *ec2.Image {
 }
 
 // populate the numerous fields that the image description returns.
-func amiDescriptionAttributes(d *schema.ResourceData, image *ec2.Image) error {
+func amiDescriptionAttributes(d *schema.ResourceData, client *AWSClient, image *ec2.Image) error {
 	// Simple attributes first
 	d.SetId(*image.ImageId)
 	d.Set("architecture", image.Architecture)
@@ -304,7 +304,7 @@ func amiDescriptionAttributes(d *schema.ResourceData, image *ec2.Image) error {
 	if err := d.Set("state_reason", amiStateReason(image.StateReason)); err != nil {
 		return err
 	}
-	if err := d.Set("tags", tagsToMap(image.Tags)); err != nil {
+	if err := d.Set("tags", client.ignoreTagsConfig.filter(tagsToMap(image.Tags))); err != nil {
 		return err
 	}
 	return nil
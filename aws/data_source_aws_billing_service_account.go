@@ -6,9 +6,6 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
-// See http://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/billing-getting-started.html#step-2
-var billingAccountId = "386209384616"
-
 func dataSourceAwsBillingServiceAccount() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceAwsBillingServiceAccountRead,
@@ -23,9 +20,15 @@ func dataSourceAwsBillingServiceAccount() *schema.Resource {
 }
 
 func dataSourceAwsBillingServiceAccountRead(d *schema.ResourceData, meta interface{}) error {
+	partition := meta.(*AWSClient).partition
+	billingAccountId, ok := awsPartitionBillingAccountIDs[partition]
+	if !ok {
+		return fmt.Errorf("Unknown partition (%q)", partition)
+	}
+
 	d.SetId(billingAccountId)
 
-	d.Set("arn", fmt.Sprintf("arn:%s:iam::%s:root", meta.(*AWSClient).partition, billingAccountId))
+	d.Set("arn", iamArnString(partition, billingAccountId, "root"))
 
 	return nil
 }
@@ -97,7 +97,7 @@ func dataSourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface
 	}
 
 	d.Set("parameters", flattenAllCloudFormationParameters(stack.Parameters))
-	d.Set("tags", flattenCloudFormationTags(stack.Tags))
+	d.Set("tags", meta.(*AWSClient).ignoreTagsConfig.filter(flattenCloudFormationTags(stack.Tags)))
 	d.Set("outputs", flattenCloudFormationOutputs(stack.Outputs))
 
 	if len(stack.Capabilities) > 0 {
@@ -134,14 +134,14 @@ func dataSourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	//Single Snapshot found so set to state
-	return snapshotDescriptionAttributes(d, snapshot)
+	return snapshotDescriptionAttributes(d, meta.(*AWSClient), snapshot)
 }
 
 func mostRecentSnapshot(snapshots []*ec2.Snapshot) *ec2.Snapshot {
 	return sortSnapshots(snapshots)[0]
 }
 
-func snapshotDescriptionAttributes(d *schema.ResourceData, snapshot *ec2.Snapshot) error {
+func snapshotDescriptionAttributes(d *schema.ResourceData, client *AWSClient, snapshot *ec2.Snapshot) error {
 	d.SetId(*snapshot.SnapshotId)
 	d.Set("snapshot_id", snapshot.SnapshotId)
 	d.Set("volume_id", snapshot.VolumeId)
@@ -154,7 +154,7 @@ func snapshotDescriptionAttributes(d *schema.ResourceData, snapshot *ec2.Snapsho
 	d.Set("owner_id", snapshot.OwnerId)
 	d.Set("owner_alias", snapshot.OwnerAlias)
 
-	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
+	if err := d.Set("tags", client.ignoreTagsConfig.filter(tagsToMap(snapshot.Tags))); err != nil {
 		return err
 	}
 
@@ -39,6 +39,24 @@ func dataSourceAwsEcsTaskDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"execution_role_arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cpu": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"memory": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"requires_compatibilities": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -62,6 +80,13 @@ func dataSourceAwsEcsTaskDefinitionRead(d *schema.ResourceData, meta interface{}
 	d.Set("revision", aws.Int64Value(taskDefinition.Revision))
 	d.Set("status", aws.StringValue(taskDefinition.Status))
 	d.Set("task_role_arn", aws.StringValue(taskDefinition.TaskRoleArn))
+	d.Set("execution_role_arn", aws.StringValue(taskDefinition.ExecutionRoleArn))
+	d.Set("cpu", aws.StringValue(taskDefinition.Cpu))
+	d.Set("memory", aws.StringValue(taskDefinition.Memory))
+
+	if err := d.Set("requires_compatibilities", flattenStringList(taskDefinition.RequiresCompatibilities)); err != nil {
+		return fmt.Errorf("Error setting requires_compatibilities: %s", err)
+	}
 
 	if d.Id() == "" {
 		return fmt.Errorf("task definition %q not found", d.Get("task_definition").(string))
@@ -99,7 +99,7 @@ func dataSourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	err = d.Set("tags", tagsToMapEFS(tags))
+	err = d.Set("tags", meta.(*AWSClient).ignoreTagsConfig.filter(tagsToMapEFS(tags)))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,184 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceAwsEksCluster() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEksClusterRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEksClusterName,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"certificate_authority": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled_cluster_log_types": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"oidc": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"issuer": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"platform_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tagsSchemaComputed(),
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"endpoint_private_access": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"endpoint_public_access": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"public_access_cidrs": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsEksClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).eksconn
+	name := d.Get("name").(string)
+
+	out, err := conn.DescribeCluster(&eks.DescribeClusterInput{
+		Name: aws.String(name),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading EKS cluster (%s): %s", name, err)
+	}
+
+	cluster := out.Cluster
+	if cluster == nil {
+		return fmt.Errorf("EKS cluster (%s) not found", name)
+	}
+
+	d.SetId(name)
+	d.Set("arn", cluster.Arn)
+	d.Set("endpoint", cluster.Endpoint)
+	d.Set("platform_version", cluster.PlatformVersion)
+	d.Set("role_arn", cluster.RoleArn)
+	d.Set("status", cluster.Status)
+	d.Set("version", cluster.Version)
+
+	if cluster.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(cluster.CreatedAt).Format(time.RFC3339))
+	}
+
+	if err := d.Set("certificate_authority", flattenEksCertificate(cluster.CertificateAuthority)); err != nil {
+		return fmt.Errorf("Error setting certificate_authority: %s", err)
+	}
+
+	if err := d.Set("enabled_cluster_log_types", flattenEksEnabledLogTypes(cluster.Logging)); err != nil {
+		return fmt.Errorf("Error setting enabled_cluster_log_types: %s", err)
+	}
+
+	if err := d.Set("identity", flattenEksIdentity(cluster.Identity)); err != nil {
+		return fmt.Errorf("Error setting identity: %s", err)
+	}
+
+	if err := d.Set("tags", meta.(*AWSClient).ignoreTagsConfig.filter(tagsToMapGeneric(cluster.Tags))); err != nil {
+		return fmt.Errorf("Error setting tags: %s", err)
+	}
+
+	if err := d.Set("vpc_config", flattenEksVpcConfigResponse(cluster.ResourcesVpcConfig)); err != nil {
+		return fmt.Errorf("Error setting vpc_config: %s", err)
+	}
+
+	return nil
+}
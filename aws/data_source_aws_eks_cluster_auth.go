@@ -0,0 +1,70 @@
+package aws
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	// eksClusterAuthTokenPrefix marks a bearer token as a presigned STS
+	// request for the AWS IAM authenticator running in the cluster.
+	eksClusterAuthTokenPrefix = "k8s-aws-v1."
+
+	// eksClusterAuthTokenPresignDuration matches aws-iam-authenticator. The
+	// authenticator itself accepts the token for 15 minutes after signing.
+	eksClusterAuthTokenPresignDuration = 60 * time.Second
+)
+
+func dataSourceAwsEksClusterAuth() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsEksClusterAuthRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateEksClusterName,
+			},
+
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceAwsEksClusterAuthRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).stsconn
+	name := d.Get("name").(string)
+
+	token, err := eksClusterAuthToken(conn, name)
+	if err != nil {
+		return fmt.Errorf("Error generating token for EKS cluster (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("token", token)
+
+	return nil
+}
+
+// eksClusterAuthToken builds a Kubernetes bearer token for the named cluster
+// the same way aws-iam-authenticator does: a presigned sts:GetCallerIdentity
+// URL, bound to the cluster through the signed x-k8s-aws-id header.
+func eksClusterAuthToken(conn *sts.STS, clusterName string) (string, error) {
+	req, _ := conn.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+	req.HTTPRequest.Header.Add("x-k8s-aws-id", clusterName)
+
+	presigned, err := req.Presign(eksClusterAuthTokenPresignDuration)
+	if err != nil {
+		return "", err
+	}
+
+	return eksClusterAuthTokenPrefix + base64.RawURLEncoding.EncodeToString([]byte(presigned)), nil
+}
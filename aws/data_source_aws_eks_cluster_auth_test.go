@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestEksClusterAuthToken(t *testing.T) {
+	sess := session.Must(session.NewSession(&aws.Config{
+		Region:      aws.String("us-west-2"),
+		Credentials: credentials.NewStaticCredentials("accessKey", "secretKey", ""),
+	}))
+
+	token, err := eksClusterAuthToken(sts.New(sess), "example")
+	if err != nil {
+		t.Fatalf("Error generating token: %s", err)
+	}
+
+	if !strings.HasPrefix(token, eksClusterAuthTokenPrefix) {
+		t.Fatalf("Expected token to start with %q, got %q", eksClusterAuthTokenPrefix, token)
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(token, eksClusterAuthTokenPrefix))
+	if err != nil {
+		t.Fatalf("Error decoding token: %s", err)
+	}
+
+	u, err := url.Parse(string(decoded))
+	if err != nil {
+		t.Fatalf("Error parsing presigned URL: %s", err)
+	}
+
+	q := u.Query()
+	if q.Get("Action") != "GetCallerIdentity" {
+		t.Fatalf("Expected GetCallerIdentity action, got %q", q.Get("Action"))
+	}
+	if !strings.Contains(q.Get("X-Amz-SignedHeaders"), "x-k8s-aws-id") {
+		t.Fatalf("Expected x-k8s-aws-id to be a signed header, got %q", q.Get("X-Amz-SignedHeaders"))
+	}
+	if q.Get("X-Amz-Expires") != "60" {
+		t.Fatalf("Expected presigned URL to expire after 60 seconds, got %q", q.Get("X-Amz-Expires"))
+	}
+}
+
+func TestAccAWSEksClusterAuthDataSource_basic(t *testing.T) {
+	dataSourceResourceName := "data.aws_eks_cluster_auth.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAWSEksClusterAuthConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceResourceName, "name", "foobar"),
+					testAccCheckAWSEksClusterAuthToken(dataSourceResourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEksClusterAuthToken(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if !strings.HasPrefix(rs.Primary.Attributes["token"], eksClusterAuthTokenPrefix) {
+			return fmt.Errorf("Unexpected token format for %s", n)
+		}
+
+		return nil
+	}
+}
+
+const testAccCheckAWSEksClusterAuthConfig_basic = `
+data "aws_eks_cluster_auth" "test" {
+  name = "foobar"
+}
+`
@@ -229,7 +229,7 @@ func dataSourceAwsElastiCacheClusterRead(d *schema.ResourceData, meta interface{
 	if len(tagResp.TagList) > 0 {
 		et = tagResp.TagList
 	}
-	d.Set("tags", tagsToMapEC(et))
+	d.Set("tags", meta.(*AWSClient).ignoreTagsConfig.filter(tagsToMapEC(et)))
 
 	return nil
 
@@ -42,6 +42,50 @@ func dataSourceAwsInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"cpu_core_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"cpu_threads_per_core": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"credit_specification": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_credits": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"hibernation": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"metadata_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"http_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"http_tokens": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"http_put_response_hop_limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"key_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -266,11 +310,11 @@ func dataSourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] aws_instance - Single Instance ID found: %s", *instance.InstanceId)
-	return instanceDescriptionAttributes(d, instance, conn)
+	return instanceDescriptionAttributes(d, meta.(*AWSClient), instance, conn)
 }
 
 // Populate instance attribute fields with the returned instance
-func instanceDescriptionAttributes(d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2) error {
+func instanceDescriptionAttributes(d *schema.ResourceData, client *AWSClient, instance *ec2.Instance, conn *ec2.EC2) error {
 	d.SetId(*instance.InstanceId)
 	// Set the easy attributes
 	d.Set("instance_state", instance.State.Name)
@@ -313,7 +357,28 @@ func instanceDescriptionAttributes(d *schema.ResourceData, instance *ec2.Instanc
 		d.Set("monitoring", monitoringState == "enabled" || monitoringState == "pending")
 	}
 
-	d.Set("tags", tagsToMap(instance.Tags))
+	if instance.CpuOptions != nil {
+		d.Set("cpu_core_count", instance.CpuOptions.CoreCount)
+		d.Set("cpu_threads_per_core", instance.CpuOptions.ThreadsPerCore)
+	}
+
+	if instance.HibernationOptions != nil {
+		d.Set("hibernation", instance.HibernationOptions.Configured)
+	}
+
+	if err := d.Set("metadata_options", flattenEc2InstanceMetadataOptions(instance.MetadataOptions)); err != nil {
+		return fmt.Errorf("Error setting metadata_options: %s", err)
+	}
+
+	creditSpecifications, err := readInstanceCreditSpecifications(conn, instance)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("credit_specification", creditSpecifications); err != nil {
+		return fmt.Errorf("Error setting credit_specification: %s", err)
+	}
+
+	d.Set("tags", client.ignoreTagsConfig.filter(tagsToMap(instance.Tags)))
 
 	// Security Groups
 	if err := readSecurityGroups(d, instance, conn); err != nil {
@@ -26,6 +26,27 @@ func TestAccAWSInstanceDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstanceDataSource_metadataOptions(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceDataSourceConfig_metadataOptions(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.aws_instance.test", "instance_type", "aws_instance.foo", "instance_type"),
+					resource.TestCheckResourceAttr("data.aws_instance.test", "credit_specification.0.cpu_credits", "unlimited"),
+					resource.TestCheckResourceAttr("data.aws_instance.test", "metadata_options.0.http_tokens", "required"),
+					resource.TestCheckResourceAttr("data.aws_instance.test", "metadata_options.0.http_put_response_hop_limit", "2"),
+					resource.TestCheckResourceAttr("data.aws_instance.test", "hibernation", "false"),
+					resource.TestCheckResourceAttrSet("data.aws_instance.test", "cpu_core_count"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSInstanceDataSource_tags(t *testing.T) {
 	rInt := acctest.RandInt()
 	resource.Test(t, resource.TestCase{
@@ -502,3 +523,11 @@ data "aws_instance" "foo" {
   instance_id = "${aws_instance.foo_instance.id}"
 }
 `
+
+func testAccInstanceDataSourceConfig_metadataOptions(rInt int) string {
+	return testAccInstanceConfigMetadataOptions(rInt, "required", 2) + `
+data "aws_instance" "test" {
+  instance_id = "${aws_instance.foo.id}"
+}
+`
+}
@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSLaunchTemplateDataSource_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-lt-%s", acctest.RandString(8))
+	dataSourceName := "data.aws_launch_template.foo"
+	resourceName := "aws_launch_template.foo"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "default_version", resourceName, "default_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "latest_version", resourceName, "latest_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "image_id", resourceName, "image_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instance_type", resourceName, "instance_type"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLaunchTemplateDataSourceConfig_basic(rName string) string {
+	return testAccAWSLaunchTemplateConfig_basic(rName) + `
+data "aws_launch_template" "foo" {
+  name = "${aws_launch_template.foo.name}"
+}
+`
+}
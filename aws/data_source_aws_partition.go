@@ -16,6 +16,10 @@ func dataSourceAwsPartition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"dns_suffix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -29,5 +33,9 @@ func dataSourceAwsPartitionRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Setting AWS Partition to %s.", client.partition)
 	d.Set("partition", meta.(*AWSClient).partition)
 
+	if p, ok := partitionForRegion(client.region); ok {
+		d.Set("dns_suffix", partitionDNSSuffix(p))
+	}
+
 	return nil
 }
@@ -20,6 +20,27 @@ func dataSourceAwsVpc() *schema.Resource {
 				Computed: true,
 			},
 
+			"cidr_block_associations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"association_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"dhcp_options_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -139,7 +160,19 @@ func dataSourceAwsVpcRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("instance_tenancy", vpc.InstanceTenancy)
 	d.Set("default", vpc.IsDefault)
 	d.Set("state", vpc.State)
-	d.Set("tags", tagsToMap(vpc.Tags))
+	d.Set("tags", meta.(*AWSClient).ignoreTagsConfig.filter(tagsToMap(vpc.Tags)))
+
+	cidrAssociations := []interface{}{}
+	for _, association := range vpc.CidrBlockAssociationSet {
+		cidrAssociations = append(cidrAssociations, map[string]interface{}{
+			"association_id": aws.StringValue(association.AssociationId),
+			"cidr_block":     aws.StringValue(association.CidrBlock),
+			"state":          aws.StringValue(association.CidrBlockState.State),
+		})
+	}
+	if err := d.Set("cidr_block_associations", cidrAssociations); err != nil {
+		return err
+	}
 
 	if vpc.Ipv6CidrBlockAssociationSet != nil {
 		d.Set("ipv6_association_id", vpc.Ipv6CidrBlockAssociationSet[0].AssociationId)
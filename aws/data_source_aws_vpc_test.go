@@ -30,6 +30,10 @@ func TestAccDataSourceAwsVpc_basic(t *testing.T) {
 						"data.aws_vpc.by_id", "enable_dns_support", "true"),
 					resource.TestCheckResourceAttr(
 						"data.aws_vpc.by_id", "enable_dns_hostnames", "false"),
+					resource.TestCheckResourceAttr(
+						"data.aws_vpc.by_id", "cidr_block_associations.#", "1"),
+					resource.TestCheckResourceAttr(
+						"data.aws_vpc.by_id", "cidr_block_associations.0.cidr_block", cidr),
 				),
 			},
 		},
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/jen20/awspolicyequivalence"
 )
@@ -85,3 +86,18 @@ func suppressAutoscalingGroupAvailabilityZoneDiffs(k, old, new string, d *schema
 
 	return false
 }
+
+// suppressEcsServiceControllerDeployedDiffs suppresses changes to the arguments
+// of an existing ECS service using the CODE_DEPLOY or EXTERNAL deployment
+// controller which can only be changed by deploying through the controller.
+func suppressEcsServiceControllerDeployedDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+
+	if v := d.Get("deployment_controller").([]interface{}); len(v) > 0 && v[0] != nil {
+		return v[0].(map[string]interface{})["type"].(string) != ecs.DeploymentControllerTypeEcs
+	}
+
+	return false
+}
@@ -0,0 +1,67 @@
+package aws
+
+// endpointServiceNames lists the keys accepted by the provider's endpoints
+// block. Each names the service client built in Config.Client() that the
+// endpoint applies to; adding a client only requires adding its name here
+// and building it with Config.sessionForService.
+var endpointServiceNames = []string{
+	"acm",
+	"apigateway",
+	"applicationautoscaling",
+	"athena",
+	"autoscaling",
+	"batch",
+	"cloudformation",
+	"cloudfront",
+	"cloudtrail",
+	"cloudwatch",
+	"cloudwatchevents",
+	"cloudwatchlogs",
+	"codebuild",
+	"codecommit",
+	"codedeploy",
+	"codepipeline",
+	"cognitoidentity",
+	"cognitoidp",
+	"configservice",
+	"devicefarm",
+	"directconnect",
+	"dms",
+	"ds",
+	"dynamodb",
+	"ec2",
+	"ecr",
+	"ecs",
+	"efs",
+	"eks",
+	"elasticache",
+	"elasticbeanstalk",
+	"elastictranscoder",
+	"elb",
+	"emr",
+	"es",
+	"firehose",
+	"glacier",
+	"iam",
+	"inspector",
+	"iot",
+	"kinesis",
+	"kms",
+	"lambda",
+	"lightsail",
+	"opsworks",
+	"rds",
+	"redshift",
+	"route53",
+	"s3",
+	"servicecatalog",
+	"ses",
+	"sfn",
+	"simpledb",
+	"sns",
+	"sqs",
+	"ssm",
+	"sts",
+	"waf",
+	"wafregional",
+}
@@ -0,0 +1,49 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestAWSConfigEndpoints(t *testing.T) {
+	for _, name := range endpointServiceNames {
+		endpoint := fmt.Sprintf("http://localhost:4567/%s", name)
+		c := &Config{
+			AccessKey:               "accessKey",
+			SecretKey:               "secretKey",
+			Region:                  "us-east-1",
+			SkipCredsValidation:     true,
+			SkipGetEC2Platforms:     true,
+			SkipRequestingAccountId: true,
+			SkipMetadataApiCheck:    true,
+			Endpoints:               map[string]string{name: endpoint},
+		}
+
+		raw, err := c.Client()
+		if err != nil {
+			t.Fatalf("%s: error creating client: %s", name, err)
+		}
+
+		var overridden []string
+		v := reflect.ValueOf(raw).Elem()
+		for i := 0; i < v.NumField(); i++ {
+			conn := v.Field(i)
+			if conn.Kind() != reflect.Ptr || conn.IsNil() || conn.Elem().Kind() != reflect.Struct {
+				continue
+			}
+			svc := conn.Elem().FieldByName("Client")
+			if !svc.IsValid() || svc.IsNil() {
+				continue
+			}
+
+			if svc.Elem().FieldByName("ClientInfo").FieldByName("Endpoint").String() == endpoint {
+				overridden = append(overridden, v.Type().Field(i).Name)
+			}
+		}
+
+		if len(overridden) == 0 {
+			t.Fatalf("%s: no service client uses the configured endpoint", name)
+		}
+	}
+}
@@ -0,0 +1,669 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// ImportGeneratorOptions controls which existing infrastructure is
+// enumerated by GenerateImports.
+type ImportGeneratorOptions struct {
+	// ResourceTypes limits the enumeration to the given resource types.
+	// All supported resource types are enumerated when it is empty.
+	ResourceTypes []string
+
+	// Filters are passed as exact match filters to the EC2 "Describe..."
+	// calls, keyed by the filter name expected by the EC2 API, e.g. "vpc-id".
+	Filters map[string]string
+
+	// Tags restricts the enumeration to resources carrying all of the
+	// given tag key/value pairs.
+	Tags map[string]string
+}
+
+// GeneratedImport describes a single existing resource discovered by
+// GenerateImports, together with its refreshed state.
+type GeneratedImport struct {
+	Type  string
+	Name  string
+	ID    string
+	State *terraform.InstanceState
+}
+
+// Address returns the resource address used in configuration and state.
+func (i *GeneratedImport) Address() string {
+	return fmt.Sprintf("%s.%s", i.Type, i.Name)
+}
+
+// importGeneratorEnumerator lists the IDs of all existing resources of a
+// single type, suitable for passing to the resource's importer.
+type importGeneratorEnumerator func(meta interface{}, filters []*ec2.Filter) ([]string, error)
+
+// The default VPC and the default security group, network ACL and main route
+// table of each VPC are only enumerated as their aws_default_* resource
+// types, which adopt rather than create and delete them.
+var importGeneratorEnumerators = map[string]importGeneratorEnumerator{
+	"aws_customer_gateway":       enumerateAwsCustomerGateways,
+	"aws_default_network_acl":    enumerateAwsNetworkAcls(true),
+	"aws_default_route_table":    enumerateAwsRouteTables(true),
+	"aws_default_security_group": enumerateAwsSecurityGroups(true),
+	"aws_default_vpc":            enumerateAwsVpcs(true),
+	"aws_ebs_volume":             enumerateAwsEbsVolumes,
+	"aws_eip":                    enumerateAwsEips,
+	"aws_instance":               enumerateAwsInstances,
+	"aws_internet_gateway":       enumerateAwsInternetGateways,
+	"aws_nat_gateway":            enumerateAwsNatGateways,
+	"aws_network_acl":            enumerateAwsNetworkAcls(false),
+	"aws_network_interface":      enumerateAwsNetworkInterfaces,
+	"aws_route_table":            enumerateAwsRouteTables(false),
+	"aws_security_group":         enumerateAwsSecurityGroups(false),
+	"aws_subnet":                 enumerateAwsSubnets,
+	"aws_vpc":                    enumerateAwsVpcs(false),
+	"aws_vpc_dhcp_options":       enumerateAwsVpcDhcpOptions,
+	"aws_vpn_gateway":            enumerateAwsVpnGateways,
+}
+
+// ImportGeneratorResourceTypes returns the sorted list of resource types
+// that GenerateImports is able to enumerate.
+func ImportGeneratorResourceTypes() []string {
+	types := make([]string, 0, len(importGeneratorEnumerators))
+	for t := range importGeneratorEnumerators {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// GenerateImports enumerates the existing resources reachable with the
+// configured provider p and imports and refreshes each of them through the
+// resource's own importer and Read function, exactly as
+// "terraform import" would.
+//
+// Importers returning additional resources of a different type (such as
+// the security group rules returned alongside a security group) only
+// contribute the resource of the requested type.
+func GenerateImports(p *schema.Provider, opts ImportGeneratorOptions) ([]*GeneratedImport, error) {
+	types := opts.ResourceTypes
+	if len(types) == 0 {
+		types = ImportGeneratorResourceTypes()
+	}
+
+	attrs := make(map[string]string, len(opts.Filters)+len(opts.Tags))
+	for k, v := range opts.Filters {
+		attrs[k] = v
+	}
+	for k, v := range opts.Tags {
+		attrs[fmt.Sprintf("tag:%s", k)] = v
+	}
+	filters := buildEC2AttributeFilterList(attrs)
+
+	var imports []*GeneratedImport
+	names := make(map[string]int)
+	for _, t := range types {
+		enumerate, ok := importGeneratorEnumerators[t]
+		if !ok {
+			return nil, fmt.Errorf("resource type %q is not supported by the import generator", t)
+		}
+
+		ids, err := enumerate(p.Meta(), filters)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing %s resources: %s", t, err)
+		}
+
+		for _, id := range ids {
+			info := &terraform.InstanceInfo{Id: id, Type: t}
+
+			log.Printf("[DEBUG] Importing %s %q", t, id)
+			states, err := p.ImportState(info, id)
+			if err != nil {
+				return nil, fmt.Errorf("Error importing %s %q: %s", t, id, err)
+			}
+
+			for _, s := range states {
+				if s.Ephemeral.Type != "" && s.Ephemeral.Type != t {
+					continue
+				}
+
+				state, err := p.Refresh(info, s)
+				if err != nil {
+					return nil, fmt.Errorf("Error reading %s %q: %s", t, id, err)
+				}
+				if state == nil || state.ID == "" {
+					log.Printf("[WARN] %s %q disappeared while importing, skipping", t, id)
+					continue
+				}
+				state.Ephemeral = terraform.EphemeralState{}
+
+				imports = append(imports, &GeneratedImport{
+					Type:  t,
+					Name:  importGeneratorResourceName(names, t, state),
+					ID:    state.ID,
+					State: state,
+				})
+			}
+		}
+	}
+
+	return imports, nil
+}
+
+var importGeneratorInvalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// importGeneratorResourceName derives a unique resource name from the Name
+// tag of the resource, falling back to its ID.
+func importGeneratorResourceName(seen map[string]int, t string, s *terraform.InstanceState) string {
+	name := s.Attributes["tags.Name"]
+	if name == "" {
+		name = s.ID
+	}
+
+	name = importGeneratorInvalidNameChars.ReplaceAllString(strings.ToLower(name), "_")
+	name = strings.Trim(name, "_")
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "r_" + name
+	}
+
+	key := t + "." + name
+	seen[key]++
+	if n := seen[key]; n > 1 {
+		name = fmt.Sprintf("%s_%d", name, n)
+	}
+
+	return name
+}
+
+// WriteImportConfig writes a resource block for each of the given imports.
+// Only arguments that can be set in configuration are written, so that
+// planning against the generated state shows no changes.
+func WriteImportConfig(w io.Writer, p *schema.Provider, imports []*GeneratedImport) error {
+	for i, imp := range imports {
+		r, ok := p.ResourcesMap[imp.Type]
+		if !ok {
+			return fmt.Errorf("unknown resource type: %s", imp.Type)
+		}
+
+		var buf bytes.Buffer
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(&buf, "resource %q %q {\n", imp.Type, imp.Name)
+		writeImportConfigBody(&buf, r.Schema, r.Data(imp.State).Get, 1)
+		buf.WriteString("}\n")
+
+		if _, err := buf.WriteTo(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteImportCommands writes a "terraform import" command for each of the
+// given imports.
+func WriteImportCommands(w io.Writer, imports []*GeneratedImport) error {
+	for _, imp := range imports {
+		if _, err := fmt.Fprintf(w, "terraform import %s %s\n", imp.Address(), imp.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteImportState writes a state file containing all of the given imports.
+func WriteImportState(w io.Writer, imports []*GeneratedImport) error {
+	state := terraform.NewState()
+	mod := state.RootModule()
+	for _, imp := range imports {
+		mod.Resources[imp.Address()] = &terraform.ResourceState{
+			Type:     imp.Type,
+			Primary:  imp.State,
+			Provider: "provider.aws",
+		}
+	}
+	return terraform.WriteState(state, w)
+}
+
+func writeImportConfigBody(buf *bytes.Buffer, s map[string]*schema.Schema, get func(string) interface{}, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	type attr struct {
+		key, value string
+	}
+	var attrs []attr
+	var blocks []string
+	conflicts := make(map[string]bool)
+
+	for _, k := range keys {
+		sch := s[k]
+		if (!sch.Required && !sch.Optional) || sch.Deprecated != "" || sch.Removed != "" || conflicts[k] {
+			continue
+		}
+
+		v := get(k)
+		if v == nil || (!sch.Required && importConfigIsEmpty(sch, v)) {
+			continue
+		}
+		for _, c := range sch.ConflictsWith {
+			conflicts[c] = true
+		}
+
+		switch sch.Type {
+		case schema.TypeMap:
+			var block bytes.Buffer
+			fmt.Fprintf(&block, "%s%s {\n", indent, k)
+			m := v.(map[string]interface{})
+			mkeys := make([]string, 0, len(m))
+			width := 0
+			for mk := range m {
+				mkeys = append(mkeys, mk)
+				if l := len(importConfigKey(mk)); l > width {
+					width = l
+				}
+			}
+			sort.Strings(mkeys)
+			for _, mk := range mkeys {
+				fmt.Fprintf(&block, "%s  %-*s = %s\n", indent, width, importConfigKey(mk), importConfigValue(m[mk]))
+			}
+			fmt.Fprintf(&block, "%s}\n", indent)
+			blocks = append(blocks, block.String())
+
+		case schema.TypeList, schema.TypeSet:
+			elem, ok := sch.Elem.(*schema.Resource)
+			if !ok {
+				attrs = append(attrs, attr{k, importConfigValue(v)})
+				continue
+			}
+
+			for _, item := range importConfigList(v) {
+				m, _ := item.(map[string]interface{})
+				var block bytes.Buffer
+				fmt.Fprintf(&block, "%s%s {\n", indent, k)
+				writeImportConfigBody(&block, elem.Schema, func(key string) interface{} {
+					return m[key]
+				}, depth+1)
+				fmt.Fprintf(&block, "%s}\n", indent)
+				blocks = append(blocks, block.String())
+			}
+
+		default:
+			attrs = append(attrs, attr{k, importConfigValue(v)})
+		}
+	}
+
+	width := 0
+	for _, a := range attrs {
+		if len(a.key) > width {
+			width = len(a.key)
+		}
+	}
+	for _, a := range attrs {
+		fmt.Fprintf(buf, "%s%-*s = %s\n", indent, width, a.key, a.value)
+	}
+	for i, b := range blocks {
+		if i > 0 || len(attrs) > 0 {
+			buf.WriteString("\n")
+		}
+		buf.WriteString(b)
+	}
+}
+
+// importConfigIsEmpty reports whether v is the zero or default value of an
+// optional attribute, in which case it is left out of the configuration.
+func importConfigIsEmpty(s *schema.Schema, v interface{}) bool {
+	if s.Default != nil && reflect.DeepEqual(s.Default, v) {
+		return true
+	}
+
+	switch s.Type {
+	case schema.TypeList, schema.TypeSet:
+		return len(importConfigList(v)) == 0
+	case schema.TypeMap:
+		m, ok := v.(map[string]interface{})
+		return !ok || len(m) == 0
+	}
+
+	return reflect.DeepEqual(v, reflect.Zero(reflect.TypeOf(v)).Interface())
+}
+
+func importConfigList(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+	return nil
+}
+
+var importConfigIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+func importConfigKey(k string) string {
+	if importConfigIdentifier.MatchString(k) {
+		return k
+	}
+	return strconv.Quote(k)
+}
+
+func importConfigValue(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return strings.Replace(strconv.Quote(v), "${", "$${", -1)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case *schema.Set, []interface{}:
+		items := importConfigList(v)
+		values := make([]string, len(items))
+		for i, item := range items {
+			values[i] = importConfigValue(item)
+		}
+		return "[" + strings.Join(values, ", ") + "]"
+	}
+	return strconv.Quote(fmt.Sprintf("%v", v))
+}
+
+func enumerateAwsCustomerGateways(meta interface{}, filters []*ec2.Filter) ([]string, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	resp, err := conn.DescribeCustomerGateways(&ec2.DescribeCustomerGatewaysInput{
+		Filters: filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, cgw := range resp.CustomerGateways {
+		if aws.StringValue(cgw.State) == "deleted" {
+			continue
+		}
+		ids = append(ids, aws.StringValue(cgw.CustomerGatewayId))
+	}
+	return ids, nil
+}
+
+func enumerateAwsEbsVolumes(meta interface{}, filters []*ec2.Filter) ([]string, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	var ids []string
+	err := conn.DescribeVolumesPages(&ec2.DescribeVolumesInput{
+		Filters: filters,
+	}, func(page *ec2.DescribeVolumesOutput, lastPage bool) bool {
+		for _, v := range page.Volumes {
+			ids = append(ids, aws.StringValue(v.VolumeId))
+		}
+		return !lastPage
+	})
+	return ids, err
+}
+
+func enumerateAwsEips(meta interface{}, filters []*ec2.Filter) ([]string, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	resp, err := conn.DescribeAddresses(&ec2.DescribeAddressesInput{
+		Filters: filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, a := range resp.Addresses {
+		// EC2-Classic addresses are identified by their public IP
+		if a.AllocationId != nil {
+			ids = append(ids, aws.StringValue(a.AllocationId))
+		} else {
+			ids = append(ids, aws.StringValue(a.PublicIp))
+		}
+	}
+	return ids, nil
+}
+
+func enumerateAwsInstances(meta interface{}, filters []*ec2.Filter) ([]string, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	var ids []string
+	err := conn.DescribeInstancesPages(&ec2.DescribeInstancesInput{
+		Filters: filters,
+	}, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, r := range page.Reservations {
+			for _, i := range r.Instances {
+				if aws.StringValue(i.State.Name) == ec2.InstanceStateNameTerminated {
+					continue
+				}
+				ids = append(ids, aws.StringValue(i.InstanceId))
+			}
+		}
+		return !lastPage
+	})
+	return ids, err
+}
+
+func enumerateAwsInternetGateways(meta interface{}, filters []*ec2.Filter) ([]string, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	resp, err := conn.DescribeInternetGateways(&ec2.DescribeInternetGatewaysInput{
+		Filters: filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, ig := range resp.InternetGateways {
+		ids = append(ids, aws.StringValue(ig.InternetGatewayId))
+	}
+	return ids, nil
+}
+
+func enumerateAwsNatGateways(meta interface{}, filters []*ec2.Filter) ([]string, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	var ids []string
+	err := conn.DescribeNatGatewaysPages(&ec2.DescribeNatGatewaysInput{
+		Filter: filters,
+	}, func(page *ec2.DescribeNatGatewaysOutput, lastPage bool) bool {
+		for _, ng := range page.NatGateways {
+			if aws.StringValue(ng.State) == ec2.NatGatewayStateDeleted {
+				continue
+			}
+			ids = append(ids, aws.StringValue(ng.NatGatewayId))
+		}
+		return !lastPage
+	})
+	return ids, err
+}
+
+// enumerateAwsNetworkAcls lists either only the default network ACLs or
+// only the non-default ones.
+func enumerateAwsNetworkAcls(defaults bool) importGeneratorEnumerator {
+	return func(meta interface{}, filters []*ec2.Filter) ([]string, error) {
+		conn := meta.(*AWSClient).ec2conn
+
+		resp, err := conn.DescribeNetworkAcls(&ec2.DescribeNetworkAclsInput{
+			Filters: filters,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var ids []string
+		for _, acl := range resp.NetworkAcls {
+			if aws.BoolValue(acl.IsDefault) != defaults {
+				continue
+			}
+			ids = append(ids, aws.StringValue(acl.NetworkAclId))
+		}
+		return ids, nil
+	}
+}
+
+func enumerateAwsNetworkInterfaces(meta interface{}, filters []*ec2.Filter) ([]string, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	resp, err := conn.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+		Filters: filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, eni := range resp.NetworkInterfaces {
+		ids = append(ids, aws.StringValue(eni.NetworkInterfaceId))
+	}
+	return ids, nil
+}
+
+// enumerateAwsRouteTables lists either only the main route tables, which are
+// managed as aws_default_route_table, or only the other route tables.
+func enumerateAwsRouteTables(defaults bool) importGeneratorEnumerator {
+	return func(meta interface{}, filters []*ec2.Filter) ([]string, error) {
+		conn := meta.(*AWSClient).ec2conn
+
+		resp, err := conn.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+			Filters: filters,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var ids []string
+		for _, rt := range resp.RouteTables {
+			main := false
+			for _, a := range rt.Associations {
+				if aws.BoolValue(a.Main) {
+					main = true
+					break
+				}
+			}
+			if main != defaults {
+				continue
+			}
+			ids = append(ids, aws.StringValue(rt.RouteTableId))
+		}
+		return ids, nil
+	}
+}
+
+// enumerateAwsSecurityGroups lists either only the security groups named
+// "default", or only the other security groups.
+func enumerateAwsSecurityGroups(defaults bool) importGeneratorEnumerator {
+	return func(meta interface{}, filters []*ec2.Filter) ([]string, error) {
+		conn := meta.(*AWSClient).ec2conn
+
+		resp, err := conn.DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{
+			Filters: filters,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var ids []string
+		for _, sg := range resp.SecurityGroups {
+			if (aws.StringValue(sg.GroupName) == "default") != defaults {
+				continue
+			}
+			ids = append(ids, aws.StringValue(sg.GroupId))
+		}
+		return ids, nil
+	}
+}
+
+func enumerateAwsSubnets(meta interface{}, filters []*ec2.Filter) ([]string, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	resp, err := conn.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		Filters: filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, subnet := range resp.Subnets {
+		ids = append(ids, aws.StringValue(subnet.SubnetId))
+	}
+	return ids, nil
+}
+
+// enumerateAwsVpcs lists either only the default VPC or only the
+// non-default VPCs.
+func enumerateAwsVpcs(defaults bool) importGeneratorEnumerator {
+	return func(meta interface{}, filters []*ec2.Filter) ([]string, error) {
+		conn := meta.(*AWSClient).ec2conn
+
+		resp, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{
+			Filters: filters,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var ids []string
+		for _, vpc := range resp.Vpcs {
+			if aws.BoolValue(vpc.IsDefault) != defaults {
+				continue
+			}
+			ids = append(ids, aws.StringValue(vpc.VpcId))
+		}
+		return ids, nil
+	}
+}
+
+func enumerateAwsVpcDhcpOptions(meta interface{}, filters []*ec2.Filter) ([]string, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	resp, err := conn.DescribeDhcpOptions(&ec2.DescribeDhcpOptionsInput{
+		Filters: filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, opts := range resp.DhcpOptions {
+		ids = append(ids, aws.StringValue(opts.DhcpOptionsId))
+	}
+	return ids, nil
+}
+
+func enumerateAwsVpnGateways(meta interface{}, filters []*ec2.Filter) ([]string, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	resp, err := conn.DescribeVpnGateways(&ec2.DescribeVpnGatewaysInput{
+		Filters: filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, vgw := range resp.VpnGateways {
+		if aws.StringValue(vgw.State) == ec2.VpnStateDeleted {
+			continue
+		}
+		ids = append(ids, aws.StringValue(vgw.VpnGatewayId))
+	}
+	return ids, nil
+}
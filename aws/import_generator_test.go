@@ -0,0 +1,284 @@
+package aws
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestGenerateImports(t *testing.T) {
+	ec2Endpoints := []*awsMockEndpoint{
+		&awsMockEndpoint{
+			Request: &awsMockRequest{"POST", "/", "Action=DescribeSubnets&" +
+				"Filter.1.Name=tag%3AEnvironment&Filter.1.Value.1=production&Version=2016-11-15"},
+			Response: &awsMockResponse{200, test_ec2_describeSubnets_response, "text/xml"},
+		},
+		&awsMockEndpoint{
+			Request: &awsMockRequest{"POST", "/", "Action=DescribeSubnets&" +
+				"SubnetId.1=subnet-9d4a7b6c&Version=2016-11-15"},
+			Response: &awsMockResponse{200, test_ec2_describeSubnets_response, "text/xml"},
+		},
+		&awsMockEndpoint{
+			Request: &awsMockRequest{"POST", "/", "Action=DescribeInternetGateways&" +
+				"Filter.1.Name=tag%3AEnvironment&Filter.1.Value.1=production&Version=2016-11-15"},
+			Response: &awsMockResponse{200, test_ec2_describeInternetGateways_response, "text/xml"},
+		},
+		&awsMockEndpoint{
+			Request: &awsMockRequest{"POST", "/", "Action=DescribeInternetGateways&" +
+				"InternetGatewayId.1=igw-c0a643a9&Version=2016-11-15"},
+			Response: &awsMockResponse{200, test_ec2_describeInternetGateways_response, "text/xml"},
+		},
+	}
+	closeFunc, sess, err := getMockedAwsApiSession("EC2", ec2Endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	p := Provider().(*schema.Provider)
+	p.SetMeta(&AWSClient{
+		ec2conn: ec2.New(sess),
+		region:  "us-east-1",
+	})
+
+	imports, err := GenerateImports(p, ImportGeneratorOptions{
+		ResourceTypes: []string{"aws_subnet", "aws_internet_gateway"},
+		Tags:          map[string]string{"Environment": "production"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, received: %s", err)
+	}
+
+	var config bytes.Buffer
+	if err := WriteImportConfig(&config, p, imports); err != nil {
+		t.Fatal(err)
+	}
+	expectedConfig := `resource "aws_subnet" "public_a" {
+  availability_zone = "us-east-1a"
+  cidr_block        = "10.1.1.0/24"
+  vpc_id            = "vpc-1a2b3c4d"
+
+  tags {
+    Environment = "production"
+    Name        = "public-a"
+  }
+}
+
+resource "aws_internet_gateway" "igw_c0a643a9" {
+  vpc_id = "vpc-1a2b3c4d"
+
+  tags {
+    Environment = "production"
+  }
+}
+`
+	if config.String() != expectedConfig {
+		t.Fatalf("Received config:\n%s\nExpected:\n%s", config.String(), expectedConfig)
+	}
+
+	var commands bytes.Buffer
+	if err := WriteImportCommands(&commands, imports); err != nil {
+		t.Fatal(err)
+	}
+	expectedCommands := `terraform import aws_subnet.public_a subnet-9d4a7b6c
+terraform import aws_internet_gateway.igw_c0a643a9 igw-c0a643a9
+`
+	if commands.String() != expectedCommands {
+		t.Fatalf("Received commands:\n%s\nExpected:\n%s", commands.String(), expectedCommands)
+	}
+
+	var state bytes.Buffer
+	if err := WriteImportState(&state, imports); err != nil {
+		t.Fatal(err)
+	}
+	s, err := terraform.ReadState(&state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs, ok := s.RootModule().Resources["aws_subnet.public_a"]
+	if !ok {
+		t.Fatalf("Expected aws_subnet.public_a in state, received: %s", s)
+	}
+	if rs.Primary.ID != "subnet-9d4a7b6c" || rs.Primary.Attributes["cidr_block"] != "10.1.1.0/24" {
+		t.Fatalf("Unexpected subnet state: %s", rs.Primary)
+	}
+}
+
+func TestGenerateImports_unsupported(t *testing.T) {
+	p := Provider().(*schema.Provider)
+	_, err := GenerateImports(p, ImportGeneratorOptions{
+		ResourceTypes: []string{"aws_s3_bucket"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Fatalf("Expected unsupported resource type error, received: %v", err)
+	}
+}
+
+func TestGenerateImports_defaults(t *testing.T) {
+	ec2Endpoints := []*awsMockEndpoint{
+		&awsMockEndpoint{
+			Request:  &awsMockRequest{"POST", "/", "Action=DescribeVpcs&Version=2016-11-15"},
+			Response: &awsMockResponse{200, test_ec2_describeVpcs_response, "text/xml"},
+		},
+		&awsMockEndpoint{
+			Request:  &awsMockRequest{"POST", "/", "Action=DescribeRouteTables&Version=2016-11-15"},
+			Response: &awsMockResponse{200, test_ec2_describeRouteTables_response, "text/xml"},
+		},
+	}
+	closeFunc, sess, err := getMockedAwsApiSession("EC2", ec2Endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	meta := &AWSClient{
+		ec2conn: ec2.New(sess),
+		region:  "us-east-1",
+	}
+
+	cases := []struct {
+		Type     string
+		Expected []string
+	}{
+		{"aws_vpc", []string{"vpc-1a2b3c4d"}},
+		{"aws_default_vpc", []string{"vpc-5e6f7a8b"}},
+		{"aws_route_table", []string{"rtb-22574640"}},
+		{"aws_default_route_table", []string{"rtb-f9ad4890"}},
+	}
+
+	for _, tc := range cases {
+		ids, err := importGeneratorEnumerators[tc.Type](meta, nil)
+		if err != nil {
+			t.Fatalf("%s: %s", tc.Type, err)
+		}
+		if !reflect.DeepEqual(ids, tc.Expected) {
+			t.Fatalf("%s: expected %q, received %q", tc.Type, tc.Expected, ids)
+		}
+	}
+}
+
+func TestImportGeneratorResourceName(t *testing.T) {
+	seen := make(map[string]int)
+	cases := []struct {
+		Attributes map[string]string
+		Expected   string
+	}{
+		{map[string]string{"tags.Name": "Public Subnet (a)"}, "public_subnet_a"},
+		{map[string]string{"tags.Name": "public-subnet-a"}, "public_subnet_a_2"},
+		{map[string]string{"tags.Name": "2nd"}, "r_2nd"},
+		{map[string]string{}, "subnet_12345678"},
+	}
+
+	for _, tc := range cases {
+		s := &terraform.InstanceState{ID: "subnet-12345678", Attributes: tc.Attributes}
+		name := importGeneratorResourceName(seen, "aws_subnet", s)
+		if name != tc.Expected {
+			t.Fatalf("Expected %q, received %q", tc.Expected, name)
+		}
+	}
+}
+
+var test_ec2_describeSubnets_response = `<DescribeSubnetsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
+  <subnetSet>
+    <item>
+      <subnetId>subnet-9d4a7b6c</subnetId>
+      <state>available</state>
+      <vpcId>vpc-1a2b3c4d</vpcId>
+      <cidrBlock>10.1.1.0/24</cidrBlock>
+      <ipv6CidrBlockAssociationSet/>
+      <availableIpAddressCount>251</availableIpAddressCount>
+      <availabilityZone>us-east-1a</availabilityZone>
+      <defaultForAz>false</defaultForAz>
+      <mapPublicIpOnLaunch>false</mapPublicIpOnLaunch>
+      <assignIpv6AddressOnCreation>false</assignIpv6AddressOnCreation>
+      <tagSet>
+        <item>
+          <key>Environment</key>
+          <value>production</value>
+        </item>
+        <item>
+          <key>Name</key>
+          <value>public-a</value>
+        </item>
+      </tagSet>
+    </item>
+  </subnetSet>
+</DescribeSubnetsResponse>`
+
+var test_ec2_describeInternetGateways_response = `<DescribeInternetGatewaysResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>59dbff89-35bd-4eac-99ed-be587EXAMPLE</requestId>
+  <internetGatewaySet>
+    <item>
+      <internetGatewayId>igw-c0a643a9</internetGatewayId>
+      <attachmentSet>
+        <item>
+          <vpcId>vpc-1a2b3c4d</vpcId>
+          <state>available</state>
+        </item>
+      </attachmentSet>
+      <tagSet>
+        <item>
+          <key>Environment</key>
+          <value>production</value>
+        </item>
+      </tagSet>
+    </item>
+  </internetGatewaySet>
+</DescribeInternetGatewaysResponse>`
+
+var test_ec2_describeVpcs_response = `<DescribeVpcsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
+  <vpcSet>
+    <item>
+      <vpcId>vpc-1a2b3c4d</vpcId>
+      <state>available</state>
+      <cidrBlock>10.1.0.0/16</cidrBlock>
+      <dhcpOptionsId>dopt-7a8b9c2d</dhcpOptionsId>
+      <instanceTenancy>default</instanceTenancy>
+      <isDefault>false</isDefault>
+    </item>
+    <item>
+      <vpcId>vpc-5e6f7a8b</vpcId>
+      <state>available</state>
+      <cidrBlock>172.31.0.0/16</cidrBlock>
+      <dhcpOptionsId>dopt-7a8b9c2d</dhcpOptionsId>
+      <instanceTenancy>default</instanceTenancy>
+      <isDefault>true</isDefault>
+    </item>
+  </vpcSet>
+</DescribeVpcsResponse>`
+
+var test_ec2_describeRouteTables_response = `<DescribeRouteTablesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>6f570b0b-9c18-4b07-bdec-73740dcf861aEXAMPLE</requestId>
+  <routeTableSet>
+    <item>
+      <routeTableId>rtb-22574640</routeTableId>
+      <vpcId>vpc-1a2b3c4d</vpcId>
+      <associationSet>
+        <item>
+          <routeTableAssociationId>rtbassoc-faad4893</routeTableAssociationId>
+          <routeTableId>rtb-22574640</routeTableId>
+          <subnetId>subnet-9d4a7b6c</subnetId>
+          <main>false</main>
+        </item>
+      </associationSet>
+    </item>
+    <item>
+      <routeTableId>rtb-f9ad4890</routeTableId>
+      <vpcId>vpc-1a2b3c4d</vpcId>
+      <associationSet>
+        <item>
+          <routeTableAssociationId>rtbassoc-faad4891</routeTableAssociationId>
+          <routeTableId>rtb-f9ad4890</routeTableId>
+          <main>true</main>
+        </item>
+      </associationSet>
+    </item>
+  </routeTableSet>
+</DescribeRouteTablesResponse>`
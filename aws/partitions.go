@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// awsRegionInfo holds the per-region data of AWS services which is not part
+// of the SDK endpoints model.
+type awsRegionInfo struct {
+	// S3WebsiteHostedZoneID is the Route 53 hosted zone of S3 website
+	// endpoints, see
+	// http://docs.aws.amazon.com/general/latest/gr/rande.html#s3_website_region_endpoints
+	S3WebsiteHostedZoneID string
+
+	// ELBHostedZoneID is the Route 53 hosted zone of load balancers. This
+	// isn't exposed by AWS - it's been found through trouble solving, see
+	// https://github.com/fog/fog-aws/pull/332/files
+	ELBHostedZoneID string
+
+	// ELBAccountID is the account writing load balancer access logs, see
+	// http://docs.aws.amazon.com/elasticloadbalancing/latest/classic/enable-access-logs.html#attach-bucket-policy
+	ELBAccountID string
+
+	// CloudTrailAccountID is the account delivering CloudTrail logs, see
+	// http://docs.aws.amazon.com/awscloudtrail/latest/userguide/cloudtrail-supported-regions.html
+	CloudTrailAccountID string
+
+	// RedshiftAccountID is the account writing Redshift audit logs, see
+	// http://docs.aws.amazon.com/redshift/latest/mgmt/db-auditing.html#db-auditing-enable-logging
+	RedshiftAccountID string
+}
+
+var awsRegionInfos = map[string]awsRegionInfo{
+	endpoints.ApNortheast1RegionID: {
+		S3WebsiteHostedZoneID: "Z2M4EHUR26P7ZW",
+		ELBHostedZoneID:       "Z14GRHDCWA56QT",
+		ELBAccountID:          "582318560864",
+		CloudTrailAccountID:   "216624486486",
+		RedshiftAccountID:     "404641285394",
+	},
+	endpoints.ApNortheast2RegionID: {
+		S3WebsiteHostedZoneID: "Z3W03O7B5YMIYP",
+		ELBHostedZoneID:       "ZWKZPGTI48KDX",
+		ELBAccountID:          "600734575887",
+		CloudTrailAccountID:   "492519147666",
+		RedshiftAccountID:     "760740231472",
+	},
+	endpoints.ApSouth1RegionID: {
+		S3WebsiteHostedZoneID: "Z11RGJOFQNVJUP",
+		ELBHostedZoneID:       "ZP97RAFLXTNZK",
+		ELBAccountID:          "718504428378",
+		CloudTrailAccountID:   "977081816279",
+		RedshiftAccountID:     "865932855811",
+	},
+	endpoints.ApSoutheast1RegionID: {
+		S3WebsiteHostedZoneID: "Z3O0J2DXBE1FTB",
+		ELBHostedZoneID:       "Z1LMS91P8CMLE5",
+		ELBAccountID:          "114774131450",
+		CloudTrailAccountID:   "903692715234",
+		RedshiftAccountID:     "361669875840",
+	},
+	endpoints.ApSoutheast2RegionID: {
+		S3WebsiteHostedZoneID: "Z1WCIGYICN2BYD",
+		ELBHostedZoneID:       "Z1GM3OXH4ZPM65",
+		ELBAccountID:          "783225319266",
+		CloudTrailAccountID:   "284668455005",
+		RedshiftAccountID:     "762762565011",
+	},
+	endpoints.CaCentral1RegionID: {
+		S3WebsiteHostedZoneID: "Z1QDHH18159H29",
+		ELBHostedZoneID:       "ZQSVJUPU6J1EY",
+		ELBAccountID:          "985666609251",
+		CloudTrailAccountID:   "819402241893",
+		RedshiftAccountID:     "907379612154",
+	},
+	endpoints.CnNorth1RegionID: {
+		ELBHostedZoneID: "638102146993",
+		ELBAccountID:    "638102146993",
+	},
+	endpoints.EuCentral1RegionID: {
+		S3WebsiteHostedZoneID: "Z21DNDUVLTQW6Q",
+		ELBHostedZoneID:       "Z215JYRZR1TBD5",
+		ELBAccountID:          "054676820928",
+		CloudTrailAccountID:   "035351147821",
+		RedshiftAccountID:     "053454850223",
+	},
+	endpoints.EuWest1RegionID: {
+		S3WebsiteHostedZoneID: "Z1BKCTXD74EZPE",
+		ELBHostedZoneID:       "Z32O12XQLNTSW2",
+		ELBAccountID:          "156460612806",
+		CloudTrailAccountID:   "859597730677",
+		RedshiftAccountID:     "210876761215",
+	},
+	endpoints.EuWest2RegionID: {
+		S3WebsiteHostedZoneID: "Z3GKZC51ZF0DB4",
+		ELBHostedZoneID:       "ZHURV8PSTC4K8",
+		ELBAccountID:          "652711504416",
+		CloudTrailAccountID:   "282025262664",
+		RedshiftAccountID:     "307160386991",
+	},
+	endpoints.SaEast1RegionID: {
+		S3WebsiteHostedZoneID: "Z7KQH4QJS55SO",
+		ELBHostedZoneID:       "Z2P70J7HTTTPLU",
+		ELBAccountID:          "507241528517",
+		CloudTrailAccountID:   "814480443879",
+		RedshiftAccountID:     "075028567923",
+	},
+	endpoints.UsEast1RegionID: {
+		S3WebsiteHostedZoneID: "Z3AQBSTGFYJSTF",
+		ELBHostedZoneID:       "Z35SXDOTRQ7X7K",
+		ELBAccountID:          "127311923021",
+		CloudTrailAccountID:   "086441151436",
+		RedshiftAccountID:     "193672423079",
+	},
+	endpoints.UsEast2RegionID: {
+		S3WebsiteHostedZoneID: "Z2O1EMRO9K5GLX",
+		ELBHostedZoneID:       "Z3AADJGX6KTTL2",
+		ELBAccountID:          "033677994240",
+		CloudTrailAccountID:   "475085895292",
+		RedshiftAccountID:     "391106570357",
+	},
+	endpoints.UsGovWest1RegionID: {
+		S3WebsiteHostedZoneID: "Z31GFT0UA1I2HV",
+		ELBHostedZoneID:       "048591011584",
+		ELBAccountID:          "048591011584",
+	},
+	endpoints.UsWest1RegionID: {
+		S3WebsiteHostedZoneID: "Z2F56UZL2M1ACD",
+		ELBHostedZoneID:       "Z368ELLRRE2KJ0",
+		ELBAccountID:          "027434742980",
+		CloudTrailAccountID:   "388731089494",
+		RedshiftAccountID:     "262260360010",
+	},
+	endpoints.UsWest2RegionID: {
+		S3WebsiteHostedZoneID: "Z3BJ6K6RIION7M",
+		ELBHostedZoneID:       "Z1H1FL5HABSF5",
+		ELBAccountID:          "797873946194",
+		CloudTrailAccountID:   "113285607260",
+		RedshiftAccountID:     "902366379725",
+	},
+}
+
+// awsPartitionBillingAccountIDs holds the account delivering billing reports
+// per partition, see
+// http://docs.aws.amazon.com/awsaccountbilling/latest/aboutv2/billing-getting-started.html#step-2
+// No other account is documented for the China and GovCloud partitions, so
+// they keep the account the data source has always returned.
+var awsPartitionBillingAccountIDs = map[string]string{
+	endpoints.AwsPartitionID:      "386209384616",
+	endpoints.AwsCnPartitionID:    "386209384616",
+	endpoints.AwsUsGovPartitionID: "386209384616",
+}
+
+// partitionForRegion returns the partition of the given region. Regions not
+// yet known to the SDK are matched by the region naming scheme of each
+// partition.
+func partitionForRegion(region string) (endpoints.Partition, bool) {
+	return endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+}
+
+// partitionIDForRegion returns the ID of the partition of the given region,
+// e.g. "aws-cn" for "cn-north-1", or an empty string if it is unknown.
+func partitionIDForRegion(region string) string {
+	if p, ok := partitionForRegion(region); ok {
+		return p.ID()
+	}
+	return ""
+}
+
+// isValidRegion returns whether the region belongs to a partition, either
+// because the SDK lists it or because it follows the region naming scheme of
+// the partition, so that new regions are accepted before the SDK knows them.
+func isValidRegion(region string) bool {
+	_, ok := partitionForRegion(region)
+	return ok
+}
+
+// partitionDNSSuffix returns the DNS suffix of the endpoints of the given
+// partition, e.g. "amazonaws.com.cn". The SDK doesn't expose it, so it is
+// taken from the endpoint it resolves for an unknown service, which follows
+// the "{service}.{region}.{dnsSuffix}" template of the partition.
+func partitionDNSSuffix(p endpoints.Partition) string {
+	var regions []string
+	for id := range p.Regions() {
+		regions = append(regions, id)
+	}
+	if len(regions) == 0 {
+		return ""
+	}
+	sort.Strings(regions)
+
+	const service = "terraform"
+	resolved, err := p.EndpointFor(service, regions[0], endpoints.ResolveUnknownServiceOption)
+	if err != nil {
+		return ""
+	}
+	u, err := url.Parse(resolved.URL)
+	if err != nil {
+		return ""
+	}
+
+	prefix := service + "." + regions[0] + "."
+	if !strings.HasPrefix(u.Host, prefix) {
+		return ""
+	}
+	return strings.TrimPrefix(u.Host, prefix)
+}
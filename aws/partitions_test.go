@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+func TestPartitionIDForRegion(t *testing.T) {
+	cases := map[string]string{
+		"us-east-1":     "aws",
+		"eu-west-2":     "aws",
+		"cn-north-1":    "aws-cn",
+		"us-gov-west-1": "aws-us-gov",
+		// Regions not yet known to the SDK are matched by their name
+		"eu-north-9":     "aws",
+		"cn-northwest-9": "aws-cn",
+		"not-a-region":   "",
+	}
+
+	for region, expected := range cases {
+		if actual := partitionIDForRegion(region); actual != expected {
+			t.Fatalf("%s: expected partition %q, got %q", region, expected, actual)
+		}
+	}
+}
+
+func TestPartitionDNSSuffix(t *testing.T) {
+	cases := map[string]string{
+		endpoints.AwsPartitionID:      "amazonaws.com",
+		endpoints.AwsCnPartitionID:    "amazonaws.com.cn",
+		endpoints.AwsUsGovPartitionID: "amazonaws.com",
+	}
+
+	partitions := map[string]endpoints.Partition{}
+	for _, p := range endpoints.DefaultPartitions() {
+		partitions[p.ID()] = p
+	}
+
+	for id, expected := range cases {
+		if actual := partitionDNSSuffix(partitions[id]); actual != expected {
+			t.Fatalf("%s: expected DNS suffix %q, got %q", id, expected, actual)
+		}
+	}
+}
+
+func TestValidateRegionName(t *testing.T) {
+	// Regions the SDK doesn't list yet are valid if they follow the naming
+	// scheme of a partition
+	for _, region := range []string{"us-east-1", "cn-north-1", "us-gov-west-1", "us-east-99", "ap-east-7"} {
+		if err := validateRegionName(region); err != nil {
+			t.Fatalf("%s: unexpected error: %s", region, err)
+		}
+	}
+	for _, region := range []string{"", "not-a-region", "example.com", "us-east"} {
+		if err := validateRegionName(region); err == nil {
+			t.Fatalf("%s: expected an error", region)
+		}
+	}
+}
+
+func TestAWSClientPartitionChecks(t *testing.T) {
+	cases := []struct {
+		Region   string
+		GovCloud bool
+		China    bool
+	}{
+		{Region: "us-east-1"},
+		{Region: "us-gov-west-1", GovCloud: true},
+		{Region: "us-gov-east-1", GovCloud: true},
+		{Region: "cn-north-1", China: true},
+		{Region: "cn-northwest-1", China: true},
+	}
+
+	for _, tc := range cases {
+		client := &AWSClient{region: tc.Region}
+		if client.IsGovCloud() != tc.GovCloud {
+			t.Fatalf("%s: expected IsGovCloud to be %t", tc.Region, tc.GovCloud)
+		}
+		if client.IsChinaCloud() != tc.China {
+			t.Fatalf("%s: expected IsChinaCloud to be %t", tc.Region, tc.China)
+		}
+	}
+}
+
+func TestAwsRegionInfos(t *testing.T) {
+	// Every region in the table must be known to the SDK
+	for region := range awsRegionInfos {
+		p, ok := partitionForRegion(region)
+		if !ok {
+			t.Fatalf("%s: region is not known to the SDK", region)
+		}
+		if _, ok := p.Regions()[region]; !ok {
+			t.Fatalf("%s: region is not known to the SDK", region)
+		}
+	}
+}
+
+func TestAwsPartitionBillingAccountIDs(t *testing.T) {
+	for _, id := range []string{endpoints.AwsPartitionID, endpoints.AwsCnPartitionID, endpoints.AwsUsGovPartitionID} {
+		if _, ok := awsPartitionBillingAccountIDs[id]; !ok {
+			t.Fatalf("%s: no billing account", id)
+		}
+	}
+}
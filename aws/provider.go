@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	homedir "github.com/mitchellh/go-homedir"
 )
@@ -18,7 +20,7 @@ func Provider() terraform.ResourceProvider {
 	// TODO: Move the configuration to this, requires validation
 
 	// The actual provider
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -43,6 +45,8 @@ func Provider() terraform.ResourceProvider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -50,6 +54,13 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["shared_credentials_file"],
 			},
 
+			"shared_config_files": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: descriptions["shared_config_files"],
+			},
+
 			"token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -157,6 +168,12 @@ func Provider() terraform.ResourceProvider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"default_tags": defaultTagsSchema(),
+
+			"ignore_tags": ignoreTagsSchema(),
+
+			"retry": retrySchema(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -184,6 +201,8 @@ func Provider() terraform.ResourceProvider {
 			"aws_efs_file_system":            dataSourceAwsEfsFileSystem(),
 			"aws_efs_mount_target":           dataSourceAwsEfsMountTarget(),
 			"aws_eip":                        dataSourceAwsEip(),
+			"aws_eks_cluster":                dataSourceAwsEksCluster(),
+			"aws_eks_cluster_auth":           dataSourceAwsEksClusterAuth(),
 			"aws_elastic_beanstalk_solution_stack": dataSourceAwsElasticBeanstalkSolutionStack(),
 			"aws_elasticache_cluster":              dataSourceAwsElastiCacheCluster(),
 			"aws_elasticache_replication_group":    dataSourceAwsElasticacheReplicationGroup(),
@@ -204,6 +223,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_kms_alias":                        dataSourceAwsKmsAlias(),
 			"aws_kms_ciphertext":                   dataSourceAwsKmsCiphertext(),
 			"aws_kms_secret":                       dataSourceAwsKmsSecret(),
+			"aws_launch_template":                  dataSourceAwsLaunchTemplate(),
 			"aws_nat_gateway":                      dataSourceAwsNatGateway(),
 			"aws_partition":                        dataSourceAwsPartition(),
 			"aws_prefix_list":                      dataSourceAwsPrefixList(),
@@ -320,7 +340,10 @@ func Provider() terraform.ResourceProvider {
 			"aws_ebs_volume":                               resourceAwsEbsVolume(),
 			"aws_ecr_lifecycle_policy":                     resourceAwsEcrLifecyclePolicy(),
 			"aws_ecr_repository":                           resourceAwsEcrRepository(),
+			"aws_ec2_fleet":                                resourceAwsEc2Fleet(),
+			"aws_ec2_managed_prefix_list":                  resourceAwsEc2ManagedPrefixList(),
 			"aws_ecr_repository_policy":                    resourceAwsEcrRepositoryPolicy(),
+			"aws_ecs_capacity_provider":                    resourceAwsEcsCapacityProvider(),
 			"aws_ecs_cluster":                              resourceAwsEcsCluster(),
 			"aws_ecs_service":                              resourceAwsEcsService(),
 			"aws_ecs_task_definition":                      resourceAwsEcsTaskDefinition(),
@@ -329,6 +352,8 @@ func Provider() terraform.ResourceProvider {
 			"aws_egress_only_internet_gateway":             resourceAwsEgressOnlyInternetGateway(),
 			"aws_eip":                                      resourceAwsEip(),
 			"aws_eip_association":                          resourceAwsEipAssociation(),
+			"aws_eks_cluster":                              resourceAwsEksCluster(),
+			"aws_eks_node_group":                           resourceAwsEksNodeGroup(),
 			"aws_elasticache_cluster":                      resourceAwsElasticacheCluster(),
 			"aws_elasticache_parameter_group":              resourceAwsElasticacheParameterGroup(),
 			"aws_elasticache_replication_group":            resourceAwsElasticacheReplicationGroup(),
@@ -386,7 +411,10 @@ func Provider() terraform.ResourceProvider {
 			"aws_lambda_event_source_mapping":              resourceAwsLambdaEventSourceMapping(),
 			"aws_lambda_alias":                             resourceAwsLambdaAlias(),
 			"aws_lambda_permission":                        resourceAwsLambdaPermission(),
+			"aws_lambda_function_event_invoke_config":      resourceAwsLambdaFunctionEventInvokeConfig(),
+			"aws_lambda_provisioned_concurrency_config":    resourceAwsLambdaProvisionedConcurrencyConfig(),
 			"aws_launch_configuration":                     resourceAwsLaunchConfiguration(),
+			"aws_launch_template":                          resourceAwsLaunchTemplate(),
 			"aws_lightsail_domain":                         resourceAwsLightsailDomain(),
 			"aws_lightsail_instance":                       resourceAwsLightsailInstance(),
 			"aws_lightsail_key_pair":                       resourceAwsLightsailKeyPair(),
@@ -455,6 +483,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_network_interface_sg_attachment":          resourceAwsNetworkInterfaceSGAttachment(),
 			"aws_default_security_group":                   resourceAwsDefaultSecurityGroup(),
 			"aws_security_group_rule":                      resourceAwsSecurityGroupRule(),
+			"aws_security_group_rules":                     resourceAwsSecurityGroupRules(),
 			"aws_servicecatalog_portfolio":                 resourceAwsServiceCatalogPortfolio(),
 			"aws_simpledb_domain":                          resourceAwsSimpleDBDomain(),
 			"aws_ssm_activation":                           resourceAwsSsmActivation(),
@@ -486,10 +515,16 @@ func Provider() terraform.ResourceProvider {
 			"aws_vpc_dhcp_options":                         resourceAwsVpcDhcpOptions(),
 			"aws_vpc_peering_connection":                   resourceAwsVpcPeeringConnection(),
 			"aws_vpc_peering_connection_accepter":          resourceAwsVpcPeeringConnectionAccepter(),
+			"aws_vpc_peering_connection_options":           resourceAwsVpcPeeringConnectionOptions(),
 			"aws_default_vpc":                              resourceAwsDefaultVpc(),
 			"aws_vpc":                                      resourceAwsVpc(),
 			"aws_vpc_endpoint":                             resourceAwsVpcEndpoint(),
 			"aws_vpc_endpoint_route_table_association":     resourceAwsVpcEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_subnet_association":          resourceAwsVpcEndpointSubnetAssociation(),
+			"aws_vpc_endpoint_connection_accepter":         resourceAwsVpcEndpointConnectionAccepter(),
+			"aws_vpc_endpoint_service":                     resourceAwsVpcEndpointService(),
+			"aws_vpc_endpoint_service_allowed_principal":   resourceAwsVpcEndpointServiceAllowedPrincipal(),
+			"aws_vpc_ipv4_cidr_block_association":          resourceAwsVpcIpv4CidrBlockAssociation(),
 			"aws_vpn_connection":                           resourceAwsVpnConnection(),
 			"aws_vpn_connection_route":                     resourceAwsVpnConnectionRoute(),
 			"aws_vpn_gateway":                              resourceAwsVpnGateway(),
@@ -526,6 +561,10 @@ func Provider() terraform.ResourceProvider {
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	addRegionOverrides(provider)
+
+	return provider
 }
 
 var descriptions map[string]string
@@ -547,6 +586,10 @@ func init() {
 		"shared_credentials_file": "The path to the shared credentials file. If not set\n" +
 			"this defaults to ~/.aws/credentials.",
 
+		"shared_config_files": "List of paths to shared config files, read in order with\n" +
+			"later files taking precedence. If not set this defaults to ~/.aws/config.\n" +
+			"Profiles in these files may use credential_process or SSO.",
+
 		"token": "session token. A session token is only required if you are\n" +
 			"using temporary security credentials.",
 
@@ -554,6 +597,8 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
+		"endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+
 		"cloudformation_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
 
 		"cloudwatch_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
@@ -609,6 +654,9 @@ func init() {
 			"use virtual hosted bucket addressing when possible\n" +
 			"(http://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
 
+		"assume_role": "Roles to assume prior to making API calls. Each role is assumed" +
+			" with the credentials obtained from the previous one.",
+
 		"assume_role_role_arn": "The ARN of an IAM role to assume prior to making API calls.",
 
 		"assume_role_session_name": "The session name to use when assuming the role. If omitted," +
@@ -620,6 +668,54 @@ func init() {
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use," +
 			" this policy to grant further permissions that are in excess to those of the, " +
 			" role that is being assumed.",
+
+		"assume_role_policy_arns": "The ARNs of managed policies applied when assuming the role.",
+
+		"assume_role_duration_seconds": "The duration, in seconds, of the role session.",
+
+		"assume_role_tags": "Session tags passed when assuming the role.",
+
+		"assume_role_transitive_tag_keys": "Session tag keys that are passed on to subsequently" +
+			" assumed roles.",
+
+		"assume_role_with_web_identity": "A role to assume with an OpenID Connect token instead" +
+			" of the main credentials.",
+
+		"assume_role_with_web_identity_token_file": "The file containing the OpenID Connect token." +
+			" It is read again whenever the credentials are refreshed.",
+
+		"default_tags": "Configuration block with settings to default resource tags across all resources.",
+
+		"default_tags_tags": "Resource tags to default across all resources. Tags set on a resource" +
+			" take precedence over these.",
+
+		"ignore_tags": "Configuration block with settings to ignore resource tags across all resources.",
+
+		"ignore_tags_keys": "Resource tag keys to ignore across all resources.",
+
+		"ignore_tags_key_prefixes": "Resource tag key prefixes to ignore across all resources.",
+
+		"retry": "Configuration block with settings to control how AWS API requests are retried and rate limited.",
+
+		"retry_max_attempts": "The maximum number of attempts made for an AWS API request, including" +
+			" the first one. Overrides max_retries when set.",
+
+		"retry_min_backoff": "The delay before the first retry, doubled for every subsequent retry.",
+
+		"retry_max_backoff": "The upper bound of the delay between retries.",
+
+		"retry_timeout": "How long to keep retrying operations that fail with errors known to be" +
+			" caused by eventual consistency, such as IAM role propagation.",
+
+		"retry_requests_per_second": "The sustained rate of AWS API requests allowed across all services.",
+
+		"retry_burst": "The number of AWS API requests that may exceed requests_per_second in a burst.",
+
+		"retry_retryable_error": "Additional error codes to retry for a single service.",
+
+		"retry_retryable_error_service": "The endpoint prefix of the service, e.g. route53 or ec2.",
+
+		"retry_retryable_error_codes": "The error codes to retry.",
 	}
 }
 
@@ -647,42 +743,103 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 	config.CredsFilename = credsPath
 
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		config.AssumeRoleARN = assumeRole["role_arn"].(string)
-		config.AssumeRoleSessionName = assumeRole["session_name"].(string)
-		config.AssumeRoleExternalID = assumeRole["external_id"].(string)
+	for _, v := range d.Get("shared_config_files").([]interface{}) {
+		configPath, err := homedir.Expand(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		config.SharedConfigFiles = append(config.SharedConfigFiles, configPath)
+	}
 
-		if v := assumeRole["policy"].(string); v != "" {
-			config.AssumeRolePolicy = v
+	for _, v := range d.Get("assume_role").([]interface{}) {
+		assumeRole, ok := v.(map[string]interface{})
+		if !ok || assumeRole["role_arn"].(string) == "" {
+			continue
 		}
 
+		role := &AssumeRole{
+			RoleARN:         assumeRole["role_arn"].(string),
+			SessionName:     assumeRole["session_name"].(string),
+			ExternalID:      assumeRole["external_id"].(string),
+			Policy:          assumeRole["policy"].(string),
+			DurationSeconds: assumeRole["duration_seconds"].(int),
+		}
+		for _, arn := range assumeRole["policy_arns"].(*schema.Set).List() {
+			role.PolicyARNs = append(role.PolicyARNs, arn.(string))
+		}
+		if tags := assumeRole["tags"].(map[string]interface{}); len(tags) > 0 {
+			role.Tags = make(map[string]string, len(tags))
+			for k, v := range tags {
+				role.Tags[k] = v.(string)
+			}
+		}
+		for _, k := range assumeRole["transitive_tag_keys"].(*schema.Set).List() {
+			role.TransitiveTagKeys = append(role.TransitiveTagKeys, k.(string))
+		}
+		config.AssumeRoles = append(config.AssumeRoles, role)
+
 		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q)",
-			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy)
-	} else {
+			role.RoleARN, role.SessionName, role.ExternalID, role.Policy)
+	}
+	if len(config.AssumeRoles) == 0 {
 		log.Printf("[INFO] No assume_role block read from configuration")
 	}
 
+	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		webIdentity := l[0].(map[string]interface{})
+		tokenFile, err := homedir.Expand(webIdentity["web_identity_token_file"].(string))
+		if err != nil {
+			return nil, err
+		}
+		config.AssumeRoleWithWebIdentity = &AssumeRoleWithWebIdentity{
+			RoleARN:              webIdentity["role_arn"].(string),
+			SessionName:          webIdentity["session_name"].(string),
+			WebIdentityTokenFile: tokenFile,
+			Policy:               webIdentity["policy"].(string),
+			DurationSeconds:      webIdentity["duration_seconds"].(int),
+		}
+	}
+
+	config.Endpoints = make(map[string]string)
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
 		endpoints := endpointsSetI.(map[string]interface{})
-		config.CloudFormationEndpoint = endpoints["cloudformation"].(string)
-		config.CloudWatchEndpoint = endpoints["cloudwatch"].(string)
-		config.CloudWatchEventsEndpoint = endpoints["cloudwatchevents"].(string)
-		config.CloudWatchLogsEndpoint = endpoints["cloudwatchlogs"].(string)
-		config.DeviceFarmEndpoint = endpoints["devicefarm"].(string)
-		config.DynamoDBEndpoint = endpoints["dynamodb"].(string)
-		config.Ec2Endpoint = endpoints["ec2"].(string)
-		config.ElbEndpoint = endpoints["elb"].(string)
-		config.IamEndpoint = endpoints["iam"].(string)
-		config.KinesisEndpoint = endpoints["kinesis"].(string)
-		config.KmsEndpoint = endpoints["kms"].(string)
-		config.RdsEndpoint = endpoints["rds"].(string)
-		config.S3Endpoint = endpoints["s3"].(string)
-		config.SnsEndpoint = endpoints["sns"].(string)
-		config.SqsEndpoint = endpoints["sqs"].(string)
+		for _, name := range endpointServiceNames {
+			config.Endpoints[name] = endpoints[name].(string)
+		}
+	}
+
+	if l, ok := d.Get("default_tags").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		defaultTags := l[0].(map[string]interface{})
+		config.DefaultTags = defaultTags["tags"].(map[string]interface{})
+	}
+
+	if l, ok := d.Get("ignore_tags").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		ignoreTags := l[0].(map[string]interface{})
+		config.IgnoreTagsKeys = ignoreTags["keys"].(*schema.Set).List()
+		config.IgnoreTagsKeyPrefixes = ignoreTags["key_prefixes"].(*schema.Set).List()
+	}
+
+	if l, ok := d.Get("retry").([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		retry := l[0].(map[string]interface{})
+		config.RetryMaxAttempts = retry["max_attempts"].(int)
+		config.RetryRequestsPerSecond = retry["requests_per_second"].(float64)
+		config.RetryBurst = retry["burst"].(int)
+
+		// The durations were validated by the schema
+		config.RetryMinBackoff, _ = time.ParseDuration(retry["min_backoff"].(string))
+		config.RetryMaxBackoff, _ = time.ParseDuration(retry["max_backoff"].(string))
+		config.RetryTimeout, _ = time.ParseDuration(retry["timeout"].(string))
+
+		config.RetryableErrorCodes = make(map[string][]string)
+		for _, v := range retry["retryable_error"].(*schema.Set).List() {
+			retryableError := v.(map[string]interface{})
+			service := retryableError["service"].(string)
+			for _, code := range retryableError["codes"].(*schema.Set).List() {
+				config.RetryableErrorCodes[service] = append(config.RetryableErrorCodes[service], code.(string))
+			}
+		}
 	}
 
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
@@ -701,9 +858,9 @@ var awsMutexKV = mutexkv.NewMutexKV()
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: descriptions["assume_role"],
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"role_arn": {
@@ -729,111 +886,220 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_policy"],
 				},
+
+				"policy_arns": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["assume_role_policy_arns"],
+				},
+
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(900, 43200),
+					Description:  descriptions["assume_role_duration_seconds"],
+				},
+
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Description: descriptions["assume_role_tags"],
+				},
+
+				"transitive_tag_keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["assume_role_transitive_tag_keys"],
+				},
 			},
 		},
 	}
 }
 
-func endpointsSchema() *schema.Schema {
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["assume_role_with_web_identity"],
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				"cloudwatch": {
+				"role_arn": {
 					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatch_endpoint"],
+					Required:    true,
+					Description: descriptions["assume_role_role_arn"],
 				},
-				"cloudwatchevents": {
+
+				"session_name": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchevents_endpoint"],
+					Description: descriptions["assume_role_session_name"],
 				},
-				"cloudwatchlogs": {
+
+				"web_identity_token_file": {
 					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchlogs_endpoint"],
+					Required:    true,
+					Description: descriptions["assume_role_with_web_identity_token_file"],
 				},
-				"cloudformation": {
+
+				"policy": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudformation_endpoint"],
+					Description: descriptions["assume_role_policy"],
 				},
-				"devicefarm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["devicefarm_endpoint"],
+
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(900, 43200),
+					Description:  descriptions["assume_role_duration_seconds"],
 				},
-				"dynamodb": {
-					Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["default_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["dynamodb_endpoint"],
+					Description: descriptions["default_tags_tags"],
 				},
-				"iam": {
-					Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["ignore_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["iam_endpoint"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
 				},
-
-				"ec2": {
-					Type:        schema.TypeString,
+				"key_prefixes": {
+					Type:        schema.TypeSet,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["ec2_endpoint"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_key_prefixes"],
 				},
+			},
+		},
+	}
+}
 
-				"elb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["elb_endpoint"],
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["retry"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["retry_max_attempts"],
 				},
-				"kinesis": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["kinesis_endpoint"],
+				"min_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateDuration,
+					Description:  descriptions["retry_min_backoff"],
 				},
-				"kms": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["kms_endpoint"],
+				"max_backoff": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateDuration,
+					Description:  descriptions["retry_max_backoff"],
 				},
-				"rds": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["rds_endpoint"],
+				"timeout": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateDuration,
+					Description:  descriptions["retry_timeout"],
 				},
-				"s3": {
-					Type:        schema.TypeString,
+				"requests_per_second": {
+					Type:        schema.TypeFloat,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["s3_endpoint"],
+					Description: descriptions["retry_requests_per_second"],
 				},
-				"sns": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sns_endpoint"],
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["retry_burst"],
 				},
-				"sqs": {
-					Type:        schema.TypeString,
+				"retryable_error": {
+					Type:        schema.TypeSet,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["sqs_endpoint"],
+					Description: descriptions["retry_retryable_error"],
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"service": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: descriptions["retry_retryable_error_service"],
+							},
+							"codes": {
+								Type:        schema.TypeSet,
+								Required:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Set:         schema.HashString,
+								Description: descriptions["retry_retryable_error_codes"],
+							},
+						},
+					},
 				},
 			},
 		},
+	}
+}
+
+func endpointsSchema() *schema.Schema {
+	endpointsAttributes := make(map[string]*schema.Schema)
+
+	for _, name := range endpointServiceNames {
+		description, ok := descriptions[name+"_endpoint"]
+		if !ok {
+			description = descriptions["endpoint"]
+		}
+
+		endpointsAttributes[name] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: description,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: endpointsAttributes,
+		},
 		Set: endpointsToHash,
 	}
 }
@@ -841,21 +1107,9 @@ func endpointsSchema() *schema.Schema {
 func endpointsToHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatch"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchevents"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchlogs"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudformation"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["devicefarm"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["dynamodb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["iam"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["ec2"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["elb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kinesis"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kms"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["rds"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["s3"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sns"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sqs"].(string)))
+	for _, name := range endpointServiceNames {
+		buf.WriteString(fmt.Sprintf("%s-", m[name].(string)))
+	}
 
 	return hashcode.String(buf.String())
 }
@@ -42,6 +42,27 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
+func TestProvider_tagsAll(t *testing.T) {
+	// The tags of an Inspector resource group select the instances it
+	// targets rather than tagging the group itself.
+	excluded := map[string]bool{
+		"aws_inspector_resource_group": true,
+	}
+
+	for name, r := range Provider().(*schema.Provider).ResourcesMap {
+		tags, ok := r.Schema["tags"]
+		if !ok || tags.Type != schema.TypeMap || excluded[name] {
+			continue
+		}
+		if _, ok := r.Schema["tags_all"]; !ok {
+			t.Errorf("%s: tags without tags_all", name)
+		}
+		if r.CustomizeDiff == nil {
+			t.Errorf("%s: tags without a CustomizeDiff for tags_all", name)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("AWS_PROFILE"); v == "" {
 		if v := os.Getenv("AWS_ACCESS_KEY_ID"); v == "" {
@@ -0,0 +1,232 @@
+package aws
+
+import (
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// regionOverrideExcludedPrefixes lists the resources and data sources of
+// global services, which do not get a region argument.
+var regionOverrideExcludedPrefixes = []string{
+	"aws_billing_service_account",
+	"aws_caller_identity",
+	"aws_canonical_user_id",
+	"aws_cloudfront_",
+	"aws_iam_",
+	"aws_partition",
+	"aws_region",
+	"aws_route53_",
+	"aws_waf_",
+}
+
+// addRegionOverrides adds a region argument to the resources and data sources
+// of regional services, which makes them use the clients of that region
+// instead of the provider region.
+func addRegionOverrides(p *schema.Provider) {
+	// Decide first, as resources may share their schema
+	var resources, dataSources []*schema.Resource
+	for name, r := range p.ResourcesMap {
+		if regionOverrideSupported(name, r) {
+			resources = append(resources, r)
+		}
+	}
+	for name, r := range p.DataSourcesMap {
+		if regionOverrideSupported(name, r) {
+			dataSources = append(dataSources, r)
+		}
+	}
+
+	for _, r := range resources {
+		addRegionOverride(r, true)
+	}
+	for _, r := range dataSources {
+		addRegionOverride(r, false)
+	}
+}
+
+func regionOverrideSupported(name string, r *schema.Resource) bool {
+	// Some resources already have a region argument of their own
+	if _, ok := r.Schema["region"]; ok {
+		return false
+	}
+	for _, prefix := range regionOverrideExcludedPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+func addRegionOverride(r *schema.Resource, forceNew bool) {
+	r.Schema["region"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: forceNew,
+	}
+
+	if r.Create != nil {
+		r.Create = withRegionalClient(r.Create)
+	}
+	if r.Read != nil {
+		r.Read = withRegionalClient(r.Read)
+	}
+	if r.Update != nil {
+		r.Update = withRegionalClient(r.Update)
+	}
+	if r.Delete != nil {
+		r.Delete = withRegionalClient(r.Delete)
+	}
+	if r.Exists != nil {
+		exists := r.Exists
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			client, err := regionalClientFor(d, meta)
+			if err != nil {
+				return false, err
+			}
+			return exists(d, client)
+		}
+	}
+	if r.CustomizeDiff != nil {
+		customizeDiff := r.CustomizeDiff
+		r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+			client, err := regionalClientForRegion(d.Get("region").(string), meta)
+			if err != nil {
+				return err
+			}
+			return customizeDiff(d, client)
+		}
+	}
+	if r.Importer != nil && r.Importer.State != nil {
+		r.Importer = &schema.ResourceImporter{
+			State: withRegionalImport(r.Importer.State),
+		}
+	}
+}
+
+// withRegionalImport wraps an import function so that resources can be
+// imported from another region with an ID of the form ID@REGION. The region
+// is set on the imported resources and the import function is called with
+// the client of that region.
+func withRegionalImport(f schema.StateFunc) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		id, region := regionOverrideImportID(d.Id())
+		d.SetId(id)
+
+		client, err := regionalClientForRegion(region, meta)
+		if err != nil {
+			return nil, err
+		}
+
+		results, err := f(d, client)
+		if err != nil || region == "" {
+			return results, err
+		}
+		for _, result := range results {
+			// Resources of global services have no region argument
+			if _, ok := result.Get("region").(string); ok {
+				result.Set("region", region)
+			}
+		}
+		return results, nil
+	}
+}
+
+// regionOverrideImportID splits an import ID of the form ID@REGION. IDs that
+// merely contain an "@", such as e-mail addresses, are returned unchanged.
+func regionOverrideImportID(id string) (string, string) {
+	i := strings.LastIndex(id, "@")
+	if i < 0 {
+		return id, ""
+	}
+	if !isValidRegion(id[i+1:]) {
+		return id, ""
+	}
+	return id[:i], id[i+1:]
+}
+
+// withRegionalClient wraps a CRUD function so that it is called with the
+// client of the region set in the resource's region argument.
+func withRegionalClient(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client, err := regionalClientFor(d, meta)
+		if err != nil {
+			return err
+		}
+		return f(d, client)
+	}
+}
+
+func regionalClientFor(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	return regionalClientForRegion(d.Get("region").(string), meta)
+}
+
+func regionalClientForRegion(region string, meta interface{}) (interface{}, error) {
+	client, ok := meta.(*AWSClient)
+	if !ok {
+		return meta, nil
+	}
+	return client.regionalClient(region)
+}
+
+// regionalClients lazily creates and caches an AWSClient per region, sharing
+// the credentials and settings of the provider's session.
+type regionalClients struct {
+	sync.Mutex
+
+	config  *Config
+	session *session.Session
+	clients map[string]*AWSClient
+}
+
+func newRegionalClients(c *Config, sess *session.Session, client *AWSClient) *regionalClients {
+	return &regionalClients{
+		config:  c,
+		session: sess,
+		clients: map[string]*AWSClient{client.region: client},
+	}
+}
+
+// regionalClient returns the client for the given region, which is c itself
+// if region is empty or the region of c.
+func (c *AWSClient) regionalClient(region string) (*AWSClient, error) {
+	if region == "" || region == c.region || c.regionalClients == nil {
+		return c, nil
+	}
+	return c.regionalClients.get(c, region)
+}
+
+func (r *regionalClients) get(base *AWSClient, region string) (*AWSClient, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if client, ok := r.clients[region]; ok {
+		return client, nil
+	}
+
+	if !r.config.SkipRegionValidation {
+		if err := validateRegionName(region); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Printf("[INFO] Initializing AWS clients for region %s", region)
+	client := &AWSClient{
+		region:           region,
+		partition:        base.partition,
+		accountid:        base.accountid,
+		defaultTags:      base.defaultTags,
+		ignoreTagsConfig: base.ignoreTagsConfig,
+		retryTimeout:     base.retryTimeout,
+		regionalClients:  r,
+	}
+	r.config.initServiceClients(client, r.session.Copy(&aws.Config{Region: aws.String(region)}))
+	client.supportedplatforms = r.config.supportedEC2Platforms(client.ec2conn)
+
+	r.clients[region] = client
+	return client, nil
+}
@@ -0,0 +1,217 @@
+package aws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestProviderRegionOverrides(t *testing.T) {
+	p := Provider().(*schema.Provider)
+
+	cases := []struct {
+		Name       string
+		Resource   *schema.Resource
+		Supported  bool
+		DataSource bool
+	}{
+		{Name: "aws_vpc", Resource: p.ResourcesMap["aws_vpc"], Supported: true},
+		{Name: "aws_sqs_queue", Resource: p.ResourcesMap["aws_sqs_queue"], Supported: true},
+		{Name: "aws_ami", Resource: p.DataSourcesMap["aws_ami"], Supported: true, DataSource: true},
+		{Name: "aws_availability_zones", Resource: p.DataSourcesMap["aws_availability_zones"], Supported: true, DataSource: true},
+		{Name: "aws_iam_role", Resource: p.ResourcesMap["aws_iam_role"]},
+		{Name: "aws_route53_zone", Resource: p.ResourcesMap["aws_route53_zone"]},
+		{Name: "aws_cloudfront_distribution", Resource: p.ResourcesMap["aws_cloudfront_distribution"]},
+		{Name: "aws_caller_identity", Resource: p.DataSourcesMap["aws_caller_identity"], DataSource: true},
+	}
+
+	for _, tc := range cases {
+		s, ok := tc.Resource.Schema["region"]
+		if ok != tc.Supported {
+			t.Fatalf("%s: expected region argument to be present: %t", tc.Name, tc.Supported)
+		}
+		if ok && s.ForceNew == tc.DataSource {
+			t.Fatalf("%s: expected region argument ForceNew to be %t", tc.Name, !tc.DataSource)
+		}
+	}
+
+	// Resources with a region argument of their own keep it
+	if s := p.ResourcesMap["aws_s3_bucket"].Schema["region"]; !s.Computed {
+		t.Fatal("aws_s3_bucket: expected own region argument to be kept")
+	}
+}
+
+func TestAWSClientRegionalClient(t *testing.T) {
+	c := &Config{
+		Region:              "us-east-1",
+		SkipGetEC2Platforms: true,
+	}
+	sess := session.New(&aws.Config{
+		Region:      aws.String(c.Region),
+		Credentials: credentials.NewStaticCredentials("key", "secret", ""),
+	})
+
+	base := &AWSClient{
+		region:           c.Region,
+		partition:        "aws",
+		accountid:        "123456789012",
+		ignoreTagsConfig: newTagsIgnoreConfig([]interface{}{"Owner"}, nil),
+		retryTimeout:     5 * time.Minute,
+	}
+	c.initServiceClients(base, sess)
+	base.regionalClients = newRegionalClients(c, sess, base)
+
+	for _, region := range []string{"", "us-east-1"} {
+		client, err := base.regionalClient(region)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %s", region, err)
+		}
+		if client != base {
+			t.Fatalf("%q: expected the provider client", region)
+		}
+	}
+
+	client, err := base.regionalClient("eu-west-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if client.region != "eu-west-1" || *client.ec2conn.Config.Region != "eu-west-1" {
+		t.Fatalf("Expected a client for eu-west-1, got %s (ec2: %s)", client.region, *client.ec2conn.Config.Region)
+	}
+	if *client.r53conn.Config.Region != "us-east-1" {
+		t.Fatalf("Expected Route 53 to stay in us-east-1, got %s", *client.r53conn.Config.Region)
+	}
+	if client.accountid != base.accountid || client.partition != base.partition {
+		t.Fatalf("Expected account %s in %s, got %s in %s", base.accountid, base.partition, client.accountid, client.partition)
+	}
+	if client.ignoreTagsConfig != base.ignoreTagsConfig || client.retryTimeout != base.retryTimeout {
+		t.Fatal("Expected the ignore_tags and retry settings of the provider client")
+	}
+
+	cached, _ := base.regionalClient("eu-west-1")
+	if cached != client {
+		t.Fatal("Expected the eu-west-1 client to be cached")
+	}
+	back, _ := client.regionalClient("us-east-1")
+	if back != base {
+		t.Fatal("Expected the provider client from a regional client")
+	}
+
+	if _, err := base.regionalClient("mars-north-1"); err == nil {
+		t.Fatal("Expected an error for an invalid region")
+	}
+	c.SkipRegionValidation = true
+	if _, err := base.regionalClient("mars-north-1"); err != nil {
+		t.Fatalf("Expected no error when skipping region validation, got: %s", err)
+	}
+}
+
+func TestAddRegionOverride(t *testing.T) {
+	var called *AWSClient
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			called = meta.(*AWSClient)
+			d.SetId(d.Get("name").(string))
+			return nil
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			called = meta.(*AWSClient)
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		CustomizeDiff: func(d *schema.ResourceDiff, meta interface{}) error {
+			called = meta.(*AWSClient)
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				called = meta.(*AWSClient)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+	}
+	addRegionOverride(r, true)
+
+	base := &AWSClient{region: "us-east-1"}
+	regional := &AWSClient{region: "eu-west-1"}
+	base.regionalClients = &regionalClients{
+		config:  &Config{},
+		clients: map[string]*AWSClient{"us-east-1": base, "eu-west-1": regional},
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":   "test",
+		"region": "eu-west-1",
+	})
+	if err := r.Create(d, base); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if called != regional {
+		t.Fatalf("Expected Create to be called with the eu-west-1 client, got %s", called.region)
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name": "test",
+	})
+	if err := r.Read(d, base); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if called != base {
+		t.Fatalf("Expected Read to be called with the provider client, got %s", called.region)
+	}
+
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"name":   "test",
+		"region": "eu-west-1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Diff(nil, terraform.NewResourceConfig(rawConfig), base); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if called != regional {
+		t.Fatalf("Expected CustomizeDiff to be called with the eu-west-1 client, got %s", called.region)
+	}
+
+	cases := []struct {
+		ID             string
+		ExpectedID     string
+		ExpectedRegion string
+		ExpectedClient *AWSClient
+	}{
+		{"test@eu-west-1", "test", "eu-west-1", regional},
+		{"test", "test", "", base},
+		{"user@example.com", "user@example.com", "", base},
+	}
+	for _, tc := range cases {
+		results, err := r.Importer.State(r.Data(&terraform.InstanceState{ID: tc.ID}), base)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tc.ID, err)
+		}
+		if called != tc.ExpectedClient {
+			t.Fatalf("%s: expected import to be called with the %s client, got %s", tc.ID, tc.ExpectedClient.region, called.region)
+		}
+		if id := results[0].Id(); id != tc.ExpectedID {
+			t.Fatalf("%s: expected ID %q, got %q", tc.ID, tc.ExpectedID, id)
+		}
+		if region := results[0].Get("region").(string); region != tc.ExpectedRegion {
+			t.Fatalf("%s: expected region %q, got %q", tc.ID, tc.ExpectedRegion, region)
+		}
+	}
+}
@@ -45,6 +45,12 @@ func resourceAwsAmi() *schema.Resource {
 		Read:   resourceAwsAmiRead,
 		Update: resourceAwsAmiUpdate,
 		Delete: resourceAwsAmiDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsAllDiff,
 	}
 }
 
@@ -233,7 +239,7 @@ func resourceAwsAmiRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("ebs_block_device", ebsBlockDevs)
 	d.Set("ephemeral_block_device", ephemeralBlockDevs)
 
-	d.Set("tags", tagsToMap(image.Tags))
+	setTagsAll(d, meta, tagsToMap(image.Tags))
 
 	return nil
 }
@@ -247,6 +253,7 @@ func resourceAwsAmiUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.Get("description").(string) != "" {
@@ -564,7 +571,8 @@ func resourceAwsAmiCommonSchema(computed bool) map[string]*schema.Schema {
 			},
 		},
 
-		"tags": tagsSchema(),
+		"tags":     tagsSchema(),
+		"tags_all": tagsSchemaAll(),
 
 		// Not a public attribute; used to let the aws_ami_copy and aws_ami_from_instance
 		// resources record that they implicitly created new EBS snapshots that we should
@@ -37,9 +37,10 @@ func resourceAwsAmiFromInstance() *schema.Resource {
 
 		// The remaining operations are shared with the generic aws_ami resource,
 		// since the aws_ami_copy resource only differs in how it's created.
-		Read:   resourceAwsAmiRead,
-		Update: resourceAwsAmiUpdate,
-		Delete: resourceAwsAmiDelete,
+		Read:          resourceAwsAmiRead,
+		Update:        resourceAwsAmiUpdate,
+		Delete:        resourceAwsAmiDelete,
+		CustomizeDiff: setTagsAllDiff,
 	}
 }
 
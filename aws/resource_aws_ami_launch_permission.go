@@ -18,6 +18,10 @@ func resourceAwsAmiLaunchPermission() *schema.Resource {
 		Read:   resourceAwsAmiLaunchPermissionRead,
 		Delete: resourceAwsAmiLaunchPermissionDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAmiLaunchPermissionImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"image_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -33,6 +37,20 @@ func resourceAwsAmiLaunchPermission() *schema.Resource {
 	}
 }
 
+func resourceAwsAmiLaunchPermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected IMAGE-ID/ACCOUNT-ID", d.Id())
+	}
+
+	imageId, accountId := idParts[0], idParts[1]
+	d.Set("image_id", imageId)
+	d.Set("account_id", accountId)
+	d.SetId(fmt.Sprintf("%s-%s", imageId, accountId))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAmiLaunchPermissionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	conn := meta.(*AWSClient).ec2conn
 
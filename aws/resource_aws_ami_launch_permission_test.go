@@ -32,6 +32,12 @@ func TestAccAWSAMILaunchPermission_Basic(t *testing.T) {
 					testAccAWSAMILaunchPermissionExists(accountID, &imageID),
 				),
 			},
+			r.TestStep{
+				ResourceName:      "aws_ami_launch_permission.self-test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSAMILaunchPermissionImportStateIdFunc("aws_ami_launch_permission.self-test"),
+			},
 			// Drop just launch permission to test destruction
 			r.TestStep{
 				Config: testAccAWSAMILaunchPermissionConfig(accountID, false),
@@ -145,3 +151,14 @@ resource "aws_ami_launch_permission" "self-test" {
 }
 `, accountID)
 }
+
+func testAccAWSAMILaunchPermissionImportStateIdFunc(resourceName string) r.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["image_id"], rs.Primary.Attributes["account_id"]), nil
+	}
+}
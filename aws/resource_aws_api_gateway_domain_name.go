@@ -19,6 +19,10 @@ func resourceAwsApiGatewayDomainName() *schema.Resource {
 		Update: resourceAwsApiGatewayDomainNameUpdate,
 		Delete: resourceAwsApiGatewayDomainNameDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			//According to AWS Documentation, ACM will be the only way to add certificates
@@ -144,6 +148,7 @@ func resourceAwsApiGatewayDomainNameRead(d *schema.ResourceData, meta interface{
 		log.Printf("[DEBUG] Error setting certificate_upload_date: %s", err)
 	}
 	d.Set("cloudfront_domain_name", domainName.DistributionDomainName)
+	d.Set("cloudfront_zone_id", cloudFrontRoute53ZoneID)
 	d.Set("domain_name", domainName.DomainName)
 	d.Set("certificate_arn", domainName.CertificateArn)
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -17,6 +18,10 @@ func resourceAwsApiGatewayMethodSettings() *schema.Resource {
 		Update: resourceAwsApiGatewayMethodSettingsUpdate,
 		Delete: resourceAwsApiGatewayMethodSettingsDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayMethodSettingsImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rest_api_id": {
 				Type:     schema.TypeString,
@@ -86,6 +91,51 @@ func resourceAwsApiGatewayMethodSettings() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayMethodSettingsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).apigateway
+
+	idParts := strings.SplitN(d.Id(), "/", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/STAGE-NAME/METHOD-PATH", d.Id())
+	}
+	restApiId, stageName, methodPath := idParts[0], idParts[1], idParts[2]
+
+	stage, err := conn.GetStage(&apigateway.GetStageInput{
+		RestApiId: aws.String(restApiId),
+		StageName: aws.String(stageName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	settings, ok := stage.MethodSettings[methodPath]
+	if !ok {
+		return nil, fmt.Errorf("API Gateway Method Settings for %q not found in stage %s", methodPath, stageName)
+	}
+
+	// Read only refreshes the settings that are present in configuration,
+	// so populate the full block once here.
+	d.Set("settings", []interface{}{
+		map[string]interface{}{
+			"metrics_enabled":                            aws.BoolValue(settings.MetricsEnabled),
+			"logging_level":                              aws.StringValue(settings.LoggingLevel),
+			"data_trace_enabled":                         aws.BoolValue(settings.DataTraceEnabled),
+			"throttling_burst_limit":                     int(aws.Int64Value(settings.ThrottlingBurstLimit)),
+			"throttling_rate_limit":                      aws.Float64Value(settings.ThrottlingRateLimit),
+			"caching_enabled":                            aws.BoolValue(settings.CachingEnabled),
+			"cache_ttl_in_seconds":                       int(aws.Int64Value(settings.CacheTtlInSeconds)),
+			"cache_data_encrypted":                       aws.BoolValue(settings.CacheDataEncrypted),
+			"require_authorization_for_cache_control":    aws.BoolValue(settings.RequireAuthorizationForCacheControl),
+			"unauthorized_cache_control_header_strategy": aws.StringValue(settings.UnauthorizedCacheControlHeaderStrategy),
+		},
+	})
+	d.Set("rest_api_id", restApiId)
+	d.Set("stage_name", stageName)
+	d.Set("method_path", methodPath)
+	d.SetId(restApiId + "-" + stageName + "-" + methodPath)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayMethodSettingsRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -20,6 +20,10 @@ func resourceAwsApiGatewayRestApi() *schema.Resource {
 		Update: resourceAwsApiGatewayRestApiUpdate,
 		Delete: resourceAwsApiGatewayRestApiDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayRestApiImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -55,6 +59,14 @@ func resourceAwsApiGatewayRestApi() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayRestApiImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// root_resource_id is otherwise only looked up on create
+	if err := resourceAwsApiGatewayRestApiRefreshResources(d, meta); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayRestApiCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 	log.Printf("[DEBUG] Creating API Gateway")
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,10 @@ func resourceAwsApiGatewayStage() *schema.Resource {
 		Update: resourceAwsApiGatewayStageUpdate,
 		Delete: resourceAwsApiGatewayStageDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayStageImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cache_cluster_enabled": {
 				Type:     schema.TypeBool,
@@ -62,6 +67,19 @@ func resourceAwsApiGatewayStage() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayStageImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected REST-API-ID/STAGE-NAME", d.Id())
+	}
+
+	d.Set("rest_api_id", idParts[0])
+	d.Set("stage_name", idParts[1])
+	d.SetId(fmt.Sprintf("ags-%s-%s", idParts[0], idParts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayStageCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,10 @@ func resourceAwsApiGatewayUsagePlanKey() *schema.Resource {
 		Read:   resourceAwsApiGatewayUsagePlanKeyRead,
 		Delete: resourceAwsApiGatewayUsagePlanKeyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsApiGatewayUsagePlanKeyImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"key_id": {
 				Type:     schema.TypeString,
@@ -50,6 +55,19 @@ func resourceAwsApiGatewayUsagePlanKey() *schema.Resource {
 	}
 }
 
+func resourceAwsApiGatewayUsagePlanKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected USAGE-PLAN-ID/KEY-ID", d.Id())
+	}
+
+	d.Set("usage_plan_id", idParts[0])
+	d.Set("key_id", idParts[1])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsApiGatewayUsagePlanKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).apigateway
 	log.Print("[DEBUG] Creating API Gateway Usage Plan Key")
@@ -86,6 +104,7 @@ func resourceAwsApiGatewayUsagePlanKeyRead(d *schema.ResourceData, meta interfac
 		return err
 	}
 
+	d.Set("key_type", up.Type)
 	d.Set("name", up.Name)
 	d.Set("value", up.Value)
 
@@ -39,6 +39,12 @@ func TestAccAWSAppCookieStickinessPolicy_basic(t *testing.T) {
 					),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:      "aws_app_cookie_stickiness_policy.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -21,6 +22,10 @@ func resourceAwsAppautoscalingPolicy() *schema.Resource {
 		Update: resourceAwsAppautoscalingPolicyUpdate,
 		Delete: resourceAwsAppautoscalingPolicyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAppautoscalingPolicyImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -248,6 +253,23 @@ func resourceAwsAppautoscalingPolicy() *schema.Resource {
 	}
 }
 
+func resourceAwsAppautoscalingPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The resource ID itself may contain slashes, e.g. service/cluster/service
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) < 4 {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected SERVICE-NAMESPACE/RESOURCE-ID/SCALABLE-DIMENSION/POLICY-NAME", d.Id())
+	}
+
+	name := idParts[len(idParts)-1]
+	d.Set("service_namespace", idParts[0])
+	d.Set("resource_id", strings.Join(idParts[1:len(idParts)-2], "/"))
+	d.Set("scalable_dimension", idParts[len(idParts)-2])
+	d.Set("name", name)
+	d.SetId(name)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAppautoscalingPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).appautoscalingconn
 
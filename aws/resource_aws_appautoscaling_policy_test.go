@@ -38,6 +38,13 @@ func TestAccAWSAppautoScalingPolicy_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_appautoscaling_policy.foobar_simple", "scalable_dimension", "ecs:service:DesiredCount"),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:      "aws_appautoscaling_policy.foobar_simple",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSAppautoScalingPolicyImportStateIdFunc("aws_appautoscaling_policy.foobar_simple"),
+			},
 		},
 	})
 }
@@ -665,3 +672,14 @@ resource "aws_appautoscaling_policy" "read" {
 }
 `, tableName, namePrefix, namePrefix)
 }
+
+func testAccAWSAppautoScalingPolicyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s/%s", rs.Primary.Attributes["service_namespace"], rs.Primary.Attributes["resource_id"], rs.Primary.Attributes["scalable_dimension"], rs.Primary.Attributes["name"]), nil
+	}
+}
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -19,6 +20,10 @@ func resourceAwsAppautoscalingTarget() *schema.Resource {
 		Read:   resourceAwsAppautoscalingTargetRead,
 		Delete: resourceAwsAppautoscalingTargetDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAppautoscalingTargetImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"max_capacity": {
 				Type:     schema.TypeInt,
@@ -56,6 +61,22 @@ func resourceAwsAppautoscalingTarget() *schema.Resource {
 	}
 }
 
+func resourceAwsAppautoscalingTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The resource ID itself may contain slashes, e.g. service/cluster/service
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) < 3 {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected SERVICE-NAMESPACE/RESOURCE-ID/SCALABLE-DIMENSION", d.Id())
+	}
+
+	resourceId := strings.Join(idParts[1:len(idParts)-1], "/")
+	d.Set("service_namespace", idParts[0])
+	d.Set("resource_id", resourceId)
+	d.Set("scalable_dimension", idParts[len(idParts)-1])
+	d.SetId(resourceId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAppautoscalingTargetCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).appautoscalingconn
 
@@ -12,6 +12,10 @@ func resourceAwsAthenaNamedQuery() *schema.Resource {
 		Read:   resourceAwsAthenaNamedQueryRead,
 		Delete: resourceAwsAthenaNamedQueryDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -64,7 +68,7 @@ func resourceAwsAthenaNamedQueryRead(d *schema.ResourceData, meta interface{}) e
 		NamedQueryId: aws.String(d.Id()),
 	}
 
-	_, err := conn.GetNamedQuery(input)
+	resp, err := conn.GetNamedQuery(input)
 	if err != nil {
 		if isAWSErr(err, athena.ErrCodeInvalidRequestException, d.Id()) {
 			d.SetId("")
@@ -72,6 +76,11 @@ func resourceAwsAthenaNamedQueryRead(d *schema.ResourceData, meta interface{}) e
 		}
 		return err
 	}
+
+	d.Set("name", resp.NamedQuery.Name)
+	d.Set("query", resp.NamedQuery.QueryString)
+	d.Set("database", resp.NamedQuery.Database)
+	d.Set("description", resp.NamedQuery.Description)
 	return nil
 }
 
@@ -23,6 +23,12 @@ func TestAccAWSAthenaNamedQuery_basic(t *testing.T) {
 					testAccCheckAWSAthenaNamedQueryExists("aws_athena_named_query.foo"),
 				),
 			},
+
+			{
+				ResourceName:      "aws_athena_named_query.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
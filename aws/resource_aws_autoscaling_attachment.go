@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
@@ -17,6 +18,10 @@ func resourceAwsAutoscalingAttachment() *schema.Resource {
 		Read:   resourceAwsAutoscalingAttachmentRead,
 		Delete: resourceAwsAutoscalingAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingAttachmentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"autoscaling_group_name": {
 				Type:     schema.TypeString,
@@ -39,6 +44,24 @@ func resourceAwsAutoscalingAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsAutoscalingAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected ASG-NAME/ELB-NAME or ASG-NAME/TARGET-GROUP-ARN", d.Id())
+	}
+
+	asgName := idParts[0]
+	d.Set("autoscaling_group_name", asgName)
+	if strings.HasPrefix(idParts[1], "arn:") {
+		d.Set("alb_target_group_arn", idParts[1])
+	} else {
+		d.Set("elb", idParts[1])
+	}
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", asgName)))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAutoscalingAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	asgconn := meta.(*AWSClient).autoscalingconn
 	asgName := d.Get("autoscaling_group_name").(string)
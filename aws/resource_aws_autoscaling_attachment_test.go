@@ -31,6 +31,13 @@ func TestAccAwsAutoscalingAttachment_elb(t *testing.T) {
 					testAccCheckAWSAutocalingElbAttachmentExists("aws_autoscaling_group.asg", 1),
 				),
 			},
+			// the attachment ID is generated, so the imported resource
+			// cannot be matched up for verification
+			{
+				ResourceName:      "aws_autoscaling_attachment.asg_attachment_foo",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAwsAutoscalingAttachmentImportStateIdFunc("aws_autoscaling_attachment.asg_attachment_foo"),
+			},
 			{
 				Config: testAccAWSAutoscalingAttachment_elb_double_associated(rInt),
 				Check: resource.ComposeTestCheckFunc(
@@ -319,3 +326,14 @@ resource "aws_autoscaling_attachment" "asg_attachment_bar" {
   alb_target_group_arn   = "${aws_lb_target_group.another_test.arn}"
 }`
 }
+
+func testAccAwsAutoscalingAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["autoscaling_group_name"], rs.Primary.Attributes["elb"]), nil
+	}
+}
@@ -63,8 +63,37 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 			},
 
 			"launch_configuration": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"launch_template"},
+			},
+
+			"launch_template": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"launch_configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ConflictsWith: []string{"launch_template.0.name"},
+						},
+						"name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Computed:      true,
+							ConflictsWith: []string{"launch_template.0.id"},
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "$Default",
+						},
+					},
+				},
 			},
 
 			"desired_capacity": {
@@ -319,9 +348,17 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 
 	createOpts := autoscaling.CreateAutoScalingGroupInput{
 		AutoScalingGroupName:             aws.String(asgName),
-		LaunchConfigurationName:          aws.String(d.Get("launch_configuration").(string)),
 		NewInstancesProtectedFromScaleIn: aws.Bool(d.Get("protect_from_scale_in").(bool)),
 	}
+
+	if v, ok := d.GetOk("launch_configuration"); ok {
+		createOpts.LaunchConfigurationName = aws.String(v.(string))
+	} else if v, ok := d.GetOk("launch_template"); ok {
+		createOpts.LaunchTemplate = expandAutoscalingLaunchTemplateSpecification(v.([]interface{}), d.HasChange("launch_template.0.name"))
+	} else {
+		return fmt.Errorf("One of launch_configuration or launch_template must be set for an Autoscaling Group")
+	}
+
 	updateOpts := autoscaling.UpdateAutoScalingGroupInput{
 		AutoScalingGroupName: aws.String(asgName),
 	}
@@ -471,6 +508,9 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("health_check_grace_period", g.HealthCheckGracePeriod)
 	d.Set("health_check_type", g.HealthCheckType)
 	d.Set("launch_configuration", g.LaunchConfigurationName)
+	if err := d.Set("launch_template", flattenAutoscalingLaunchTemplateSpecification(g.LaunchTemplate)); err != nil {
+		return fmt.Errorf("Error setting launch_template: %s", err)
+	}
 	d.Set("load_balancers", flattenStringList(g.LoadBalancerNames))
 
 	if err := d.Set("suspended_processes", flattenAsgSuspendedProcesses(g.SuspendedProcesses)); err != nil {
@@ -523,7 +563,13 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if !tagOk && !tagsOk {
-		d.Set("tag", autoscalingTagDescriptionsToSlice(g.Tags))
+		ignoreTagsConfig := meta.(*AWSClient).ignoreTagsConfig
+		for _, t := range g.Tags {
+			if !ignoreTagsConfig.ignored(*t.Key) {
+				tagList = append(tagList, t)
+			}
+		}
+		d.Set("tag", autoscalingTagDescriptionsToSlice(tagList))
 	}
 
 	if len(*g.VPCZoneIdentifier) > 0 {
@@ -574,7 +620,15 @@ func resourceAwsAutoscalingGroupUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("launch_configuration") {
-		opts.LaunchConfigurationName = aws.String(d.Get("launch_configuration").(string))
+		if v, ok := d.GetOk("launch_configuration"); ok {
+			opts.LaunchConfigurationName = aws.String(v.(string))
+		}
+	}
+
+	if d.HasChange("launch_template") {
+		if v, ok := d.GetOk("launch_template"); ok {
+			opts.LaunchTemplate = expandAutoscalingLaunchTemplateSpecification(v.([]interface{}), d.HasChange("launch_template.0.name"))
+		}
 	}
 
 	if d.HasChange("min_size") {
@@ -1043,3 +1097,41 @@ func expandVpcZoneIdentifiers(list []interface{}) *string {
 	}
 	return aws.String(strings.Join(strs, ","))
 }
+
+// expandAutoscalingLaunchTemplateSpecification returns the launch template
+// reference of a launch_template block. Both id and name are known once the
+// launch template has been read, but only one of them may be sent: the name
+// when byName is set because it was changed, as the id is then stale, and
+// otherwise the id.
+func expandAutoscalingLaunchTemplateSpecification(l []interface{}, byName bool) *autoscaling.LaunchTemplateSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	spec := &autoscaling.LaunchTemplateSpecification{}
+	if v := m["name"].(string); byName && v != "" {
+		spec.LaunchTemplateName = aws.String(v)
+	} else if v := m["id"].(string); v != "" {
+		spec.LaunchTemplateId = aws.String(v)
+	} else if v := m["name"].(string); v != "" {
+		spec.LaunchTemplateName = aws.String(v)
+	}
+	if v := m["version"].(string); v != "" {
+		spec.Version = aws.String(v)
+	}
+	return spec
+}
+
+func flattenAutoscalingLaunchTemplateSpecification(spec *autoscaling.LaunchTemplateSpecification) []interface{} {
+	if spec == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"id":      aws.StringValue(spec.LaunchTemplateId),
+			"name":    aws.StringValue(spec.LaunchTemplateName),
+			"version": aws.StringValue(spec.Version),
+		},
+	}
+}
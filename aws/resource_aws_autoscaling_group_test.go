@@ -15,8 +15,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -378,6 +380,63 @@ func TestAccAWSAutoScalingGroup_withPlacementGroup(t *testing.T) {
 	})
 }
 
+func TestAccAWSAutoScalingGroup_launchTemplate(t *testing.T) {
+	var group autoscaling.Group
+
+	randName := fmt.Sprintf("tf-lt-test-%s", acctest.RandString(5))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAutoScalingGroupConfig_launchTemplate(randName, "${aws_launch_template.foobar.latest_version}"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "launch_configuration", ""),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "launch_template.0.name", randName),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "launch_template.0.version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSAutoScalingGroupConfig_launchTemplate(randName, "$Latest"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					resource.TestCheckResourceAttr("aws_autoscaling_group.bar", "launch_template.0.version", "$Latest"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSAutoScalingGroup_launchTemplateName(t *testing.T) {
+	var group autoscaling.Group
+
+	randName := fmt.Sprintf("tf-lt-test-%s", acctest.RandString(5))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSAutoScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAutoScalingGroupConfig_launchTemplateName(randName, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					resource.TestCheckResourceAttrPair("aws_autoscaling_group.bar", "launch_template.0.id", "aws_launch_template.foo", "id"),
+				),
+			},
+			{
+				Config: testAccAWSAutoScalingGroupConfig_launchTemplateName(randName, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSAutoScalingGroupExists("aws_autoscaling_group.bar", &group),
+					resource.TestCheckResourceAttrPair("aws_autoscaling_group.bar", "launch_template.0.id", "aws_launch_template.bar", "id"),
+					resource.TestCheckResourceAttrPair("aws_autoscaling_group.bar", "launch_template.0.name", "aws_launch_template.bar", "name"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSAutoScalingGroup_enablingMetrics(t *testing.T) {
 	var group autoscaling.Group
 	randName := fmt.Sprintf("terraform-test-%s", acctest.RandString(10))
@@ -693,6 +752,97 @@ func testAccCheckAWSAutoScalingGroupAttributesLoadBalancer(group *autoscaling.Gr
 	}
 }
 
+func TestExpandAutoscalingLaunchTemplateSpecification(t *testing.T) {
+	cases := []struct {
+		Input    map[string]interface{}
+		ByName   bool
+		Expected *autoscaling.LaunchTemplateSpecification
+	}{
+		{
+			Input: map[string]interface{}{"id": "lt-12345678", "name": "foo", "version": "$Latest"},
+			Expected: &autoscaling.LaunchTemplateSpecification{
+				LaunchTemplateId: aws.String("lt-12345678"),
+				Version:          aws.String("$Latest"),
+			},
+		},
+		{
+			Input: map[string]interface{}{"id": "", "name": "foo", "version": "$Default"},
+			Expected: &autoscaling.LaunchTemplateSpecification{
+				LaunchTemplateName: aws.String("foo"),
+				Version:            aws.String("$Default"),
+			},
+		},
+		// A changed name wins over the id of the previous launch template
+		{
+			Input:  map[string]interface{}{"id": "lt-12345678", "name": "bar", "version": "$Latest"},
+			ByName: true,
+			Expected: &autoscaling.LaunchTemplateSpecification{
+				LaunchTemplateName: aws.String("bar"),
+				Version:            aws.String("$Latest"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		spec := expandAutoscalingLaunchTemplateSpecification([]interface{}{tc.Input}, tc.ByName)
+		if !reflect.DeepEqual(spec, tc.Expected) {
+			t.Fatalf("Expected %s, got %s", tc.Expected, spec)
+		}
+	}
+}
+
+func TestAutoscalingGroupLaunchTemplateNameChange(t *testing.T) {
+	var spec *autoscaling.LaunchTemplateSpecification
+	r := resourceAwsAutoscalingGroup()
+	r.Update = func(d *schema.ResourceData, meta interface{}) error {
+		spec = expandAutoscalingLaunchTemplateSpecification(d.Get("launch_template").([]interface{}), d.HasChange("launch_template.0.name"))
+		return nil
+	}
+
+	state := &terraform.InstanceState{
+		ID: "foo",
+		Attributes: map[string]string{
+			"name":                          "foo",
+			"max_size":                      "0",
+			"min_size":                      "0",
+			"launch_template.#":             "1",
+			"launch_template.0.id":          "lt-12345678",
+			"launch_template.0.name":        "foo",
+			"launch_template.0.version":     "$Latest",
+			"availability_zones.#":          "1",
+			"availability_zones.2487133097": "us-west-2a",
+		},
+	}
+	rawConfig, err := config.NewRawConfig(map[string]interface{}{
+		"name":               "foo",
+		"max_size":           0,
+		"min_size":           0,
+		"availability_zones": []interface{}{"us-west-2a"},
+		"launch_template": []interface{}{
+			map[string]interface{}{"name": "bar", "version": "$Latest"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	diff, err := r.Diff(state, terraform.NewResourceConfig(rawConfig), nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if _, err := r.Apply(state, diff, nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := &autoscaling.LaunchTemplateSpecification{
+		LaunchTemplateName: aws.String("bar"),
+		Version:            aws.String("$Latest"),
+	}
+	if !reflect.DeepEqual(spec, expected) {
+		t.Fatalf("Expected %s, got %s", expected, spec)
+	}
+}
+
 func testAccCheckAWSAutoScalingGroupExists(n string, group *autoscaling.Group) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1873,3 +2023,53 @@ resource "aws_launch_configuration" "test" {
   instance_type = "t1.micro"
 }
 `
+
+func testAccAWSAutoScalingGroupConfig_launchTemplateName(name, template string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name          = "%[1]s-foo"
+  image_id      = "ami-21f78e11"
+  instance_type = "t2.micro"
+}
+
+resource "aws_launch_template" "bar" {
+  name          = "%[1]s-bar"
+  image_id      = "ami-21f78e11"
+  instance_type = "t2.micro"
+}
+
+resource "aws_autoscaling_group" "bar" {
+  availability_zones = ["us-west-2a"]
+  name               = "%[1]s"
+  max_size           = 0
+  min_size           = 0
+
+  launch_template {
+    name    = "${aws_launch_template.%[2]s.name}"
+    version = "$Latest"
+  }
+}
+`, name, template)
+}
+
+func testAccAWSAutoScalingGroupConfig_launchTemplate(name, version string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foobar" {
+  name          = "%s"
+  image_id      = "ami-21f78e11"
+  instance_type = "t2.micro"
+}
+
+resource "aws_autoscaling_group" "bar" {
+  availability_zones = ["us-west-2a"]
+  name               = "%s"
+  max_size           = 0
+  min_size           = 0
+
+  launch_template {
+    id      = "${aws_launch_template.foobar.id}"
+    version = "%s"
+  }
+}
+`, name, name, version)
+}
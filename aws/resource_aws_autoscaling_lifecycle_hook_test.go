@@ -29,6 +29,13 @@ func TestAccAWSAutoscalingLifecycleHook_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_autoscaling_lifecycle_hook.foobar", "lifecycle_transition", "autoscaling:EC2_INSTANCE_LAUNCHING"),
 				),
 			},
+
+			{
+				ResourceName:      "aws_autoscaling_lifecycle_hook.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSAutoscalingLifecycleHookImportStateIdFunc("aws_autoscaling_lifecycle_hook.foobar"),
+			},
 		},
 	})
 }
@@ -280,3 +287,14 @@ EOF
   role_arn                = "${aws_iam_role.foobar.arn}"
 }`, name, rInt, rInt, rInt, name, rInt)
 }
+
+func testAccAWSAutoscalingLifecycleHookImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["autoscaling_group_name"], rs.Primary.Attributes["name"]), nil
+	}
+}
@@ -17,6 +17,10 @@ func resourceAwsAutoscalingNotification() *schema.Resource {
 		Update: resourceAwsAutoscalingNotificationUpdate,
 		Delete: resourceAwsAutoscalingNotificationDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingNotificationImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"topic_arn": &schema.Schema{
 				Type:     schema.TypeString,
@@ -41,6 +45,11 @@ func resourceAwsAutoscalingNotification() *schema.Resource {
 	}
 }
 
+func resourceAwsAutoscalingNotificationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("topic_arn", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAutoscalingNotificationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).autoscalingconn
 	gl := convertSetToList(d.Get("group_names").(*schema.Set))
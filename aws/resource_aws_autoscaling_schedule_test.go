@@ -38,6 +38,13 @@ func TestAccAWSAutoscalingSchedule_basic(t *testing.T) {
 					testAccCheckScalingScheduleExists("aws_autoscaling_schedule.foobar", &schedule),
 				),
 			},
+
+			{
+				ResourceName:      "aws_autoscaling_schedule.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSAutoscalingScheduleImportStateIdFunc("aws_autoscaling_schedule.foobar"),
+			},
 		},
 	})
 }
@@ -356,3 +363,14 @@ resource "aws_autoscaling_schedule" "foobar" {
     autoscaling_group_name = "${aws_autoscaling_group.foobar.name}"
 }`, r, r)
 }
+
+func testAccAWSAutoscalingScheduleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["autoscaling_group_name"], rs.Primary.Attributes["scheduled_action_name"]), nil
+	}
+}
@@ -26,6 +26,12 @@ func TestAccAWSBatchComputeEnvironment_createEc2(t *testing.T) {
 					testAccCheckAwsBatchComputeEnvironmentExists(),
 				),
 			},
+
+			{
+				ResourceName:      "aws_batch_compute_environment.ec2",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
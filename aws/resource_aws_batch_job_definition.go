@@ -17,6 +17,10 @@ func resourceAwsBatchJobDefinition() *schema.Resource {
 		Read:   resourceAwsBatchJobDefinitionRead,
 		Delete: resourceAwsBatchJobDefinitionDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsBatchJobDefinitionImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -73,6 +77,11 @@ func resourceAwsBatchJobDefinition() *schema.Resource {
 	}
 }
 
+func resourceAwsBatchJobDefinitionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("arn", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsBatchJobDefinitionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).batchconn
 	name := d.Get("name").(string)
@@ -119,6 +128,7 @@ func resourceAwsBatchJobDefinitionRead(d *schema.ResourceData, meta interface{})
 		return nil
 	}
 	d.Set("arn", job.JobDefinitionArn)
+	d.Set("name", job.JobDefinitionName)
 	d.Set("container_properties", job.ContainerProperties)
 	d.Set("parameters", aws.StringValueMap(job.Parameters))
 	d.Set("retry_strategy", flattenRetryStrategy(job.RetryStrategy))
@@ -19,6 +19,10 @@ func resourceAwsBatchJobQueue() *schema.Resource {
 		Update: resourceAwsBatchJobQueueUpdate,
 		Delete: resourceAwsBatchJobQueueDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsBatchJobQueueImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"compute_environments": {
 				Type:     schema.TypeList,
@@ -48,6 +52,11 @@ func resourceAwsBatchJobQueue() *schema.Resource {
 	}
 }
 
+func resourceAwsBatchJobQueueImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("name", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsBatchJobQueueCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).batchconn
 	input := batch.CreateJobQueueInput{
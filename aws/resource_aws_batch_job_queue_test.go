@@ -29,6 +29,12 @@ func TestAccAWSBatchJobQueue_basic(t *testing.T) {
 					testAccCheckBatchJobQueueAttributes(&jq),
 				),
 			},
+
+			{
+				ResourceName:      "aws_batch_job_queue.test_queue",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -16,10 +16,11 @@ import (
 
 func resourceAwsCloudFormationStack() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsCloudFormationStackCreate,
-		Read:   resourceAwsCloudFormationStackRead,
-		Update: resourceAwsCloudFormationStackUpdate,
-		Delete: resourceAwsCloudFormationStackDelete,
+		Create:        resourceAwsCloudFormationStackCreate,
+		Read:          resourceAwsCloudFormationStackRead,
+		Update:        resourceAwsCloudFormationStackUpdate,
+		Delete:        resourceAwsCloudFormationStackDelete,
+		CustomizeDiff: setTagsAllDiff,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -105,6 +106,7 @@ func resourceAwsCloudFormationStack() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsSchemaAll(),
 			"iam_role_arn": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -154,7 +156,7 @@ func resourceAwsCloudFormationStackCreate(d *schema.ResourceData, meta interface
 	if v, ok := d.GetOk("policy_url"); ok {
 		input.StackPolicyURL = aws.String(v.(string))
 	}
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
 	}
 	if v, ok := d.GetOk("timeout_in_minutes"); ok {
@@ -332,7 +334,7 @@ func resourceAwsCloudFormationStackRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	err = d.Set("tags", flattenCloudFormationTags(stack.Tags))
+	err = setTagsAll(d, meta, flattenCloudFormationTags(stack.Tags))
 	if err != nil {
 		return err
 	}
@@ -385,7 +387,7 @@ func resourceAwsCloudFormationStackUpdate(d *schema.ResourceData, meta interface
 		input.Parameters = expandCloudFormationParameters(v.(map[string]interface{}))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		input.Tags = expandCloudFormationTags(v.(map[string]interface{}))
 	}
 
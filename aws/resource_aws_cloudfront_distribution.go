@@ -15,10 +15,11 @@ import (
 
 func resourceAwsCloudFrontDistribution() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsCloudFrontDistributionCreate,
-		Read:   resourceAwsCloudFrontDistributionRead,
-		Update: resourceAwsCloudFrontDistributionUpdate,
-		Delete: resourceAwsCloudFrontDistributionDelete,
+		Create:        resourceAwsCloudFrontDistributionCreate,
+		Read:          resourceAwsCloudFrontDistributionRead,
+		Update:        resourceAwsCloudFrontDistributionUpdate,
+		Delete:        resourceAwsCloudFrontDistributionDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsCloudFrontDistributionImport,
 		},
@@ -543,7 +544,8 @@ func resourceAwsCloudFrontDistribution() *schema.Resource {
 				Default:  false,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -554,7 +556,7 @@ func resourceAwsCloudFrontDistributionCreate(d *schema.ResourceData, meta interf
 	params := &cloudfront.CreateDistributionWithTagsInput{
 		DistributionConfigWithTags: &cloudfront.DistributionConfigWithTags{
 			DistributionConfig: expandDistributionConfig(d),
-			Tags:               tagsFromMapCloudFront(d.Get("tags").(map[string]interface{})),
+			Tags:               tagsFromMapCloudFront(d.Get("tags_all").(map[string]interface{})),
 		},
 	}
 
@@ -611,7 +613,7 @@ func resourceAwsCloudFrontDistributionRead(d *schema.ResourceData, meta interfac
 			d.Id(), d.Get("arn").(string)), err)
 	}
 
-	if err := d.Set("tags", tagsToMapCloudFront(tagResp.Tags)); err != nil {
+	if err := setTagsAll(d, meta, tagsToMapCloudFront(tagResp.Tags)); err != nil {
 		return err
 	}
 
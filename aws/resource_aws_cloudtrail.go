@@ -13,10 +13,11 @@ import (
 
 func resourceAwsCloudTrail() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsCloudTrailCreate,
-		Read:   resourceAwsCloudTrailRead,
-		Update: resourceAwsCloudTrailUpdate,
-		Delete: resourceAwsCloudTrailDelete,
+		Create:        resourceAwsCloudTrailCreate,
+		Read:          resourceAwsCloudTrailRead,
+		Update:        resourceAwsCloudTrailUpdate,
+		Delete:        resourceAwsCloudTrailDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -80,7 +81,8 @@ func resourceAwsCloudTrail() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -217,7 +219,7 @@ func resourceAwsCloudTrailRead(d *schema.ResourceData, meta interface{}) error {
 		tags = tagsOut.ResourceTagList[0].TagsList
 	}
 
-	if err := d.Set("tags", tagsToMapCloudtrail(tags)); err != nil {
+	if err := setTagsAll(d, meta, tagsToMapCloudtrail(tags)); err != nil {
 		return err
 	}
 
@@ -285,7 +287,7 @@ func resourceAwsCloudTrailUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := setTagsCloudtrail(conn, d)
 		if err != nil {
 			return err
@@ -5,6 +5,7 @@ import (
 	"log"
 	"math"
 	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -22,6 +23,10 @@ func resourceAwsCloudWatchEventTarget() *schema.Resource {
 		Update: resourceAwsCloudWatchEventTargetUpdate,
 		Delete: resourceAwsCloudWatchEventTargetDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCloudWatchEventTargetImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rule": {
 				Type:         schema.TypeString,
@@ -124,6 +129,21 @@ func resourceAwsCloudWatchEventTarget() *schema.Resource {
 	}
 }
 
+func resourceAwsCloudWatchEventTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Rule names may contain dashes, so the "rule-target_id" resource ID
+	// cannot be split reliably
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected RULE-NAME/TARGET-ID", d.Id())
+	}
+
+	d.Set("rule", idParts[0])
+	d.Set("target_id", idParts[1])
+	d.SetId(idParts[0] + "-" + idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsCloudWatchEventTargetCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatcheventsconn
 
@@ -15,10 +15,11 @@ import (
 
 func resourceAwsCloudWatchLogGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsCloudWatchLogGroupCreate,
-		Read:   resourceAwsCloudWatchLogGroupRead,
-		Update: resourceAwsCloudWatchLogGroupUpdate,
-		Delete: resourceAwsCloudWatchLogGroupDelete,
+		Create:        resourceAwsCloudWatchLogGroupCreate,
+		Read:          resourceAwsCloudWatchLogGroupRead,
+		Update:        resourceAwsCloudWatchLogGroupUpdate,
+		Delete:        resourceAwsCloudWatchLogGroupDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -55,7 +56,8 @@ func resourceAwsCloudWatchLogGroup() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -126,7 +128,7 @@ func resourceAwsCloudWatchLogGroupRead(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			return err
 		}
-		d.Set("tags", tags)
+		setTagsAll(d, meta, tags)
 	}
 
 	return nil
@@ -186,8 +188,8 @@ func resourceAwsCloudWatchLogGroupUpdate(d *schema.ResourceData, meta interface{
 
 	restricted := meta.(*AWSClient).IsChinaCloud() || meta.(*AWSClient).IsGovCloud()
 
-	if !restricted && d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if !restricted && d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffCloudWatchTags(o, n)
@@ -272,7 +274,7 @@ func resourceAwsCloudWatchLogGroupDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func flattenCloudWatchTags(d *schema.ResourceData, conn *cloudwatchlogs.CloudWatchLogs) (map[string]interface{}, error) {
+func flattenCloudWatchTags(d *schema.ResourceData, conn *cloudwatchlogs.CloudWatchLogs) (map[string]string, error) {
 	tagsOutput, err := conn.ListTagsLogGroup(&cloudwatchlogs.ListTagsLogGroupInput{
 		LogGroupName: aws.String(d.Get("name").(string)),
 	})
@@ -280,7 +282,7 @@ func flattenCloudWatchTags(d *schema.ResourceData, conn *cloudwatchlogs.CloudWat
 		return nil, errwrap.Wrapf("Error Getting CloudWatch Logs Tag List: {{err}}", err)
 	}
 	if tagsOutput != nil {
-		output := make(map[string]interface{}, len(tagsOutput.Tags))
+		output := make(map[string]string, len(tagsOutput.Tags))
 
 		for i, v := range tagsOutput.Tags {
 			output[i] = *v
@@ -289,5 +291,5 @@ func flattenCloudWatchTags(d *schema.ResourceData, conn *cloudwatchlogs.CloudWat
 		return output, nil
 	}
 
-	return make(map[string]interface{}), nil
+	return make(map[string]string), nil
 }
@@ -20,6 +20,10 @@ func resourceAwsCloudWatchLogMetricFilter() *schema.Resource {
 		Update: resourceAwsCloudWatchLogMetricFilterUpdate,
 		Delete: resourceAwsCloudWatchLogMetricFilterDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCloudWatchLogMetricFilterImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -80,6 +84,19 @@ func resourceAwsCloudWatchLogMetricFilter() *schema.Resource {
 	}
 }
 
+func resourceAwsCloudWatchLogMetricFilterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOG-GROUP-NAME:METRIC-FILTER-NAME", d.Id())
+	}
+
+	d.Set("log_group_name", idParts[0])
+	d.Set("name", idParts[1])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsCloudWatchLogMetricFilterUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatchlogsconn
 
@@ -39,6 +39,12 @@ func TestAccAWSCloudWatchLogMetricFilter_basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:      "aws_cloudwatch_log_metric_filter.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSCloudWatchLogMetricFilterImportStateIdFunc("aws_cloudwatch_log_metric_filter.foobar"),
+			},
 			{
 				Config: testAccAWSCloudWatchLogMetricFilterConfigModified(rInt),
 				Check: resource.ComposeTestCheckFunc(
@@ -191,3 +197,14 @@ resource "aws_cloudwatch_log_group" "dada" {
 }
 `, rInt, rInt)
 }
+
+func testAccAWSCloudWatchLogMetricFilterImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["log_group_name"], rs.Primary.Attributes["name"]), nil
+	}
+}
@@ -26,6 +26,13 @@ func TestAccAWSCloudWatchLogStream_basic(t *testing.T) {
 					testAccCheckCloudWatchLogStreamExists("aws_cloudwatch_log_stream.foobar", &ls),
 				),
 			},
+
+			{
+				ResourceName:      "aws_cloudwatch_log_stream.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSCloudWatchLogStreamImportStateIdFunc("aws_cloudwatch_log_stream.foobar"),
+			},
 		},
 	})
 }
@@ -150,3 +157,14 @@ resource "aws_cloudwatch_log_stream" "foobar" {
 }
 `, rName, rName)
 }
+
+func testAccAWSCloudWatchLogStreamImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["log_group_name"], rs.Primary.Attributes["name"]), nil
+	}
+}
@@ -22,6 +22,10 @@ func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
 		Update: resourceAwsCloudwatchLogSubscriptionFilterUpdate,
 		Delete: resourceAwsCloudwatchLogSubscriptionFilterDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCloudwatchLogSubscriptionFilterImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -52,6 +56,20 @@ func resourceAwsCloudwatchLogSubscriptionFilter() *schema.Resource {
 	}
 }
 
+func resourceAwsCloudwatchLogSubscriptionFilterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Log group names may contain slashes, so use a pipe as the separator
+	idParts := strings.Split(d.Id(), "|")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOG-GROUP-NAME|FILTER-NAME", d.Id())
+	}
+
+	d.Set("log_group_name", idParts[0])
+	d.Set("name", idParts[1])
+	d.SetId(cloudwatchLogsSubscriptionFilterId(idParts[0]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsCloudwatchLogSubscriptionFilterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).cloudwatchlogsconn
 	params := getAwsCloudWatchLogsSubscriptionFilterInput(d)
@@ -148,6 +166,10 @@ func resourceAwsCloudwatchLogSubscriptionFilterRead(d *schema.ResourceData, meta
 	for _, subscriptionFilter := range resp.SubscriptionFilters {
 		if *subscriptionFilter.LogGroupName == log_group_name {
 			d.SetId(cloudwatchLogsSubscriptionFilterId(log_group_name))
+			d.Set("name", subscriptionFilter.FilterName)
+			d.Set("destination_arn", subscriptionFilter.DestinationArn)
+			d.Set("filter_pattern", subscriptionFilter.FilterPattern)
+			d.Set("role_arn", subscriptionFilter.RoleArn)
 			return nil // OK, matching subscription filter found
 		}
 	}
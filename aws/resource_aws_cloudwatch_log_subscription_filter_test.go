@@ -33,6 +33,13 @@ func TestAccAWSCloudwatchLogSubscriptionFilter_basic(t *testing.T) {
 						"aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter", "log_group_name", fmt.Sprintf("example_lambda_name_%s", rstring)),
 				),
 			},
+
+			{
+				ResourceName:      "aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSCloudwatchLogSubscriptionFilterImportStateIdFunc("aws_cloudwatch_log_subscription_filter.test_lambdafunction_logfilter"),
+			},
 		},
 	})
 }
@@ -218,3 +225,14 @@ EOF
 }
 `, rstring, rstring, rstring, rstring, rstring)
 }
+
+func testAccAWSCloudwatchLogSubscriptionFilterImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s|%s", rs.Primary.Attributes["log_group_name"], rs.Primary.Attributes["name"]), nil
+	}
+}
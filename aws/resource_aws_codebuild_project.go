@@ -17,10 +17,15 @@ import (
 
 func resourceAwsCodeBuildProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsCodeBuildProjectCreate,
-		Read:   resourceAwsCodeBuildProjectRead,
-		Update: resourceAwsCodeBuildProjectUpdate,
-		Delete: resourceAwsCodeBuildProjectDelete,
+		Create:        resourceAwsCodeBuildProjectCreate,
+		Read:          resourceAwsCodeBuildProjectRead,
+		Update:        resourceAwsCodeBuildProjectUpdate,
+		Delete:        resourceAwsCodeBuildProjectDelete,
+		CustomizeDiff: setTagsAllDiff,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"artifacts": {
@@ -178,7 +183,8 @@ func resourceAwsCodeBuildProject() *schema.Resource {
 				Default:      "60",
 				ValidateFunc: validateAwsCodeBuildTimeout,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -213,7 +219,7 @@ func resourceAwsCodeBuildProjectCreate(d *schema.ResourceData, meta interface{})
 		params.TimeoutInMinutes = aws.Int64(int64(v.(int)))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params.Tags = tagsFromMapCodeBuild(v.(map[string]interface{}))
 	}
 
@@ -396,7 +402,7 @@ func resourceAwsCodeBuildProjectRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("service_role", project.ServiceRole)
 	d.Set("build_timeout", project.TimeoutInMinutes)
 
-	if err := d.Set("tags", tagsToMapCodeBuild(project.Tags)); err != nil {
+	if err := setTagsAll(d, meta, tagsToMapCodeBuild(project.Tags)); err != nil {
 		return err
 	}
 
@@ -443,7 +449,7 @@ func resourceAwsCodeBuildProjectUpdate(d *schema.ResourceData, meta interface{})
 
 	// The documentation clearly says "The replacement set of tags for this build project."
 	// But its a slice of pointers so if not set for every update, they get removed.
-	params.Tags = tagsFromMapCodeBuild(d.Get("tags").(map[string]interface{}))
+	params.Tags = tagsFromMapCodeBuild(d.Get("tags_all").(map[string]interface{}))
 
 	_, err := conn.UpdateProject(params)
 
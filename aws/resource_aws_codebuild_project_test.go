@@ -37,6 +37,12 @@ func TestAccAWSCodeBuildProject_basic(t *testing.T) {
 						"aws_codebuild_project.foo", "build_timeout", "50"),
 				),
 			},
+
+			{
+				ResourceName:      "aws_codebuild_project.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -15,6 +15,10 @@ func resourceAwsCodeCommitTrigger() *schema.Resource {
 		Read:   resourceAwsCodeCommitTriggerRead,
 		Delete: resourceAwsCodeCommitTriggerDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCodeCommitTriggerImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"repository_name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -70,6 +74,11 @@ func resourceAwsCodeCommitTrigger() *schema.Resource {
 	}
 }
 
+func resourceAwsCodeCommitTriggerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("repository_name", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsCodeCommitTriggerCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).codecommitconn
 
@@ -108,6 +117,11 @@ func resourceAwsCodeCommitTriggerRead(d *schema.ResourceData, meta interface{})
 
 	log.Printf("[DEBUG] CodeCommit Trigger: %s", resp)
 
+	d.Set("configuration_id", resp.ConfigurationId)
+	if err := d.Set("trigger", flattenAwsCodeCommitTriggers(resp.Triggers)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -161,3 +175,17 @@ func expandAwsCodeCommitTriggers(configured []interface{}) []*codecommit.Reposit
 	}
 	return triggers
 }
+
+func flattenAwsCodeCommitTriggers(triggers []*codecommit.RepositoryTrigger) []interface{} {
+	result := make([]interface{}, 0, len(triggers))
+	for _, t := range triggers {
+		result = append(result, map[string]interface{}{
+			"name":            aws.StringValue(t.Name),
+			"destination_arn": aws.StringValue(t.DestinationArn),
+			"custom_data":     aws.StringValue(t.CustomData),
+			"branches":        flattenStringList(t.Branches),
+			"events":          flattenStringList(t.Events),
+		})
+	}
+	return result
+}
@@ -19,6 +19,10 @@ func resourceAwsCodeDeployApp() *schema.Resource {
 		Update: resourceAwsCodeDeployUpdate,
 		Delete: resourceAwsCodeDeployAppDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsCodeDeployAppImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -36,6 +40,24 @@ func resourceAwsCodeDeployApp() *schema.Resource {
 	}
 }
 
+func resourceAwsCodeDeployAppImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).codedeployconn
+
+	// The resource ID is prefixed with the application ID, which is not
+	// something users can easily look up
+	application := d.Id()
+	resp, err := conn.GetApplication(&codedeploy.GetApplicationInput{
+		ApplicationName: aws.String(application),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", *resp.Application.ApplicationId, application))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsCodeDeployAppCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).codedeployconn
 
@@ -79,6 +101,7 @@ func resourceAwsCodeDeployAppRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	d.Set("name", resp.Application.ApplicationName)
+	d.Set("unique_id", resp.Application.ApplicationId)
 
 	return nil
 }
@@ -23,6 +23,12 @@ func TestAccAWSCodeDeployApp_basic(t *testing.T) {
 					testAccCheckAWSCodeDeployAppExists("aws_codedeploy_app.foo"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_codedeploy_app.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSCodeDeployAppImportStateIdFunc("aws_codedeploy_app.foo"),
+			},
 			resource.TestStep{
 				Config: testAccAWSCodeDeployAppModified,
 				Check: resource.ComposeTestCheckFunc(
@@ -79,3 +85,14 @@ var testAccAWSCodeDeployAppModified = `
 resource "aws_codedeploy_app" "foo" {
 	name = "bar"
 }`
+
+func testAccAWSCodeDeployAppImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["name"], nil
+	}
+}
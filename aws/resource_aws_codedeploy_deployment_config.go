@@ -16,6 +16,10 @@ func resourceAwsCodeDeployDeploymentConfig() *schema.Resource {
 		Read:   resourceAwsCodeDeployDeploymentConfigRead,
 		Delete: resourceAwsCodeDeployDeploymentConfigDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"deployment_config_name": {
 				Type:     schema.TypeString,
@@ -32,6 +32,12 @@ func TestAccAWSCodeDeployDeploymentConfig_fleetPercent(t *testing.T) {
 						"aws_codedeploy_deployment_config.foo", "minimum_healthy_hosts.0.value", "75"),
 				),
 			},
+
+			{
+				ResourceName:      "aws_codedeploy_deployment_config.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
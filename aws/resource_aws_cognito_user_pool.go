@@ -17,10 +17,11 @@ import (
 
 func resourceAwsCognitoUserPool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsCognitoUserPoolCreate,
-		Read:   resourceAwsCognitoUserPoolRead,
-		Update: resourceAwsCognitoUserPoolUpdate,
-		Delete: resourceAwsCognitoUserPoolDelete,
+		Create:        resourceAwsCognitoUserPoolCreate,
+		Read:          resourceAwsCognitoUserPoolRead,
+		Update:        resourceAwsCognitoUserPoolUpdate,
+		Delete:        resourceAwsCognitoUserPoolDelete,
+		CustomizeDiff: setTagsAllDiff,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -355,7 +356,8 @@ func resourceAwsCognitoUserPool() *schema.Resource {
 				ValidateFunc: validateCognitoUserPoolSmsVerificationMessage,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 
 			"username_attributes": {
 				Type:     schema.TypeList,
@@ -549,7 +551,7 @@ func resourceAwsCognitoUserPoolCreate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 	log.Printf("[DEBUG] Creating Cognito User Pool: %s", params)
@@ -657,7 +659,7 @@ func resourceAwsCognitoUserPoolRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("creation_date", resp.UserPool.CreationDate.Format(time.RFC3339))
 	d.Set("last_modified_date", resp.UserPool.LastModifiedDate.Format(time.RFC3339))
 	d.Set("name", resp.UserPool.Name)
-	d.Set("tags", tagsToMapGeneric(resp.UserPool.UserPoolTags))
+	setTagsAll(d, meta, tagsToMapGeneric(resp.UserPool.UserPoolTags))
 
 	return nil
 }
@@ -786,7 +788,7 @@ func resourceAwsCognitoUserPoolUpdate(d *schema.ResourceData, meta interface{})
 		params.SmsVerificationMessage = aws.String(v)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		params.UserPoolTags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 
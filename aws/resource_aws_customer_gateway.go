@@ -16,10 +16,11 @@ import (
 
 func resourceAwsCustomerGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsCustomerGatewayCreate,
-		Read:   resourceAwsCustomerGatewayRead,
-		Update: resourceAwsCustomerGatewayUpdate,
-		Delete: resourceAwsCustomerGatewayDelete,
+		Create:        resourceAwsCustomerGatewayCreate,
+		Read:          resourceAwsCustomerGatewayRead,
+		Update:        resourceAwsCustomerGatewayUpdate,
+		Delete:        resourceAwsCustomerGatewayDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -43,7 +44,8 @@ func resourceAwsCustomerGateway() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -201,7 +203,7 @@ func resourceAwsCustomerGatewayRead(d *schema.ResourceData, meta interface{}) er
 	customerGateway := resp.CustomerGateways[0]
 	d.Set("ip_address", customerGateway.IpAddress)
 	d.Set("type", customerGateway.Type)
-	d.Set("tags", tagsToMap(customerGateway.Tags))
+	setTagsAll(d, meta, tagsToMap(customerGateway.Tags))
 
 	if *customerGateway.BgpAsn != "" {
 		val, err := strconv.ParseInt(*customerGateway.BgpAsn, 0, 0)
@@ -224,6 +226,7 @@ func resourceAwsCustomerGatewayUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	return resourceAwsCustomerGatewayRead(d, meta)
 }
@@ -14,10 +14,11 @@ import (
 
 func resourceAwsDbEventSubscription() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsDbEventSubscriptionCreate,
-		Read:   resourceAwsDbEventSubscriptionRead,
-		Update: resourceAwsDbEventSubscriptionUpdate,
-		Delete: resourceAwsDbEventSubscriptionDelete,
+		Create:        resourceAwsDbEventSubscriptionCreate,
+		Read:          resourceAwsDbEventSubscriptionRead,
+		Update:        resourceAwsDbEventSubscriptionUpdate,
+		Delete:        resourceAwsDbEventSubscriptionDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsDbEventSubscriptionImport,
 		},
@@ -59,7 +60,8 @@ func resourceAwsDbEventSubscription() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -67,7 +69,7 @@ func resourceAwsDbEventSubscription() *schema.Resource {
 func resourceAwsDbEventSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
 	rdsconn := meta.(*AWSClient).rdsconn
 	name := d.Get("name").(string)
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	sourceIdsSet := d.Get("source_ids").(*schema.Set)
 	sourceIds := make([]*string, sourceIdsSet.Len())
@@ -170,7 +172,7 @@ func resourceAwsDbEventSubscriptionRead(d *schema.ResourceData, meta interface{}
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		setTagsAll(d, meta, tagsToMapRDS(dt))
 	}
 
 	return nil
@@ -269,6 +271,7 @@ func resourceAwsDbEventSubscriptionUpdate(d *schema.ResourceData, meta interface
 			return err
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
@@ -17,10 +17,11 @@ import (
 
 func resourceAwsDbInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsDbInstanceCreate,
-		Read:   resourceAwsDbInstanceRead,
-		Update: resourceAwsDbInstanceUpdate,
-		Delete: resourceAwsDbInstanceDelete,
+		Create:        resourceAwsDbInstanceCreate,
+		Read:          resourceAwsDbInstanceRead,
+		Update:        resourceAwsDbInstanceUpdate,
+		Delete:        resourceAwsDbInstanceDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsDbInstanceImport,
 		},
@@ -350,14 +351,15 @@ func resourceAwsDbInstance() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var identifier string
 	if v, ok := d.GetOk("identifier"); ok {
@@ -800,7 +802,7 @@ func resourceAwsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		setTagsAll(d, meta, tagsToMapRDS(dt))
 	}
 
 	// Create an empty schema.Set to hold all vpc security group ids
@@ -1082,6 +1084,7 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 			return err
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 	d.Partial(false)
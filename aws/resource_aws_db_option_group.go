@@ -17,10 +17,11 @@ import (
 
 func resourceAwsDbOptionGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsDbOptionGroupCreate,
-		Read:   resourceAwsDbOptionGroupRead,
-		Update: resourceAwsDbOptionGroupUpdate,
-		Delete: resourceAwsDbOptionGroupDelete,
+		Create:        resourceAwsDbOptionGroupCreate,
+		Read:          resourceAwsDbOptionGroupRead,
+		Update:        resourceAwsDbOptionGroupUpdate,
+		Delete:        resourceAwsDbOptionGroupDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -112,14 +113,15 @@ func resourceAwsDbOptionGroup() *schema.Resource {
 				Set: resourceAwsDbOptionHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsDbOptionGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	rdsconn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var groupName string
 	if v, ok := d.GetOk("name"); ok {
@@ -211,7 +213,7 @@ func resourceAwsDbOptionGroupRead(d *schema.ResourceData, meta interface{}) erro
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		setTagsAll(d, meta, tagsToMapRDS(dt))
 	}
 
 	return nil
@@ -289,6 +291,7 @@ func resourceAwsDbOptionGroupUpdate(d *schema.ResourceData, meta interface{}) er
 			return err
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
@@ -18,10 +18,11 @@ import (
 
 func resourceAwsDbParameterGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsDbParameterGroupCreate,
-		Read:   resourceAwsDbParameterGroupRead,
-		Update: resourceAwsDbParameterGroupUpdate,
-		Delete: resourceAwsDbParameterGroupDelete,
+		Create:        resourceAwsDbParameterGroupCreate,
+		Read:          resourceAwsDbParameterGroupRead,
+		Update:        resourceAwsDbParameterGroupUpdate,
+		Delete:        resourceAwsDbParameterGroupDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -81,14 +82,15 @@ func resourceAwsDbParameterGroup() *schema.Resource {
 				Set: resourceAwsDbParameterHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsDbParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	rdsconn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var groupName string
 	if v, ok := d.GetOk("name"); ok {
@@ -181,7 +183,7 @@ func resourceAwsDbParameterGroupRead(d *schema.ResourceData, meta interface{}) e
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		setTagsAll(d, meta, tagsToMapRDS(dt))
 	}
 
 	return nil
@@ -241,6 +243,7 @@ func resourceAwsDbParameterGroupUpdate(d *schema.ResourceData, meta interface{})
 			return err
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
@@ -17,10 +17,11 @@ import (
 
 func resourceAwsDbSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsDbSecurityGroupCreate,
-		Read:   resourceAwsDbSecurityGroupRead,
-		Update: resourceAwsDbSecurityGroupUpdate,
-		Delete: resourceAwsDbSecurityGroupDelete,
+		Create:        resourceAwsDbSecurityGroupCreate,
+		Read:          resourceAwsDbSecurityGroupRead,
+		Update:        resourceAwsDbSecurityGroupUpdate,
+		Delete:        resourceAwsDbSecurityGroupDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -76,14 +77,15 @@ func resourceAwsDbSecurityGroup() *schema.Resource {
 				Set: resourceAwsDbSecurityGroupIngressHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsDbSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var err error
 	var errs []error
@@ -197,7 +199,7 @@ func resourceAwsDbSecurityGroupRead(d *schema.ResourceData, meta interface{}) er
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		setTagsAll(d, meta, tagsToMapRDS(dt))
 	}
 
 	return nil
@@ -212,6 +214,7 @@ func resourceAwsDbSecurityGroupUpdate(d *schema.ResourceData, meta interface{})
 			return err
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
@@ -18,6 +18,10 @@ func resourceAwsDbSnapshot() *schema.Resource {
 		Read:   resourceAwsDbSnapshotRead,
 		Delete: resourceAwsDbSnapshotDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Read: schema.DefaultTimeout(20 * time.Minute),
 		},
@@ -151,6 +155,8 @@ func resourceAwsDbSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 
 	snapshot := resp.DBSnapshots[0]
 
+	d.Set("db_snapshot_identifier", snapshot.DBSnapshotIdentifier)
+	d.Set("db_instance_identifier", snapshot.DBInstanceIdentifier)
 	d.Set("allocated_storage", snapshot.AllocatedStorage)
 	d.Set("availability_zone", snapshot.AvailabilityZone)
 	d.Set("db_snapshot_arn", snapshot.DBSnapshotArn)
@@ -166,6 +172,7 @@ func resourceAwsDbSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("source_region", snapshot.SourceRegion)
 	d.Set("snapshot_type", snapshot.SnapshotType)
 	d.Set("status", snapshot.Status)
+	d.Set("storage_type", snapshot.StorageType)
 	d.Set("vpc_id", snapshot.VpcId)
 
 	return nil
@@ -24,6 +24,12 @@ func TestAccAWSDBSnapshot_basic(t *testing.T) {
 					testAccCheckDbSnapshotExists("aws_db_snapshot.test", &v),
 				),
 			},
+
+			{
+				ResourceName:      "aws_db_snapshot.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -15,10 +15,11 @@ import (
 
 func resourceAwsDbSubnetGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsDbSubnetGroupCreate,
-		Read:   resourceAwsDbSubnetGroupRead,
-		Update: resourceAwsDbSubnetGroupUpdate,
-		Delete: resourceAwsDbSubnetGroupDelete,
+		Create:        resourceAwsDbSubnetGroupCreate,
+		Read:          resourceAwsDbSubnetGroupRead,
+		Update:        resourceAwsDbSubnetGroupUpdate,
+		Delete:        resourceAwsDbSubnetGroupDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -58,14 +59,15 @@ func resourceAwsDbSubnetGroup() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsDbSubnetGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	rdsconn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	subnetIdsSet := d.Get("subnet_ids").(*schema.Set)
 	subnetIds := make([]*string, subnetIdsSet.Len())
@@ -164,7 +166,7 @@ func resourceAwsDbSubnetGroupRead(d *schema.ResourceData, meta interface{}) erro
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		setTagsAll(d, meta, tagsToMapRDS(dt))
 	}
 
 	return nil
@@ -200,6 +202,7 @@ func resourceAwsDbSubnetGroupUpdate(d *schema.ResourceData, meta interface{}) er
 			return err
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
@@ -24,7 +24,13 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 		// We reuse aws_network_acl's read method, the operations are the same
 		Read:   resourceAwsNetworkAclRead,
 		Delete: resourceAwsDefaultNetworkAclDelete,
-		Update: resourceAwsDefaultNetworkAclUpdate,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsDefaultNetworkAclImport,
+		},
+
+		Update:        resourceAwsDefaultNetworkAclUpdate,
+		CustomizeDiff: setTagsAllDiff,
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -145,11 +151,17 @@ func resourceAwsDefaultNetworkAcl() *schema.Resource {
 				Set: resourceAwsNetworkAclEntryHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
+func resourceAwsDefaultNetworkAclImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("default_network_acl_id", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsDefaultNetworkAclCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(d.Get("default_network_acl_id").(string))
 
@@ -234,6 +246,7 @@ func resourceAwsDefaultNetworkAclUpdate(d *schema.ResourceData, meta interface{}
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -40,6 +40,12 @@ func TestAccAWSDefaultNetworkAcl_basic(t *testing.T) {
 					testAccCheckAWSDefaultACLAttributes(&networkAcl, []*ec2.NetworkAclEntry{}, 0, 2),
 				),
 			},
+
+			{
+				ResourceName:      "aws_default_network_acl.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
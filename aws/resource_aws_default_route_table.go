@@ -11,10 +11,15 @@ import (
 
 func resourceAwsDefaultRouteTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsDefaultRouteTableCreate,
-		Read:   resourceAwsDefaultRouteTableRead,
-		Update: resourceAwsRouteTableUpdate,
-		Delete: resourceAwsDefaultRouteTableDelete,
+		Create:        resourceAwsDefaultRouteTableCreate,
+		Read:          resourceAwsDefaultRouteTableRead,
+		Update:        resourceAwsRouteTableUpdate,
+		Delete:        resourceAwsDefaultRouteTableDelete,
+		CustomizeDiff: setTagsAllDiff,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsDefaultRouteTableImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"default_route_table_id": {
@@ -85,11 +90,43 @@ func resourceAwsDefaultRouteTable() *schema.Resource {
 				Set: resourceAwsRouteTableHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
+func resourceAwsDefaultRouteTableImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	resp, err := conn.DescribeRouteTables(&ec2.DescribeRouteTablesInput{
+		RouteTableIds: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.RouteTables) < 1 || resp.RouteTables[0] == nil {
+		return nil, fmt.Errorf("route table %s is not found", d.Id())
+	}
+	rt := resp.RouteTables[0]
+
+	main := false
+	for _, a := range rt.Associations {
+		if aws.BoolValue(a.Main) {
+			main = true
+			break
+		}
+	}
+	if !main {
+		return nil, fmt.Errorf("route table %s is not the main route table of %s", d.Id(), aws.StringValue(rt.VpcId))
+	}
+
+	// Read looks the default route table up by VPC
+	d.Set("vpc_id", rt.VpcId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsDefaultRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(d.Get("default_route_table_id").(string))
 
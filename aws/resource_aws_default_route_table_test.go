@@ -27,6 +27,12 @@ func TestAccAWSDefaultRouteTable_basic(t *testing.T) {
 						"aws_default_route_table.foo", &v),
 				),
 			},
+
+			{
+				ResourceName:      "aws_default_route_table.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
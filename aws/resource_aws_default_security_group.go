@@ -16,6 +16,12 @@ func resourceAwsDefaultSecurityGroup() *schema.Resource {
 	dsg.Create = resourceAwsDefaultSecurityGroupCreate
 	dsg.Delete = resourceAwsDefaultSecurityGroupDelete
 
+	// Rules are managed inline, so don't split them out into separate
+	// aws_security_group_rule resources on import like aws_security_group does
+	dsg.Importer = &schema.ResourceImporter{
+		State: schema.ImportStatePassthrough,
+	}
+
 	// Descriptions cannot be updated
 	delete(dsg.Schema, "description")
 
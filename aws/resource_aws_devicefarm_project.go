@@ -16,6 +16,10 @@ func resourceAwsDevicefarmProject() *schema.Resource {
 		Update: resourceAwsDevicefarmProjectUpdate,
 		Delete: resourceAwsDevicefarmProjectDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": &schema.Schema{
 				Type:     schema.TypeString,
@@ -43,6 +43,12 @@ func TestAccAWSDeviceFarmProject_basic(t *testing.T) {
 						t, &afterCreate, &afterUpdate),
 				),
 			},
+
+			{
+				ResourceName:      "aws_devicefarm_project.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
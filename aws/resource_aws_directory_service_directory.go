@@ -21,10 +21,11 @@ var directoryCreationFuncs = map[string]func(*directoryservice.DirectoryService,
 
 func resourceAwsDirectoryServiceDirectory() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsDirectoryServiceDirectoryCreate,
-		Read:   resourceAwsDirectoryServiceDirectoryRead,
-		Update: resourceAwsDirectoryServiceDirectoryUpdate,
-		Delete: resourceAwsDirectoryServiceDirectoryDelete,
+		Create:        resourceAwsDirectoryServiceDirectoryCreate,
+		Read:          resourceAwsDirectoryServiceDirectoryRead,
+		Update:        resourceAwsDirectoryServiceDirectoryUpdate,
+		Delete:        resourceAwsDirectoryServiceDirectoryDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -64,7 +65,8 @@ func resourceAwsDirectoryServiceDirectory() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 			"vpc_settings": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -452,7 +454,7 @@ func resourceAwsDirectoryServiceDirectoryRead(d *schema.ResourceData, meta inter
 	if err != nil {
 		return fmt.Errorf("Failed to get Directory service tags (id: %s): %s", d.Id(), err)
 	}
-	d.Set("tags", tagsToMapDS(tagList.Tags))
+	setTagsAll(d, meta, tagsToMapDS(tagList.Tags))
 
 	return nil
 }
@@ -15,10 +15,11 @@ import (
 
 func resourceAwsDmsEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsDmsEndpointCreate,
-		Read:   resourceAwsDmsEndpointRead,
-		Update: resourceAwsDmsEndpointUpdate,
-		Delete: resourceAwsDmsEndpointDelete,
+		Create:        resourceAwsDmsEndpointCreate,
+		Read:          resourceAwsDmsEndpointRead,
+		Update:        resourceAwsDmsEndpointUpdate,
+		Delete:        resourceAwsDmsEndpointDelete,
+		CustomizeDiff: setTagsAllDiff,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -112,6 +113,7 @@ func resourceAwsDmsEndpoint() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsSchemaAll(),
 			"username": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -127,7 +129,7 @@ func resourceAwsDmsEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		EndpointIdentifier: aws.String(d.Get("endpoint_id").(string)),
 		EndpointType:       aws.String(d.Get("endpoint_type").(string)),
 		EngineName:         aws.String(d.Get("engine_name").(string)),
-		Tags:               dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags:               dmsTagsFromMap(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	// if dynamodb then add required params
@@ -214,7 +216,7 @@ func resourceAwsDmsEndpointRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	setTagsAll(d, meta, dmsTagsToMap(tagsResp.TagList))
 
 	return nil
 }
@@ -284,7 +286,7 @@ func resourceAwsDmsEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 		hasChanges = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := dmsSetTags(d.Get("endpoint_arn").(string), d, meta)
 		if err != nil {
 			return err
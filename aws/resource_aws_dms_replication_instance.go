@@ -14,10 +14,11 @@ import (
 
 func resourceAwsDmsReplicationInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsDmsReplicationInstanceCreate,
-		Read:   resourceAwsDmsReplicationInstanceRead,
-		Update: resourceAwsDmsReplicationInstanceUpdate,
-		Delete: resourceAwsDmsReplicationInstanceDelete,
+		Create:        resourceAwsDmsReplicationInstanceCreate,
+		Read:          resourceAwsDmsReplicationInstanceRead,
+		Update:        resourceAwsDmsReplicationInstanceUpdate,
+		Delete:        resourceAwsDmsReplicationInstanceDelete,
+		CustomizeDiff: setTagsAllDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -116,6 +117,7 @@ func resourceAwsDmsReplicationInstance() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsSchemaAll(),
 			"vpc_security_group_ids": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -135,7 +137,7 @@ func resourceAwsDmsReplicationInstanceCreate(d *schema.ResourceData, meta interf
 		PubliclyAccessible:            aws.Bool(d.Get("publicly_accessible").(bool)),
 		ReplicationInstanceClass:      aws.String(d.Get("replication_instance_class").(string)),
 		ReplicationInstanceIdentifier: aws.String(d.Get("replication_instance_id").(string)),
-		Tags: dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags: dmsTagsFromMap(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	// WARNING: GetOk returns the zero value for the type if the key is omitted in config. This means for optional
@@ -232,7 +234,7 @@ func resourceAwsDmsReplicationInstanceRead(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return err
 	}
-	d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	setTagsAll(d, meta, dmsTagsToMap(tagsResp.TagList))
 
 	return nil
 }
@@ -291,7 +293,7 @@ func resourceAwsDmsReplicationInstanceUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := dmsSetTags(d.Get("replication_instance_arn").(string), d, meta)
 		if err != nil {
 			return err
@@ -11,10 +11,11 @@ import (
 
 func resourceAwsDmsReplicationSubnetGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsDmsReplicationSubnetGroupCreate,
-		Read:   resourceAwsDmsReplicationSubnetGroupRead,
-		Update: resourceAwsDmsReplicationSubnetGroupUpdate,
-		Delete: resourceAwsDmsReplicationSubnetGroupDelete,
+		Create:        resourceAwsDmsReplicationSubnetGroupCreate,
+		Read:          resourceAwsDmsReplicationSubnetGroupRead,
+		Update:        resourceAwsDmsReplicationSubnetGroupUpdate,
+		Delete:        resourceAwsDmsReplicationSubnetGroupDelete,
+		CustomizeDiff: setTagsAllDiff,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -45,6 +46,7 @@ func resourceAwsDmsReplicationSubnetGroup() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsSchemaAll(),
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -60,7 +62,7 @@ func resourceAwsDmsReplicationSubnetGroupCreate(d *schema.ResourceData, meta int
 		ReplicationSubnetGroupIdentifier:  aws.String(d.Get("replication_subnet_group_id").(string)),
 		ReplicationSubnetGroupDescription: aws.String(d.Get("replication_subnet_group_description").(string)),
 		SubnetIds:                         expandStringList(d.Get("subnet_ids").(*schema.Set).List()),
-		Tags:                              dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags:                              dmsTagsFromMap(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	log.Println("[DEBUG] DMS create replication subnet group:", request)
@@ -109,7 +111,7 @@ func resourceAwsDmsReplicationSubnetGroupRead(d *schema.ResourceData, meta inter
 	if err != nil {
 		return err
 	}
-	d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	setTagsAll(d, meta, dmsTagsToMap(tagsResp.TagList))
 
 	return nil
 }
@@ -128,7 +130,7 @@ func resourceAwsDmsReplicationSubnetGroupUpdate(d *schema.ResourceData, meta int
 		request.ReplicationSubnetGroupDescription = aws.String(d.Get("replication_subnet_group_description").(string))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := dmsSetTags(d.Get("replication_subnet_group_arn").(string), d, meta)
 		if err != nil {
 			return err
@@ -16,10 +16,11 @@ import (
 
 func resourceAwsDmsReplicationTask() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsDmsReplicationTaskCreate,
-		Read:   resourceAwsDmsReplicationTaskRead,
-		Update: resourceAwsDmsReplicationTaskUpdate,
-		Delete: resourceAwsDmsReplicationTaskDelete,
+		Create:        resourceAwsDmsReplicationTaskCreate,
+		Read:          resourceAwsDmsReplicationTaskRead,
+		Update:        resourceAwsDmsReplicationTaskUpdate,
+		Delete:        resourceAwsDmsReplicationTaskDelete,
+		CustomizeDiff: setTagsAllDiff,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -78,6 +79,7 @@ func resourceAwsDmsReplicationTask() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsSchemaAll(),
 			"target_endpoint_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -97,7 +99,7 @@ func resourceAwsDmsReplicationTaskCreate(d *schema.ResourceData, meta interface{
 		ReplicationTaskIdentifier: aws.String(d.Get("replication_task_id").(string)),
 		SourceEndpointArn:         aws.String(d.Get("source_endpoint_arn").(string)),
 		TableMappings:             aws.String(d.Get("table_mappings").(string)),
-		Tags:                      dmsTagsFromMap(d.Get("tags").(map[string]interface{})),
+		Tags:                      dmsTagsFromMap(d.Get("tags_all").(map[string]interface{})),
 		TargetEndpointArn:         aws.String(d.Get("target_endpoint_arn").(string)),
 	}
 
@@ -172,7 +174,7 @@ func resourceAwsDmsReplicationTaskRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
-	d.Set("tags", dmsTagsToMap(tagsResp.TagList))
+	setTagsAll(d, meta, dmsTagsToMap(tagsResp.TagList))
 
 	return nil
 }
@@ -209,7 +211,7 @@ func resourceAwsDmsReplicationTaskUpdate(d *schema.ResourceData, meta interface{
 		hasChanges = true
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		err := dmsSetTags(d.Get("replication_task_arn").(string), d, meta)
 		if err != nil {
 			return err
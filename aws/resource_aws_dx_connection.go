@@ -16,6 +16,10 @@ func resourceAwsDxConnection() *schema.Resource {
 		Read:   resourceAwsDxConnectionRead,
 		Delete: resourceAwsDxConnectionDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -74,6 +78,9 @@ func resourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error
 	if d.Id() != *resp.Connections[0].ConnectionId {
 		return fmt.Errorf("[ERROR] DX Connection (%s) not found", connectionId)
 	}
+	d.Set("name", resp.Connections[0].ConnectionName)
+	d.Set("bandwidth", resp.Connections[0].Bandwidth)
+	d.Set("location", resp.Connections[0].Location)
 	return nil
 }
 
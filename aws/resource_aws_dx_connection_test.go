@@ -23,6 +23,12 @@ func TestAccAwsDxConnection_basic(t *testing.T) {
 					testAccCheckAwsDxConnectionExists("aws_dx_connection.hoge"),
 				),
 			},
+
+			{
+				ResourceName:      "aws_dx_connection.hoge",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
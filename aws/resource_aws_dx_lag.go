@@ -17,6 +17,10 @@ func resourceAwsDxLag() *schema.Resource {
 		Update: resourceAwsDxLagUpdate,
 		Delete: resourceAwsDxLagDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -85,6 +89,10 @@ func resourceAwsDxLagRead(d *schema.ResourceData, meta interface{}) error {
 	if d.Id() != *resp.Lags[0].LagId {
 		return fmt.Errorf("[ERROR] DX Lag (%s) not found", lagId)
 	}
+	d.Set("name", resp.Lags[0].LagName)
+	d.Set("connections_bandwidth", resp.Lags[0].ConnectionsBandwidth)
+	d.Set("location", resp.Lags[0].Location)
+	d.Set("number_of_connections", resp.Lags[0].NumberOfConnections)
 	return nil
 }
 
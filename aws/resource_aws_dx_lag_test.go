@@ -23,6 +23,13 @@ func TestAccAwsDxLag_basic(t *testing.T) {
 					testAccCheckAwsDxLagExists("aws_dx_lag.hoge"),
 				),
 			},
+
+			{
+				ResourceName:            "aws_dx_lag.hoge",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
 		},
 	})
 }
@@ -31,10 +31,11 @@ const DYNAMODB_LIMIT_EXCEEDED_SLEEP = 10 * time.Second
 // default values specified below)
 func resourceAwsDynamoDbTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsDynamoDbTableCreate,
-		Read:   resourceAwsDynamoDbTableRead,
-		Update: resourceAwsDynamoDbTableUpdate,
-		Delete: resourceAwsDynamoDbTableDelete,
+		Create:        resourceAwsDynamoDbTableCreate,
+		Read:          resourceAwsDynamoDbTableRead,
+		Update:        resourceAwsDynamoDbTableUpdate,
+		Delete:        resourceAwsDynamoDbTableDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -201,7 +202,8 @@ func resourceAwsDynamoDbTable() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -318,7 +320,7 @@ func resourceAwsDynamoDbTableCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	_, timeToLiveOk := d.GetOk("ttl")
-	_, tagsOk := d.GetOk("tags")
+	_, tagsOk := d.GetOk("tags_all")
 
 	attemptCount := 1
 	for attemptCount <= DYNAMODB_MAX_THROTTLE_RETRIES {
@@ -831,7 +833,7 @@ func flattenAwsDynamoDbTableResource(d *schema.ResourceData, meta interface{}, t
 		return err
 	}
 	if len(tags) != 0 {
-		d.Set("tags", tags)
+		setTagsAll(d, meta, tags)
 	}
 
 	return nil
@@ -1063,7 +1065,7 @@ func createTableTags(d *schema.ResourceData, meta interface{}) error {
 	if err := waitForTableToBeActive(d.Id(), meta); err != nil {
 		return err
 	}
-	tags := d.Get("tags").(map[string]interface{})
+	tags := d.Get("tags_all").(map[string]interface{})
 	arn := d.Get("arn").(string)
 	dynamodbconn := meta.(*AWSClient).dynamodbconn
 	req := &dynamodb.TagResourceInput{
@@ -18,6 +18,12 @@ func resourceAwsEbsSnapshot() *schema.Resource {
 		Read:   resourceAwsEbsSnapshotRead,
 		Delete: resourceAwsEbsSnapshotDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"volume_id": {
 				Type:     schema.TypeString,
@@ -59,6 +65,12 @@ func resourceAwsEbsSnapshot() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				ForceNew: true,
+			},
 		},
 	}
 }
@@ -113,10 +125,10 @@ func resourceAwsEbsSnapshotRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("owner_alias", snapshot.OwnerAlias)
 	d.Set("volume_id", snapshot.VolumeId)
 	d.Set("data_encryption_key_id", snapshot.DataEncryptionKeyId)
-	d.Set("kms_keey_id", snapshot.KmsKeyId)
+	d.Set("kms_key_id", snapshot.KmsKeyId)
 	d.Set("volume_size", snapshot.VolumeSize)
 
-	if err := d.Set("tags", tagsToMap(snapshot.Tags)); err != nil {
+	if err := setTagsAll(d, meta, tagsToMap(snapshot.Tags)); err != nil {
 		log.Printf("[WARN] error saving tags to state: %s", err)
 	}
 
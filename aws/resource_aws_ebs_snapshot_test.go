@@ -23,6 +23,12 @@ func TestAccAWSEBSSnapshot_basic(t *testing.T) {
 					testAccCheckTags(&v.Tags, "Name", "testAccAwsEbsSnapshotConfig"),
 				),
 			},
+
+			{
+				ResourceName:      "aws_ebs_snapshot.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
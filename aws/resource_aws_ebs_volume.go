@@ -17,10 +17,11 @@ import (
 
 func resourceAwsEbsVolume() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsEbsVolumeCreate,
-		Read:   resourceAwsEbsVolumeRead,
-		Update: resourceAWSEbsVolumeUpdate,
-		Delete: resourceAwsEbsVolumeDelete,
+		Create:        resourceAwsEbsVolumeCreate,
+		Read:          resourceAwsEbsVolumeRead,
+		Update:        resourceAWSEbsVolumeUpdate,
+		Delete:        resourceAwsEbsVolumeDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -69,7 +70,8 @@ func resourceAwsEbsVolume() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -139,7 +141,7 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(*result.VolumeId)
 
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(conn, d); err != nil {
 			return errwrap.Wrapf("Error setting tags for EBS Volume: {{err}}", err)
 		}
@@ -150,7 +152,7 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceAWSEbsVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(conn, d); err != nil {
 			return errwrap.Wrapf("Error updating tags for EBS Volume: {{err}}", err)
 		}
@@ -311,7 +313,7 @@ func readVolume(d *schema.ResourceData, client *AWSClient, volume *ec2.Volume) e
 		}
 	}
 
-	d.Set("tags", tagsToMap(volume.Tags))
+	setTagsAll(d, client, tagsToMap(volume.Tags))
 
 	return nil
 }
@@ -0,0 +1,715 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2Fleet() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceAwsEc2FleetCreate,
+		Read:          resourceAwsEc2FleetRead,
+		Update:        resourceAwsEc2FleetUpdate,
+		Delete:        resourceAwsEc2FleetDelete,
+		CustomizeDiff: setTagsAllDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"launch_template_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"launch_template_specification": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"override": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_zone": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"instance_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"max_price": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"priority": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+										ForceNew: true,
+									},
+									"subnet_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"weighted_capacity": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"target_capacity_specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_target_capacity_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.DefaultTargetCapacityTypeOnDemand,
+								ec2.DefaultTargetCapacityTypeSpot,
+							}, false),
+						},
+						"total_target_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"on_demand_target_capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"spot_target_capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"on_demand_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allocation_strategy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  ec2.FleetOnDemandAllocationStrategyLowestPrice,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.FleetOnDemandAllocationStrategyLowestPrice,
+								ec2.FleetOnDemandAllocationStrategyPrioritized,
+							}, false),
+						},
+						"max_total_price": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"spot_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allocation_strategy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  ec2.SpotAllocationStrategyLowestPrice,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.SpotAllocationStrategyLowestPrice,
+								ec2.SpotAllocationStrategyDiversified,
+								ec2.SpotAllocationStrategyCapacityOptimized,
+							}, false),
+						},
+						"instance_interruption_behavior": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  ec2.SpotInstanceInterruptionBehaviorTerminate,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.SpotInstanceInterruptionBehaviorHibernate,
+								ec2.SpotInstanceInterruptionBehaviorStop,
+								ec2.SpotInstanceInterruptionBehaviorTerminate,
+							}, false),
+						},
+						// Only used with the lowest-price allocation strategy
+						"instance_pools_to_use_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_total_price": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"excess_capacity_termination_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.FleetExcessCapacityTerminationPolicyTermination,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.FleetExcessCapacityTerminationPolicyNoTermination,
+					ec2.FleetExcessCapacityTerminationPolicyTermination,
+				}, false),
+			},
+
+			"replace_unhealthy_instances": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"terminate_instances": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"terminate_instances_with_expiration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ec2.FleetTypeMaintain,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.FleetTypeMaintain,
+					ec2.FleetTypeRequest,
+				}, false),
+			},
+
+			"wait_for_fulfillment": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"fulfilled_capacity": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"fulfilled_on_demand_capacity": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
+		},
+	}
+}
+
+func resourceAwsEc2FleetCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.CreateFleetInput{
+		ClientToken:                      aws.String(resource.UniqueId()),
+		ExcessCapacityTerminationPolicy:  aws.String(d.Get("excess_capacity_termination_policy").(string)),
+		LaunchTemplateConfigs:            expandEc2FleetLaunchTemplateConfigs(d.Get("launch_template_config").([]interface{})),
+		ReplaceUnhealthyInstances:        aws.Bool(d.Get("replace_unhealthy_instances").(bool)),
+		TargetCapacitySpecification:      expandEc2FleetTargetCapacitySpecification(d.Get("target_capacity_specification").([]interface{})),
+		TerminateInstancesWithExpiration: aws.Bool(d.Get("terminate_instances_with_expiration").(bool)),
+		Type:                             aws.String(d.Get("type").(string)),
+	}
+
+	if v, ok := d.GetOk("on_demand_options"); ok {
+		req.OnDemandOptions = expandEc2FleetOnDemandOptions(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("spot_options"); ok {
+		req.SpotOptions = expandEc2FleetSpotOptions(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Fleet: %s", req)
+	resp, err := conn.CreateFleet(req)
+	if err != nil {
+		return fmt.Errorf("Error creating EC2 Fleet: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.FleetId))
+
+	log.Printf("[INFO] Waiting for EC2 Fleet (%s) to be active", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.FleetStateCodeSubmitted},
+		Target:     []string{ec2.FleetStateCodeActive},
+		Refresh:    ec2FleetStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EC2 Fleet (%s) to be active: %s", d.Id(), err)
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return err
+	}
+
+	if d.Get("wait_for_fulfillment").(bool) {
+		log.Printf("[INFO] Waiting for EC2 Fleet (%s) to be fulfilled", d.Id())
+		if err := waitForFleetFulfillment(ec2FleetActivityRefreshFunc(conn, d.Id()), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("Error waiting for EC2 Fleet (%s) to be fulfilled: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsEc2FleetRead(d, meta)
+}
+
+func resourceAwsEc2FleetRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	fleetRaw, state, err := ec2FleetStateRefreshFunc(conn, d.Id())()
+	if err != nil {
+		return fmt.Errorf("Error reading EC2 Fleet (%s): %s", d.Id(), err)
+	}
+
+	if fleetRaw == nil || ec2FleetDeleted(state) {
+		log.Printf("[WARN] EC2 Fleet (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	fleet := fleetRaw.(*ec2.FleetData)
+
+	d.Set("excess_capacity_termination_policy", fleet.ExcessCapacityTerminationPolicy)
+	d.Set("replace_unhealthy_instances", fleet.ReplaceUnhealthyInstances)
+	d.Set("terminate_instances_with_expiration", fleet.TerminateInstancesWithExpiration)
+	d.Set("type", fleet.Type)
+	d.Set("fulfilled_capacity", fleet.FulfilledCapacity)
+	d.Set("fulfilled_on_demand_capacity", fleet.FulfilledOnDemandCapacity)
+
+	if err := d.Set("launch_template_config", flattenEc2FleetLaunchTemplateConfigs(fleet.LaunchTemplateConfigs)); err != nil {
+		return fmt.Errorf("Error setting launch_template_config: %s", err)
+	}
+
+	if err := d.Set("target_capacity_specification", flattenEc2FleetTargetCapacitySpecification(fleet.TargetCapacitySpecification)); err != nil {
+		return fmt.Errorf("Error setting target_capacity_specification: %s", err)
+	}
+
+	if err := d.Set("on_demand_options", flattenEc2FleetOnDemandOptions(fleet.OnDemandOptions)); err != nil {
+		return fmt.Errorf("Error setting on_demand_options: %s", err)
+	}
+
+	if err := d.Set("spot_options", flattenEc2FleetSpotOptions(fleet.SpotOptions)); err != nil {
+		return fmt.Errorf("Error setting spot_options: %s", err)
+	}
+
+	setTagsAll(d, meta, tagsToMap(fleet.Tags))
+
+	return nil
+}
+
+func resourceAwsEc2FleetUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("target_capacity_specification") || d.HasChange("excess_capacity_termination_policy") {
+		req := &ec2.ModifyFleetInput{
+			ExcessCapacityTerminationPolicy: aws.String(d.Get("excess_capacity_termination_policy").(string)),
+			FleetId:                         aws.String(d.Id()),
+			TargetCapacitySpecification:     expandEc2FleetTargetCapacitySpecification(d.Get("target_capacity_specification").([]interface{})),
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Fleet: %s", req)
+		if _, err := conn.ModifyFleet(req); err != nil {
+			return fmt.Errorf("Error modifying EC2 Fleet (%s): %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{ec2.FleetStateCodeModifying},
+			Target:     []string{ec2.FleetStateCodeActive},
+			Refresh:    ec2FleetStateRefreshFunc(conn, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			MinTimeout: 5 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for EC2 Fleet (%s) to be modified: %s", d.Id(), err)
+		}
+
+		if d.HasChange("target_capacity_specification") && d.Get("wait_for_fulfillment").(bool) {
+			log.Printf("[INFO] Waiting for EC2 Fleet (%s) to be fulfilled", d.Id())
+			if err := waitForFleetFulfillment(ec2FleetActivityRefreshFunc(conn, d.Id()), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("Error waiting for EC2 Fleet (%s) to be fulfilled: %s", d.Id(), err)
+			}
+		}
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsEc2FleetRead(d, meta)
+}
+
+func resourceAwsEc2FleetDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	terminateInstances := d.Get("terminate_instances").(bool)
+
+	log.Printf("[INFO] Deleting EC2 Fleet: %s", d.Id())
+	resp, err := conn.DeleteFleets(&ec2.DeleteFleetsInput{
+		FleetIds:           []*string{aws.String(d.Id())},
+		TerminateInstances: aws.Bool(terminateInstances),
+	})
+	if isAWSErr(err, "InvalidFleetId.NotFound", "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error deleting EC2 Fleet (%s): %s", d.Id(), err)
+	}
+
+	for _, item := range resp.UnsuccessfulFleetDeletions {
+		if aws.StringValue(item.FleetId) == d.Id() && item.Error != nil {
+			return fmt.Errorf("Error deleting EC2 Fleet (%s): %s: %s", d.Id(), aws.StringValue(item.Error.Code), aws.StringValue(item.Error.Message))
+		}
+	}
+
+	// Without instance termination the fleet settles in deleted_running
+	target := []string{ec2.FleetStateCodeDeleted, ec2.FleetStateCodeDeletedRunning}
+	pending := []string{ec2.FleetStateCodeActive, ec2.FleetStateCodeModifying}
+	if terminateInstances {
+		target = []string{ec2.FleetStateCodeDeleted}
+		pending = append(pending, ec2.FleetStateCodeDeletedTerminating)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    ec2FleetStateRefreshFunc(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EC2 Fleet (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// ec2FleetStateRefreshFunc returns the fleet state of an EC2 Fleet. A fleet
+// which can no longer be described is reported as deleted.
+func ec2FleetStateRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		fleet, err := ec2FleetDescribe(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if fleet == nil {
+			return &ec2.FleetData{}, ec2.FleetStateCodeDeleted, nil
+		}
+
+		return fleet, aws.StringValue(fleet.FleetState), nil
+	}
+}
+
+// ec2FleetActivityRefreshFunc returns the activity status of an EC2 Fleet,
+// for use with waitForFleetFulfillment.
+func ec2FleetActivityRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		fleet, err := ec2FleetDescribe(conn, id)
+		if err != nil || fleet == nil {
+			return nil, "", err
+		}
+
+		status := aws.StringValue(fleet.ActivityStatus)
+		if status == ec2.FleetActivityStatusError && len(fleet.Errors) > 0 {
+			return fleet, status, fmt.Errorf("%s: %s", aws.StringValue(fleet.Errors[0].ErrorCode), aws.StringValue(fleet.Errors[0].ErrorMessage))
+		}
+
+		return fleet, status, nil
+	}
+}
+
+func ec2FleetDescribe(conn *ec2.EC2, id string) (*ec2.FleetData, error) {
+	resp, err := conn.DescribeFleets(&ec2.DescribeFleetsInput{
+		FleetIds: []*string{aws.String(id)},
+	})
+	if isAWSErr(err, "InvalidFleetId.NotFound", "") {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fleet := range resp.Fleets {
+		if aws.StringValue(fleet.FleetId) == id {
+			return fleet, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ec2FleetDeleted(state string) bool {
+	switch state {
+	case ec2.FleetStateCodeDeleted, ec2.FleetStateCodeDeletedRunning, ec2.FleetStateCodeDeletedTerminating:
+		return true
+	}
+	return false
+}
+
+func expandEc2FleetLaunchTemplateConfigs(l []interface{}) []*ec2.FleetLaunchTemplateConfigRequest {
+	var configs []*ec2.FleetLaunchTemplateConfigRequest
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+
+		spec := &ec2.FleetLaunchTemplateSpecificationRequest{}
+		if specs := m["launch_template_specification"].([]interface{}); len(specs) > 0 && specs[0] != nil {
+			sm := specs[0].(map[string]interface{})
+			if v := sm["id"].(string); v != "" {
+				spec.LaunchTemplateId = aws.String(v)
+			}
+			if v := sm["name"].(string); v != "" {
+				spec.LaunchTemplateName = aws.String(v)
+			}
+			if v := sm["version"].(string); v != "" {
+				spec.Version = aws.String(v)
+			}
+		}
+
+		config := &ec2.FleetLaunchTemplateConfigRequest{
+			LaunchTemplateSpecification: spec,
+		}
+		for _, raw := range m["override"].([]interface{}) {
+			om := raw.(map[string]interface{})
+			override := &ec2.FleetLaunchTemplateOverridesRequest{}
+			if v := om["availability_zone"].(string); v != "" {
+				override.AvailabilityZone = aws.String(v)
+			}
+			if v := om["instance_type"].(string); v != "" {
+				override.InstanceType = aws.String(v)
+			}
+			if v := om["max_price"].(string); v != "" {
+				override.MaxPrice = aws.String(v)
+			}
+			if v := om["priority"].(float64); v > 0 {
+				override.Priority = aws.Float64(v)
+			}
+			if v := om["subnet_id"].(string); v != "" {
+				override.SubnetId = aws.String(v)
+			}
+			if v := om["weighted_capacity"].(float64); v > 0 {
+				override.WeightedCapacity = aws.Float64(v)
+			}
+			config.Overrides = append(config.Overrides, override)
+		}
+		configs = append(configs, config)
+	}
+	return configs
+}
+
+func flattenEc2FleetLaunchTemplateConfigs(configs []*ec2.FleetLaunchTemplateConfig) []interface{} {
+	var result []interface{}
+	for _, config := range configs {
+		m := map[string]interface{}{}
+		if spec := config.LaunchTemplateSpecification; spec != nil {
+			m["launch_template_specification"] = []interface{}{
+				map[string]interface{}{
+					"id":      aws.StringValue(spec.LaunchTemplateId),
+					"name":    aws.StringValue(spec.LaunchTemplateName),
+					"version": aws.StringValue(spec.Version),
+				},
+			}
+		}
+		var overrides []interface{}
+		for _, override := range config.Overrides {
+			overrides = append(overrides, map[string]interface{}{
+				"availability_zone": aws.StringValue(override.AvailabilityZone),
+				"instance_type":     aws.StringValue(override.InstanceType),
+				"max_price":         aws.StringValue(override.MaxPrice),
+				"priority":          aws.Float64Value(override.Priority),
+				"subnet_id":         aws.StringValue(override.SubnetId),
+				"weighted_capacity": aws.Float64Value(override.WeightedCapacity),
+			})
+		}
+		m["override"] = overrides
+		result = append(result, m)
+	}
+	return result
+}
+
+func expandEc2FleetTargetCapacitySpecification(l []interface{}) *ec2.TargetCapacitySpecificationRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	spec := &ec2.TargetCapacitySpecificationRequest{
+		DefaultTargetCapacityType: aws.String(m["default_target_capacity_type"].(string)),
+		TotalTargetCapacity:       aws.Int64(int64(m["total_target_capacity"].(int))),
+	}
+	if v := m["on_demand_target_capacity"].(int); v > 0 {
+		spec.OnDemandTargetCapacity = aws.Int64(int64(v))
+	}
+	if v := m["spot_target_capacity"].(int); v > 0 {
+		spec.SpotTargetCapacity = aws.Int64(int64(v))
+	}
+
+	return spec
+}
+
+func flattenEc2FleetTargetCapacitySpecification(spec *ec2.TargetCapacitySpecification) []interface{} {
+	if spec == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"default_target_capacity_type": aws.StringValue(spec.DefaultTargetCapacityType),
+			"total_target_capacity":        int(aws.Int64Value(spec.TotalTargetCapacity)),
+			"on_demand_target_capacity":    int(aws.Int64Value(spec.OnDemandTargetCapacity)),
+			"spot_target_capacity":         int(aws.Int64Value(spec.SpotTargetCapacity)),
+		},
+	}
+}
+
+func expandEc2FleetOnDemandOptions(l []interface{}) *ec2.OnDemandOptionsRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	options := &ec2.OnDemandOptionsRequest{
+		AllocationStrategy: aws.String(m["allocation_strategy"].(string)),
+	}
+	if v := m["max_total_price"].(string); v != "" {
+		options.MaxTotalPrice = aws.String(v)
+	}
+
+	return options
+}
+
+func flattenEc2FleetOnDemandOptions(options *ec2.OnDemandOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"allocation_strategy": aws.StringValue(options.AllocationStrategy),
+			"max_total_price":     aws.StringValue(options.MaxTotalPrice),
+		},
+	}
+}
+
+func expandEc2FleetSpotOptions(l []interface{}) *ec2.SpotOptionsRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	options := &ec2.SpotOptionsRequest{
+		AllocationStrategy:           aws.String(m["allocation_strategy"].(string)),
+		InstanceInterruptionBehavior: aws.String(m["instance_interruption_behavior"].(string)),
+	}
+	if aws.StringValue(options.AllocationStrategy) == ec2.SpotAllocationStrategyLowestPrice {
+		options.InstancePoolsToUseCount = aws.Int64(int64(m["instance_pools_to_use_count"].(int)))
+	}
+	if v := m["max_total_price"].(string); v != "" {
+		options.MaxTotalPrice = aws.String(v)
+	}
+
+	return options
+}
+
+func flattenEc2FleetSpotOptions(options *ec2.SpotOptions) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"allocation_strategy":            aws.StringValue(options.AllocationStrategy),
+		"instance_interruption_behavior": aws.StringValue(options.InstanceInterruptionBehavior),
+		"instance_pools_to_use_count":    1,
+		"max_total_price":                aws.StringValue(options.MaxTotalPrice),
+	}
+	if options.InstancePoolsToUseCount != nil {
+		m["instance_pools_to_use_count"] = int(aws.Int64Value(options.InstancePoolsToUseCount))
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandEc2FleetSpotOptions(t *testing.T) {
+	cases := []struct {
+		AllocationStrategy string
+		ExpectedPoolCount  *int64
+	}{
+		{
+			AllocationStrategy: ec2.SpotAllocationStrategyLowestPrice,
+			ExpectedPoolCount:  aws.Int64(2),
+		},
+		{
+			AllocationStrategy: ec2.SpotAllocationStrategyCapacityOptimized,
+			ExpectedPoolCount:  nil,
+		},
+	}
+
+	for _, tc := range cases {
+		options := expandEc2FleetSpotOptions([]interface{}{
+			map[string]interface{}{
+				"allocation_strategy":            tc.AllocationStrategy,
+				"instance_interruption_behavior": ec2.SpotInstanceInterruptionBehaviorTerminate,
+				"instance_pools_to_use_count":    2,
+				"max_total_price":                "",
+			},
+		})
+
+		if aws.StringValue(options.AllocationStrategy) != tc.AllocationStrategy {
+			t.Fatalf("Expected allocation strategy %s, got %s", tc.AllocationStrategy, aws.StringValue(options.AllocationStrategy))
+		}
+		if (tc.ExpectedPoolCount == nil) != (options.InstancePoolsToUseCount == nil) ||
+			aws.Int64Value(options.InstancePoolsToUseCount) != aws.Int64Value(tc.ExpectedPoolCount) {
+			t.Fatalf("Expected %s instance pool count %v, got %v", tc.AllocationStrategy, tc.ExpectedPoolCount, options.InstancePoolsToUseCount)
+		}
+		if options.MaxTotalPrice != nil {
+			t.Fatalf("Expected no max total price, got %s", aws.StringValue(options.MaxTotalPrice))
+		}
+	}
+}
+
+func TestAccAWSEc2Fleet_basic(t *testing.T) {
+	var before, after ec2.FleetData
+	resourceName := "aws_ec2_fleet.test"
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEc2FleetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEc2FleetConfig(rName, 2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2FleetExists(resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "type", "maintain"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template_config.0.override.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.total_target_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.on_demand_target_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spot_options.0.allocation_strategy", "capacity-optimized"),
+					resource.TestCheckResourceAttr(resourceName, "on_demand_options.0.allocation_strategy", "lowest-price"),
+					resource.TestCheckResourceAttr(resourceName, "fulfilled_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				Config: testAccAWSEc2FleetConfig(rName, 3, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEc2FleetExists(resourceName, &after),
+					testAccCheckAWSEc2FleetNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.total_target_capacity", "3"),
+					resource.TestCheckResourceAttr(resourceName, "target_capacity_specification.0.on_demand_target_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "fulfilled_capacity", "3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"terminate_instances", "wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEc2FleetDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_fleet" {
+			continue
+		}
+
+		_, state, err := ec2FleetStateRefreshFunc(conn, rs.Primary.ID)()
+		if err != nil {
+			return err
+		}
+		if state != ec2.FleetStateCodeDeleted {
+			return fmt.Errorf("EC2 Fleet %s still exists in state %s", rs.Primary.ID, state)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEc2FleetExists(n string, fleet *ec2.FleetData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Fleet ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		resp, err := ec2FleetDescribe(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp == nil || ec2FleetDeleted(aws.StringValue(resp.FleetState)) {
+			return fmt.Errorf("EC2 Fleet %s not found", rs.Primary.ID)
+		}
+
+		*fleet = *resp
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEc2FleetNotRecreated(before, after *ec2.FleetData) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(before.FleetId) != aws.StringValue(after.FleetId) {
+			return fmt.Errorf("EC2 Fleet %s was recreated as %s", aws.StringValue(before.FleetId), aws.StringValue(after.FleetId))
+		}
+		return nil
+	}
+}
+
+func testAccAWSEc2FleetConfig(rName string, totalTargetCapacity, onDemandTargetCapacity int) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+data "aws_ami" "amzn-ami-minimal-hvm-ebs" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-minimal-hvm-*"]
+  }
+
+  filter {
+    name   = "root-device-type"
+    values = ["ebs"]
+  }
+}
+
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = "${data.aws_ami.amzn-ami-minimal-hvm-ebs.id}"
+  instance_type = "t3.micro"
+}
+
+resource "aws_ec2_fleet" "test" {
+  terminate_instances  = true
+  wait_for_fulfillment = true
+
+  launch_template_config {
+    launch_template_specification {
+      id      = "${aws_launch_template.test.id}"
+      version = "${aws_launch_template.test.latest_version}"
+    }
+
+    override {
+      availability_zone = "${data.aws_availability_zones.available.names[0]}"
+    }
+
+    override {
+      availability_zone = "${data.aws_availability_zones.available.names[1]}"
+    }
+  }
+
+  target_capacity_specification {
+    default_target_capacity_type = "spot"
+    total_target_capacity        = %[2]d
+    on_demand_target_capacity    = %[3]d
+  }
+
+  spot_options {
+    allocation_strategy = "capacity-optimized"
+  }
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName, totalTargetCapacity, onDemandTargetCapacity)
+}
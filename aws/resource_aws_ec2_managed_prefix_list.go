@@ -0,0 +1,381 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEc2ManagedPrefixList() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceAwsEc2ManagedPrefixListCreate,
+		Read:          resourceAwsEc2ManagedPrefixListRead,
+		Update:        resourceAwsEc2ManagedPrefixListUpdate,
+		Delete:        resourceAwsEc2ManagedPrefixListDelete,
+		CustomizeDiff: setTagsAllDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+
+			"address_family": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"IPv4",
+					"IPv6",
+				}, false),
+			},
+
+			"max_entries": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"entry": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCIDRNetworkAddress,
+						},
+
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 255),
+						},
+					},
+				},
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
+		},
+	}
+}
+
+func resourceAwsEc2ManagedPrefixListCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.CreateManagedPrefixListInput{
+		AddressFamily:  aws.String(d.Get("address_family").(string)),
+		ClientToken:    aws.String(resource.UniqueId()),
+		Entries:        expandEc2AddPrefixListEntries(d.Get("entry").(*schema.Set).List()),
+		MaxEntries:     aws.Int64(int64(d.Get("max_entries").(int))),
+		PrefixListName: aws.String(d.Get("name").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Managed Prefix List: %s", req)
+	resp, err := conn.CreateManagedPrefixList(req)
+	if err != nil {
+		return fmt.Errorf("Error creating EC2 Managed Prefix List: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.PrefixList.PrefixListId))
+
+	if _, err := ec2ManagedPrefixListWaitUntilComplete(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsEc2ManagedPrefixListRead(d, meta)
+}
+
+func resourceAwsEc2ManagedPrefixListRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	plRaw, state, err := ec2ManagedPrefixListStateRefresh(conn, d.Id())()
+	if err != nil {
+		return fmt.Errorf("Error reading EC2 Managed Prefix List (%s): %s", d.Id(), err)
+	}
+
+	if plRaw == nil || state == ec2.PrefixListStateDeleteComplete {
+		log.Printf("[WARN] EC2 Managed Prefix List (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	pl := plRaw.(*ec2.ManagedPrefixList)
+
+	var entries []*ec2.PrefixListEntry
+	err = conn.GetManagedPrefixListEntriesPages(&ec2.GetManagedPrefixListEntriesInput{
+		PrefixListId:  pl.PrefixListId,
+		TargetVersion: pl.Version,
+	}, func(page *ec2.GetManagedPrefixListEntriesOutput, lastPage bool) bool {
+		entries = append(entries, page.Entries...)
+		return !lastPage
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading EC2 Managed Prefix List (%s) entries: %s", d.Id(), err)
+	}
+
+	d.Set("name", pl.PrefixListName)
+	d.Set("address_family", pl.AddressFamily)
+	d.Set("max_entries", pl.MaxEntries)
+	d.Set("arn", pl.PrefixListArn)
+	d.Set("owner_id", pl.OwnerId)
+	d.Set("version", pl.Version)
+
+	if err := d.Set("entry", flattenEc2PrefixListEntries(entries)); err != nil {
+		return fmt.Errorf("Error setting entry: %s", err)
+	}
+
+	setTagsAll(d, meta, tagsToMap(pl.Tags))
+
+	return nil
+}
+
+func resourceAwsEc2ManagedPrefixListUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("name") || d.HasChange("entry") {
+		req := &ec2.ModifyManagedPrefixListInput{
+			PrefixListId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("name") {
+			req.PrefixListName = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("entry") {
+			o, n := d.GetChange("entry")
+			add, remove, redescribe := diffEc2PrefixListEntries(o.(*schema.Set).List(), n.(*schema.Set).List())
+			req.AddEntries = add
+			req.RemoveEntries = remove
+			// Modifications are rejected unless made against the version
+			// which was last read, so that concurrent changes aren't lost.
+			req.CurrentVersion = aws.Int64(int64(d.Get("version").(int)))
+
+			// An entry can't be added while it is in the list, so the
+			// entries whose description changed are removed first and
+			// added back along with the other changes.
+			if len(redescribe) > 0 {
+				removeReq := &ec2.ModifyManagedPrefixListInput{
+					PrefixListId:   aws.String(d.Id()),
+					CurrentVersion: req.CurrentVersion,
+					RemoveEntries:  redescribe,
+				}
+
+				log.Printf("[DEBUG] Modifying EC2 Managed Prefix List: %s", removeReq)
+				if _, err := conn.ModifyManagedPrefixList(removeReq); err != nil {
+					return fmt.Errorf("Error modifying EC2 Managed Prefix List (%s): %s", d.Id(), err)
+				}
+
+				pl, err := ec2ManagedPrefixListWaitUntilComplete(conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+				if err != nil {
+					return err
+				}
+				req.CurrentVersion = pl.Version
+			}
+		}
+
+		log.Printf("[DEBUG] Modifying EC2 Managed Prefix List: %s", req)
+		if _, err := conn.ModifyManagedPrefixList(req); err != nil {
+			return fmt.Errorf("Error modifying EC2 Managed Prefix List (%s): %s", d.Id(), err)
+		}
+
+		if _, err := ec2ManagedPrefixListWaitUntilComplete(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if err := setTags(conn, d); err != nil {
+		return err
+	}
+
+	return resourceAwsEc2ManagedPrefixListRead(d, meta)
+}
+
+func resourceAwsEc2ManagedPrefixListDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting EC2 Managed Prefix List: %s", d.Id())
+	_, err := conn.DeleteManagedPrefixList(&ec2.DeleteManagedPrefixListInput{
+		PrefixListId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidPrefixListID.NotFound", "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting EC2 Managed Prefix List (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.PrefixListStateDeleteInProgress},
+		Target:  []string{ec2.PrefixListStateDeleteComplete},
+		Refresh: ec2ManagedPrefixListStateRefresh(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Delay:   5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EC2 Managed Prefix List (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// ec2ManagedPrefixListStateRefresh watches a managed prefix list. Lists
+// which no longer exist are reported as deleted, and failed operations
+// are returned as errors carrying the reason given by the API.
+func ec2ManagedPrefixListStateRefresh(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeManagedPrefixLists(&ec2.DescribeManagedPrefixListsInput{
+			PrefixListIds: []*string{aws.String(id)},
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidPrefixListID.NotFound", "") {
+				return "", ec2.PrefixListStateDeleteComplete, nil
+			}
+			return nil, "", err
+		}
+
+		if len(resp.PrefixLists) == 0 || resp.PrefixLists[0] == nil {
+			return "", ec2.PrefixListStateDeleteComplete, nil
+		}
+
+		pl := resp.PrefixLists[0]
+		state := aws.StringValue(pl.State)
+		if strings.HasSuffix(state, "-failed") {
+			return pl, state, fmt.Errorf("%s: %s", state, aws.StringValue(pl.StateMessage))
+		}
+
+		return pl, state, nil
+	}
+}
+
+// ec2ManagedPrefixListWaitUntilComplete waits for the operation in progress
+// on a managed prefix list to complete and returns the list.
+func ec2ManagedPrefixListWaitUntilComplete(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.ManagedPrefixList, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			ec2.PrefixListStateCreateInProgress,
+			ec2.PrefixListStateModifyInProgress,
+			ec2.PrefixListStateRestoreInProgress,
+		},
+		Target: []string{
+			ec2.PrefixListStateCreateComplete,
+			ec2.PrefixListStateModifyComplete,
+			ec2.PrefixListStateRestoreComplete,
+		},
+		Refresh: ec2ManagedPrefixListStateRefresh(conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+	plRaw, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for EC2 Managed Prefix List (%s) to become available: %s", id, err)
+	}
+
+	return plRaw.(*ec2.ManagedPrefixList), nil
+}
+
+func expandEc2AddPrefixListEntries(l []interface{}) []*ec2.AddPrefixListEntry {
+	entries := make([]*ec2.AddPrefixListEntry, 0, len(l))
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		entry := &ec2.AddPrefixListEntry{
+			Cidr: aws.String(m["cidr"].(string)),
+		}
+		if v, ok := m["description"].(string); ok && v != "" {
+			entry.Description = aws.String(v)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func flattenEc2PrefixListEntries(entries []*ec2.PrefixListEntry) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, map[string]interface{}{
+			"cidr":        aws.StringValue(entry.Cidr),
+			"description": aws.StringValue(entry.Description),
+		})
+	}
+	return result
+}
+
+// diffEc2PrefixListEntries returns the entries to add and remove to go from
+// the old entries to the new ones. Entries whose description changed are to
+// be added with the new description, and are also returned in redescribe as
+// they need to be removed beforehand.
+func diffEc2PrefixListEntries(o, n []interface{}) (add []*ec2.AddPrefixListEntry, remove, redescribe []*ec2.RemovePrefixListEntry) {
+	old := make(map[string]string, len(o))
+	for _, raw := range o {
+		m := raw.(map[string]interface{})
+		old[m["cidr"].(string)] = m["description"].(string)
+	}
+
+	var added []interface{}
+	wanted := make(map[string]bool, len(n))
+	for _, raw := range n {
+		m := raw.(map[string]interface{})
+		cidr := m["cidr"].(string)
+		wanted[cidr] = true
+
+		description, ok := old[cidr]
+		if !ok || description != m["description"].(string) {
+			added = append(added, m)
+		}
+		if ok && description != m["description"].(string) {
+			redescribe = append(redescribe, &ec2.RemovePrefixListEntry{Cidr: aws.String(cidr)})
+		}
+	}
+
+	for _, raw := range o {
+		cidr := raw.(map[string]interface{})["cidr"].(string)
+		if !wanted[cidr] {
+			remove = append(remove, &ec2.RemovePrefixListEntry{Cidr: aws.String(cidr)})
+		}
+	}
+
+	if len(added) > 0 {
+		add = expandEc2AddPrefixListEntries(added)
+	}
+
+	return add, remove, redescribe
+}
@@ -0,0 +1,222 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDiffEc2PrefixListEntries(t *testing.T) {
+	entry := func(cidr, description string) interface{} {
+		return map[string]interface{}{"cidr": cidr, "description": description}
+	}
+
+	o := []interface{}{
+		entry("10.0.0.0/16", "office"),
+		entry("10.1.0.0/16", "partner"),
+		entry("10.2.0.0/16", ""),
+	}
+	n := []interface{}{
+		entry("10.0.0.0/16", "office"),
+		entry("10.1.0.0/16", "partner a"),
+		entry("10.3.0.0/16", ""),
+	}
+
+	add, remove, redescribe := diffEc2PrefixListEntries(o, n)
+
+	var added, removed, redescribed []string
+	for _, e := range add {
+		added = append(added, fmt.Sprintf("%s=%s", aws.StringValue(e.Cidr), aws.StringValue(e.Description)))
+	}
+	for _, e := range remove {
+		removed = append(removed, aws.StringValue(e.Cidr))
+	}
+	for _, e := range redescribe {
+		redescribed = append(redescribed, aws.StringValue(e.Cidr))
+	}
+	sort.Strings(added)
+
+	if expected := []string{"10.1.0.0/16=partner a", "10.3.0.0/16="}; !reflect.DeepEqual(added, expected) {
+		t.Fatalf("Expected added entries %#v, got %#v", expected, added)
+	}
+	if expected := []string{"10.2.0.0/16"}; !reflect.DeepEqual(removed, expected) {
+		t.Fatalf("Expected removed entries %#v, got %#v", expected, removed)
+	}
+	if expected := []string{"10.1.0.0/16"}; !reflect.DeepEqual(redescribed, expected) {
+		t.Fatalf("Expected entries with a new description %#v, got %#v", expected, redescribed)
+	}
+}
+
+func TestDiffEc2PrefixListEntries_descriptionOnly(t *testing.T) {
+	o := []interface{}{
+		map[string]interface{}{"cidr": "10.0.0.0/16", "description": "office"},
+	}
+	n := []interface{}{
+		map[string]interface{}{"cidr": "10.0.0.0/16", "description": "head office"},
+	}
+
+	add, remove, redescribe := diffEc2PrefixListEntries(o, n)
+
+	expectedAdd := []*ec2.AddPrefixListEntry{{Cidr: aws.String("10.0.0.0/16"), Description: aws.String("head office")}}
+	if !reflect.DeepEqual(add, expectedAdd) {
+		t.Fatalf("Expected added entries %s, got %s", expectedAdd, add)
+	}
+	if len(remove) != 0 {
+		t.Fatalf("Expected no removed entries, got %s", remove)
+	}
+	expectedRedescribe := []*ec2.RemovePrefixListEntry{{Cidr: aws.String("10.0.0.0/16")}}
+	if !reflect.DeepEqual(redescribe, expectedRedescribe) {
+		t.Fatalf("Expected entries with a new description %s, got %s", expectedRedescribe, redescribe)
+	}
+}
+
+func TestAccAWSEc2ManagedPrefixList_basic(t *testing.T) {
+	var pl ec2.ManagedPrefixList
+	resourceName := "aws_ec2_managed_prefix_list.test"
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAwsEc2ManagedPrefixListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAwsEc2ManagedPrefixListConfig(rName, "partner"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ManagedPrefixListExists(resourceName, &pl),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "address_family", "IPv4"),
+					resource.TestCheckResourceAttr(resourceName, "max_entries", "5"),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner_id"),
+				),
+			},
+			{
+				Config: testAccAwsEc2ManagedPrefixListConfig(rName+"-updated", "partner a"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ManagedPrefixListExists(resourceName, &pl),
+					resource.TestCheckResourceAttr(resourceName, "name", rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "3"),
+				),
+			},
+			{
+				// Only a description changes
+				Config: testAccAwsEc2ManagedPrefixListConfig(rName+"-updated", "partner b"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAwsEc2ManagedPrefixListExists(resourceName, &pl),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version", "5"),
+					testAccCheckAwsEc2ManagedPrefixListEntry(&pl, "10.1.0.0/16", "partner b"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAwsEc2ManagedPrefixListDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ec2_managed_prefix_list" {
+			continue
+		}
+
+		_, state, err := ec2ManagedPrefixListStateRefresh(conn, rs.Primary.ID)()
+		if err != nil {
+			return err
+		}
+		if state != ec2.PrefixListStateDeleteComplete {
+			return fmt.Errorf("EC2 Managed Prefix List %s still exists in state %s", rs.Primary.ID, state)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAwsEc2ManagedPrefixListExists(n string, pl *ec2.ManagedPrefixList) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 Managed Prefix List ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		plRaw, state, err := ec2ManagedPrefixListStateRefresh(conn, rs.Primary.ID)()
+		if err != nil {
+			return err
+		}
+		if state == ec2.PrefixListStateDeleteComplete {
+			return fmt.Errorf("EC2 Managed Prefix List %s not found", rs.Primary.ID)
+		}
+
+		*pl = *plRaw.(*ec2.ManagedPrefixList)
+
+		return nil
+	}
+}
+
+func testAccCheckAwsEc2ManagedPrefixListEntry(pl *ec2.ManagedPrefixList, cidr, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		resp, err := conn.GetManagedPrefixListEntries(&ec2.GetManagedPrefixListEntriesInput{
+			PrefixListId: pl.PrefixListId,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range resp.Entries {
+			if aws.StringValue(entry.Cidr) == cidr {
+				if actual := aws.StringValue(entry.Description); actual != description {
+					return fmt.Errorf("Expected description %q for %s, got %q", description, cidr, actual)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("No entry found for %s", cidr)
+	}
+}
+
+func testAccAwsEc2ManagedPrefixListConfig(rName, partnerDescription string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = %[1]q
+  address_family = "IPv4"
+  max_entries    = 5
+
+  entry {
+    cidr        = "10.0.0.0/16"
+    description = "office"
+  }
+
+  entry {
+    cidr        = "10.1.0.0/16"
+    description = %[2]q
+  }
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName, partnerDescription)
+}
@@ -13,6 +13,10 @@ func resourceAwsEcrLifecyclePolicy() *schema.Resource {
 		Read:   resourceAwsEcrLifecyclePolicyRead,
 		Delete: resourceAwsEcrLifecyclePolicyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEcrLifecyclePolicyImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"repository": &schema.Schema{
 				Type:     schema.TypeString,
@@ -20,10 +24,11 @@ func resourceAwsEcrLifecyclePolicy() *schema.Resource {
 				ForceNew: true,
 			},
 			"policy": &schema.Schema{
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: validateJsonString,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateJsonString,
+				DiffSuppressFunc: suppressEquivalentJsonDiffs,
 			},
 			"registry_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -33,6 +38,11 @@ func resourceAwsEcrLifecyclePolicy() *schema.Resource {
 	}
 }
 
+func resourceAwsEcrLifecyclePolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("repository", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsEcrLifecyclePolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecrconn
 
@@ -58,7 +68,7 @@ func resourceAwsEcrLifecyclePolicyRead(d *schema.ResourceData, meta interface{})
 		RepositoryName: aws.String(d.Get("repository").(string)),
 	}
 
-	_, err := conn.GetLifecyclePolicy(input)
+	resp, err := conn.GetLifecyclePolicy(input)
 	if err != nil {
 		if aerr, ok := err.(awserr.Error); ok {
 			switch aerr.Code() {
@@ -72,6 +82,10 @@ func resourceAwsEcrLifecyclePolicyRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
+	d.Set("repository", resp.RepositoryName)
+	d.Set("registry_id", resp.RegistryId)
+	d.Set("policy", resp.LifecyclePolicyText)
+
 	return nil
 }
 
@@ -27,6 +27,12 @@ func TestAccAWSEcrLifecyclePolicy_basic(t *testing.T) {
 					testAccCheckAWSEcrLifecyclePolicyExists("aws_ecr_lifecycle_policy.foo"),
 				),
 			},
+
+			{
+				ResourceName:      "aws_ecr_lifecycle_policy.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
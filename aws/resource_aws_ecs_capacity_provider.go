@@ -0,0 +1,302 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEcsCapacityProvider() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEcsCapacityProviderCreate,
+		Read:   resourceAwsEcsCapacityProviderRead,
+		Update: resourceAwsEcsCapacityProviderUpdate,
+		Delete: resourceAwsEcsCapacityProviderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsAllDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"auto_scaling_group_provider": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_scaling_group_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateArn,
+						},
+						"managed_termination_protection": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ecs.ManagedTerminationProtectionEnabled,
+								ecs.ManagedTerminationProtectionDisabled,
+							}, false),
+						},
+						"managed_scaling": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"maximum_scaling_step_size": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 10000),
+									},
+									"minimum_scaling_step_size": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 10000),
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											ecs.ManagedScalingStatusEnabled,
+											ecs.ManagedScalingStatusDisabled,
+										}, false),
+									},
+									"target_capacity": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
+		},
+	}
+}
+
+func resourceAwsEcsCapacityProviderCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecsconn
+
+	input := &ecs.CreateCapacityProviderInput{
+		Name:                     aws.String(d.Get("name").(string)),
+		AutoScalingGroupProvider: expandEcsAutoScalingGroupProvider(d.Get("auto_scaling_group_provider").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = tagsFromMapECS(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating ECS capacity provider: %s", input)
+	out, err := conn.CreateCapacityProvider(input)
+	if err != nil {
+		return fmt.Errorf("Error creating ECS capacity provider: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.CapacityProvider.CapacityProviderArn))
+
+	return resourceAwsEcsCapacityProviderRead(d, meta)
+}
+
+func resourceAwsEcsCapacityProviderRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecsconn
+
+	provider, err := ecsCapacityProviderDescribe(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading ECS capacity provider (%s): %s", d.Id(), err)
+	}
+
+	if provider == nil || aws.StringValue(provider.Status) == ecs.CapacityProviderStatusInactive {
+		log.Printf("[WARN] ECS capacity provider (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// Imports may be given the name rather than the ARN
+	d.SetId(aws.StringValue(provider.CapacityProviderArn))
+	d.Set("arn", provider.CapacityProviderArn)
+	d.Set("name", provider.Name)
+
+	if err := d.Set("auto_scaling_group_provider", flattenEcsAutoScalingGroupProvider(provider.AutoScalingGroupProvider)); err != nil {
+		return fmt.Errorf("Error setting auto_scaling_group_provider: %s", err)
+	}
+
+	return setTagsAll(d, meta, tagsToMapECS(provider.Tags))
+}
+
+func resourceAwsEcsCapacityProviderUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecsconn
+
+	if err := setTagsECS(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("Error updating ECS capacity provider (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEcsCapacityProviderRead(d, meta)
+}
+
+func resourceAwsEcsCapacityProviderDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecsconn
+
+	log.Printf("[DEBUG] Deleting ECS capacity provider %s", d.Id())
+	_, err := conn.DeleteCapacityProvider(&ecs.DeleteCapacityProviderInput{
+		CapacityProvider: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting ECS capacity provider (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ecs.CapacityProviderUpdateStatusDeleteInProgress},
+		Target:     []string{ecs.CapacityProviderUpdateStatusDeleteComplete},
+		Refresh:    ecsCapacityProviderUpdateStatusRefreshFunc(conn, d.Id()),
+		Timeout:    20 * time.Minute,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for ECS capacity provider (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// ecsCapacityProviderDescribe returns the capacity provider with the given
+// name or ARN, or nil if it does not exist.
+func ecsCapacityProviderDescribe(conn *ecs.ECS, id string) (*ecs.CapacityProvider, error) {
+	out, err := conn.DescribeCapacityProviders(&ecs.DescribeCapacityProvidersInput{
+		CapacityProviders: []*string{aws.String(id)},
+		Include:           []*string{aws.String(ecs.CapacityProviderFieldTags)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, provider := range out.CapacityProviders {
+		if aws.StringValue(provider.CapacityProviderArn) == id || aws.StringValue(provider.Name) == id {
+			return provider, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func ecsCapacityProviderUpdateStatusRefreshFunc(conn *ecs.ECS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		provider, err := ecsCapacityProviderDescribe(conn, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Deleted capacity providers are only listed for a short while
+		if provider == nil {
+			return "", ecs.CapacityProviderUpdateStatusDeleteComplete, nil
+		}
+
+		if aws.StringValue(provider.UpdateStatus) == ecs.CapacityProviderUpdateStatusDeleteFailed {
+			return provider, ecs.CapacityProviderUpdateStatusDeleteFailed,
+				fmt.Errorf("%s", aws.StringValue(provider.UpdateStatusReason))
+		}
+
+		return provider, aws.StringValue(provider.UpdateStatus), nil
+	}
+}
+
+func expandEcsAutoScalingGroupProvider(configured []interface{}) *ecs.AutoScalingGroupProvider {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+
+	provider := &ecs.AutoScalingGroupProvider{
+		AutoScalingGroupArn: aws.String(data["auto_scaling_group_arn"].(string)),
+	}
+
+	if v, ok := data["managed_termination_protection"].(string); ok && v != "" {
+		provider.ManagedTerminationProtection = aws.String(v)
+	}
+
+	if v, ok := data["managed_scaling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		scaling := &ecs.ManagedScaling{}
+
+		if v, ok := m["maximum_scaling_step_size"].(int); ok && v != 0 {
+			scaling.MaximumScalingStepSize = aws.Int64(int64(v))
+		}
+		if v, ok := m["minimum_scaling_step_size"].(int); ok && v != 0 {
+			scaling.MinimumScalingStepSize = aws.Int64(int64(v))
+		}
+		if v, ok := m["status"].(string); ok && v != "" {
+			scaling.Status = aws.String(v)
+		}
+		if v, ok := m["target_capacity"].(int); ok && v != 0 {
+			scaling.TargetCapacity = aws.Int64(int64(v))
+		}
+
+		provider.ManagedScaling = scaling
+	}
+
+	return provider
+}
+
+func flattenEcsAutoScalingGroupProvider(provider *ecs.AutoScalingGroupProvider) []interface{} {
+	if provider == nil {
+		return nil
+	}
+
+	m := map[string]interface{}{
+		"auto_scaling_group_arn":         aws.StringValue(provider.AutoScalingGroupArn),
+		"managed_termination_protection": aws.StringValue(provider.ManagedTerminationProtection),
+	}
+
+	if scaling := provider.ManagedScaling; scaling != nil {
+		m["managed_scaling"] = []interface{}{
+			map[string]interface{}{
+				"maximum_scaling_step_size": int(aws.Int64Value(scaling.MaximumScalingStepSize)),
+				"minimum_scaling_step_size": int(aws.Int64Value(scaling.MinimumScalingStepSize)),
+				"status":                    aws.StringValue(scaling.Status),
+				"target_capacity":           int(aws.Int64Value(scaling.TargetCapacity)),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEcsCapacityProvider_basic(t *testing.T) {
+	var provider ecs.CapacityProvider
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+	resourceName := "aws_ecs_capacity_provider.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsCapacityProviderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsCapacityProviderConfig(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsCapacityProviderExists(resourceName, &provider),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "id", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "auto_scaling_group_provider.0.auto_scaling_group_arn", "aws_autoscaling_group.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_termination_protection", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_scaling.0.status", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_scaling.0.target_capacity", "90"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_scaling.0.minimum_scaling_step_size", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_scaling_group_provider.0.managed_scaling.0.maximum_scaling_step_size", "5"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key", "value1"),
+				),
+			},
+			{
+				Config: testAccAWSEcsCapacityProviderConfig(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsCapacityProviderExists(resourceName, &provider),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key", "value2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEcsCapacityProviderDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ecsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ecs_capacity_provider" {
+			continue
+		}
+
+		provider, err := ecsCapacityProviderDescribe(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if provider != nil && aws.StringValue(provider.Status) != ecs.CapacityProviderStatusInactive {
+			return fmt.Errorf("ECS capacity provider %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSEcsCapacityProviderExists(n string, provider *ecs.CapacityProvider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ECS capacity provider ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		out, err := ecsCapacityProviderDescribe(conn, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if out == nil {
+			return fmt.Errorf("ECS capacity provider %s not found", rs.Primary.ID)
+		}
+
+		*provider = *out
+
+		return nil
+	}
+}
+
+func testAccAWSEcsCapacityProviderConfig(rName, tagValue string) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+data "aws_ami" "test" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-hvm-*-x86_64-gp2"]
+  }
+}
+
+resource "aws_launch_template" "test" {
+  image_id      = "${data.aws_ami.test.id}"
+  instance_type = "t3.micro"
+  name          = %[1]q
+}
+
+resource "aws_autoscaling_group" "test" {
+  availability_zones    = ["${data.aws_availability_zones.available.names[0]}"]
+  desired_capacity      = 0
+  max_size              = 0
+  min_size              = 0
+  name                  = %[1]q
+  protect_from_scale_in = true
+
+  launch_template {
+    id = "${aws_launch_template.test.id}"
+  }
+
+  tag {
+    key                 = "AmazonECSManaged"
+    value               = ""
+    propagate_at_launch = true
+  }
+}
+
+resource "aws_ecs_capacity_provider" "test" {
+  name = %[1]q
+
+  auto_scaling_group_provider {
+    auto_scaling_group_arn         = "${aws_autoscaling_group.test.arn}"
+    managed_termination_protection = "ENABLED"
+
+    managed_scaling {
+      maximum_scaling_step_size = 5
+      minimum_scaling_step_size = 1
+      status                    = "ENABLED"
+      target_capacity           = 90
+    }
+  }
+
+  tags {
+    Key = %[2]q
+  }
+}
+`, rName, tagValue)
+}
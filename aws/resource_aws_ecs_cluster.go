@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -10,33 +11,130 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsEcsCluster() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsEcsClusterCreate,
 		Read:   resourceAwsEcsClusterRead,
+		Update: resourceAwsEcsClusterUpdate,
 		Delete: resourceAwsEcsClusterDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEcsClusterImport,
+		},
+
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+
+			"arn": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"setting": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ecs.ClusterSettingNameContainerInsights,
+							}, false),
+						},
+						"value": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"enabled",
+								"disabled",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"capacity_providers": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"default_capacity_provider_strategy": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"capacity_provider": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weight": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+						"base": &schema.Schema{
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100000),
+						},
+					},
+				},
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
+func resourceAwsEcsClusterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Accept either the cluster name or its ARN; Read looks clusters up by name
+	name := d.Id()
+	if i := strings.LastIndex(name, "/"); i != -1 {
+		name = name[i+1:]
+	}
+	d.Set("name", name)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsEcsClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
 
 	clusterName := d.Get("name").(string)
 	log.Printf("[DEBUG] Creating ECS cluster %s", clusterName)
 
-	out, err := conn.CreateCluster(&ecs.CreateClusterInput{
+	input := &ecs.CreateClusterInput{
 		ClusterName: aws.String(clusterName),
-	})
+		Settings:    expandEcsClusterSettings(d.Get("setting").(*schema.Set).List()),
+	}
+
+	if v, ok := d.GetOk("capacity_providers"); ok {
+		input.CapacityProviders = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("default_capacity_provider_strategy"); ok {
+		input.DefaultCapacityProviderStrategy = expandEcsCapacityProviderStrategy(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = tagsFromMapECS(v.(map[string]interface{}))
+	}
+
+	out, err := conn.CreateCluster(input)
 	if err != nil {
 		return err
 	}
@@ -44,7 +142,8 @@ func resourceAwsEcsClusterCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(*out.Cluster.ClusterArn)
 	d.Set("name", out.Cluster.ClusterName)
-	return nil
+
+	return resourceAwsEcsClusterRead(d, meta)
 }
 
 func resourceAwsEcsClusterRead(d *schema.ResourceData, meta interface{}) error {
@@ -54,6 +153,10 @@ func resourceAwsEcsClusterRead(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Reading ECS cluster %s", clusterName)
 	out, err := conn.DescribeClusters(&ecs.DescribeClustersInput{
 		Clusters: []*string{aws.String(clusterName)},
+		Include: []*string{
+			aws.String(ecs.ClusterFieldSettings),
+			aws.String(ecs.ClusterFieldTags),
+		},
 	})
 	if err != nil {
 		return err
@@ -71,7 +174,21 @@ func resourceAwsEcsClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 			d.SetId(*c.ClusterArn)
 			d.Set("name", c.ClusterName)
-			return nil
+			d.Set("arn", c.ClusterArn)
+
+			if err := d.Set("setting", flattenEcsClusterSettings(c.Settings)); err != nil {
+				return fmt.Errorf("Error setting setting: %s", err)
+			}
+
+			if err := d.Set("capacity_providers", flattenStringList(c.CapacityProviders)); err != nil {
+				return fmt.Errorf("Error setting capacity_providers: %s", err)
+			}
+
+			if err := d.Set("default_capacity_provider_strategy", flattenEcsCapacityProviderStrategy(c.DefaultCapacityProviderStrategy)); err != nil {
+				return fmt.Errorf("Error setting default_capacity_provider_strategy: %s", err)
+			}
+
+			return setTagsAll(d, meta, tagsToMapECS(c.Tags))
 		}
 	}
 
@@ -80,6 +197,113 @@ func resourceAwsEcsClusterRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceAwsEcsClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ecsconn
+
+	if d.HasChange("setting") {
+		input := &ecs.UpdateClusterSettingsInput{
+			Cluster:  aws.String(d.Id()),
+			Settings: expandEcsClusterSettings(d.Get("setting").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Updating ECS cluster %s settings: %s", d.Id(), input)
+		if _, err := conn.UpdateClusterSettings(input); err != nil {
+			return fmt.Errorf("Error updating ECS cluster (%s) settings: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("capacity_providers") || d.HasChange("default_capacity_provider_strategy") {
+		// Both lists are always sent; the API replaces whatever is
+		// currently associated with the cluster
+		input := &ecs.PutClusterCapacityProvidersInput{
+			Cluster:                         aws.String(d.Id()),
+			CapacityProviders:               expandStringSet(d.Get("capacity_providers").(*schema.Set)),
+			DefaultCapacityProviderStrategy: expandEcsCapacityProviderStrategy(d.Get("default_capacity_provider_strategy").(*schema.Set).List()),
+		}
+
+		log.Printf("[DEBUG] Updating ECS cluster %s capacity providers: %s", d.Id(), input)
+		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			_, err := conn.PutClusterCapacityProviders(input)
+			if isAWSErr(err, ecs.ErrCodeClientException, "Cluster was not ACTIVE") ||
+				isAWSErr(err, ecs.ErrCodeResourceInUseException, "") ||
+				isAWSErr(err, ecs.ErrCodeUpdateInProgressException, "") {
+				return resource.RetryableError(err)
+			}
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating ECS cluster (%s) capacity providers: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsECS(conn, d, d.Id()); err != nil {
+		return fmt.Errorf("Error updating ECS cluster (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEcsClusterRead(d, meta)
+}
+
+func expandEcsClusterSettings(configured []interface{}) []*ecs.ClusterSetting {
+	if len(configured) == 0 {
+		return nil
+	}
+
+	settings := make([]*ecs.ClusterSetting, 0, len(configured))
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+		settings = append(settings, &ecs.ClusterSetting{
+			Name:  aws.String(data["name"].(string)),
+			Value: aws.String(data["value"].(string)),
+		})
+	}
+
+	return settings
+}
+
+func flattenEcsClusterSettings(list []*ecs.ClusterSetting) []interface{} {
+	result := make([]interface{}, 0, len(list))
+	for _, setting := range list {
+		result = append(result, map[string]interface{}{
+			"name":  aws.StringValue(setting.Name),
+			"value": aws.StringValue(setting.Value),
+		})
+	}
+
+	return result
+}
+
+// expandEcsCapacityProviderStrategy never returns nil, as
+// PutClusterCapacityProviders requires the strategy even when it is empty.
+func expandEcsCapacityProviderStrategy(configured []interface{}) []*ecs.CapacityProviderStrategyItem {
+	strategy := make([]*ecs.CapacityProviderStrategyItem, 0, len(configured))
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+		strategy = append(strategy, &ecs.CapacityProviderStrategyItem{
+			CapacityProvider: aws.String(data["capacity_provider"].(string)),
+			Weight:           aws.Int64(int64(data["weight"].(int))),
+			Base:             aws.Int64(int64(data["base"].(int))),
+		})
+	}
+
+	return strategy
+}
+
+func flattenEcsCapacityProviderStrategy(list []*ecs.CapacityProviderStrategyItem) []interface{} {
+	result := make([]interface{}, 0, len(list))
+	for _, item := range list {
+		result = append(result, map[string]interface{}{
+			"capacity_provider": aws.StringValue(item.CapacityProvider),
+			"weight":            int(aws.Int64Value(item.Weight)),
+			"base":              int(aws.Int64Value(item.Base)),
+		})
+	}
+
+	return result
+}
+
 func resourceAwsEcsClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
 
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
@@ -22,10 +23,151 @@ func TestAccAWSEcsCluster_basic(t *testing.T) {
 					testAccCheckAWSEcsClusterExists("aws_ecs_cluster.foo"),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:      "aws_ecs_cluster.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEcsCluster_containerInsights(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+	resourceName := "aws_ecs_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsClusterConfigContainerInsights(rName, "enabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "setting.4047805881.name", "containerInsights"),
+					resource.TestCheckResourceAttr(resourceName, "setting.4047805881.value", "enabled"),
+				),
+			},
+			{
+				Config: testAccAWSEcsClusterConfigContainerInsights(rName, "disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "setting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "setting.1157067080.value", "disabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEcsCluster_tags(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+	resourceName := "aws_ecs_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsClusterConfigTags(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+				),
+			},
+			{
+				Config: testAccAWSEcsClusterConfigTags(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
 		},
 	})
 }
 
+func TestAccAWSEcsCluster_capacityProviders(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+	resourceName := "aws_ecs_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsClusterConfigCapacityProviders(rName, "FARGATE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity_providers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_capacity_provider_strategy.#", "1"),
+				),
+			},
+			{
+				Config: testAccAWSEcsClusterConfigCapacityProviders(rName, "FARGATE_SPOT"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity_providers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_capacity_provider_strategy.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSEcsCluster_capacityProvidersNoStrategy(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+	resourceName := "aws_ecs_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsClusterConfigCapacityProvidersNoStrategy(rName, `"FARGATE"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity_providers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_capacity_provider_strategy.#", "0"),
+				),
+			},
+			{
+				Config: testAccAWSEcsClusterConfigCapacityProvidersNoStrategy(rName, `"FARGATE", "FARGATE_SPOT"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "capacity_providers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_capacity_provider_strategy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandEcsCapacityProviderStrategy_empty(t *testing.T) {
+	strategy := expandEcsCapacityProviderStrategy([]interface{}{})
+	if strategy == nil || len(strategy) != 0 {
+		t.Fatalf("Expected an empty, non-nil strategy, got %#v", strategy)
+	}
+}
+
 func testAccCheckAWSEcsClusterDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ecsconn
 
@@ -68,3 +210,52 @@ resource "aws_ecs_cluster" "foo" {
 	name = "red-grapes"
 }
 `
+
+func testAccAWSEcsClusterConfigContainerInsights(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %q
+
+  setting {
+    name  = "containerInsights"
+    value = %q
+  }
+}
+`, rName, value)
+}
+
+func testAccAWSEcsClusterConfigTags(rName, tagKey, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name = %q
+
+  tags {
+    %s = %q
+  }
+}
+`, rName, tagKey, tagValue)
+}
+
+func testAccAWSEcsClusterConfigCapacityProviders(rName, defaultProvider string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name               = %q
+  capacity_providers = ["FARGATE", "FARGATE_SPOT"]
+
+  default_capacity_provider_strategy {
+    capacity_provider = %q
+    weight            = 1
+    base              = 1
+  }
+}
+`, rName, defaultProvider)
+}
+
+func testAccAWSEcsClusterConfigCapacityProvidersNoStrategy(rName, providers string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "test" {
+  name               = %q
+  capacity_providers = [%s]
+}
+`, rName, providers)
+}
@@ -10,14 +10,20 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 var taskDefinitionRE = regexp.MustCompile("^([a-zA-Z0-9_-]+):([0-9]+)$")
 
+// ecsServiceStableDelay is the polling interval used while waiting for an
+// ECS service to reach a steady state, matching the SDK's ServicesStable waiter.
+const ecsServiceStableDelay = 15 * time.Second
+
 func resourceAwsEcsService() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsEcsServiceCreate,
@@ -25,6 +31,15 @@ func resourceAwsEcsService() *schema.Resource {
 		Update: resourceAwsEcsServiceUpdate,
 		Delete: resourceAwsEcsServiceDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEcsServiceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -40,8 +55,9 @@ func resourceAwsEcsService() *schema.Resource {
 			},
 
 			"task_definition": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEcsServiceControllerDeployedDiffs,
 			},
 
 			"desired_count": {
@@ -49,6 +65,120 @@ func resourceAwsEcsService() *schema.Resource {
 				Optional: true,
 			},
 
+			"launch_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ecs.LaunchTypeEc2,
+					ecs.LaunchTypeFargate,
+				}, false),
+			},
+
+			"platform_version": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressEcsServiceControllerDeployedDiffs,
+			},
+
+			"health_check_grace_period_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+
+			"deployment_controller": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  ecs.DeploymentControllerTypeEcs,
+							ValidateFunc: validation.StringInSlice([]string{
+								ecs.DeploymentControllerTypeEcs,
+								ecs.DeploymentControllerTypeCodeDeploy,
+								ecs.DeploymentControllerTypeExternal,
+							}, false),
+						},
+					},
+				},
+			},
+
+			"service_registries": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"registry_arn": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"container_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"container_port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+					},
+				},
+			},
+
+			"wait_for_steady_state": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"network_configuration": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressEcsServiceControllerDeployedDiffs,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnets": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"assign_public_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"iam_role": {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -167,24 +297,57 @@ func resourceAwsEcsService() *schema.Resource {
 	}
 }
 
+func resourceAwsEcsServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The cluster may be given as an ARN, which itself contains a slash
+	i := strings.LastIndex(d.Id(), "/")
+	if i < 1 || i == len(d.Id())-1 {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected CLUSTER/SERVICE-NAME", d.Id())
+	}
+
+	d.Set("cluster", d.Id()[:i])
+	d.Set("wait_for_steady_state", false)
+	d.SetId(d.Id()[i+1:])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsEcsServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
 
 	input := ecs.CreateServiceInput{
-		ServiceName:    aws.String(d.Get("name").(string)),
-		TaskDefinition: aws.String(d.Get("task_definition").(string)),
-		DesiredCount:   aws.Int64(int64(d.Get("desired_count").(int))),
-		ClientToken:    aws.String(resource.UniqueId()),
+		ServiceName:  aws.String(d.Get("name").(string)),
+		DesiredCount: aws.Int64(int64(d.Get("desired_count").(int))),
+		ClientToken:  aws.String(resource.UniqueId()),
 		DeploymentConfiguration: &ecs.DeploymentConfiguration{
 			MaximumPercent:        aws.Int64(int64(d.Get("deployment_maximum_percent").(int))),
 			MinimumHealthyPercent: aws.Int64(int64(d.Get("deployment_minimum_healthy_percent").(int))),
 		},
 	}
 
+	if v, ok := d.GetOk("task_definition"); ok {
+		input.TaskDefinition = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("cluster"); ok {
 		input.Cluster = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("launch_type"); ok {
+		input.LaunchType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("platform_version"); ok {
+		input.PlatformVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("health_check_grace_period_seconds"); ok {
+		input.HealthCheckGracePeriodSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	input.NetworkConfiguration = expandEcsNetworkConfiguration(d.Get("network_configuration").([]interface{}))
+	input.ServiceRegistries = expandEcsServiceRegistries(d.Get("service_registries").([]interface{}))
+	input.DeploymentController = expandEcsDeploymentController(d.Get("deployment_controller").([]interface{}))
+
 	loadBalancers := expandEcsLoadBalancers(d.Get("load_balancer").(*schema.Set).List())
 	if len(loadBalancers) > 0 {
 		log.Printf("[DEBUG] Adding ECS load balancers: %s", loadBalancers)
@@ -309,8 +472,11 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 	d.SetId(*service.ServiceArn)
 	d.Set("name", service.ServiceName)
 
-	// Save task definition in the same format
-	if strings.HasPrefix(d.Get("task_definition").(string), "arn:"+meta.(*AWSClient).partition+":ecs:") {
+	// Save task definition in the same format. Services using the EXTERNAL
+	// deployment controller have no task definition of their own.
+	if service.TaskDefinition == nil {
+		d.Set("task_definition", "")
+	} else if strings.HasPrefix(d.Get("task_definition").(string), "arn:"+meta.(*AWSClient).partition+":ecs:") {
 		d.Set("task_definition", service.TaskDefinition)
 	} else {
 		taskDefinition := buildFamilyAndRevisionFromARN(*service.TaskDefinition)
@@ -343,7 +509,23 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if service.LoadBalancers != nil {
-		d.Set("load_balancers", flattenEcsLoadBalancers(service.LoadBalancers))
+		d.Set("load_balancer", flattenEcsLoadBalancers(service.LoadBalancers))
+	}
+
+	d.Set("launch_type", service.LaunchType)
+	d.Set("platform_version", service.PlatformVersion)
+	d.Set("health_check_grace_period_seconds", service.HealthCheckGracePeriodSeconds)
+
+	if err := d.Set("network_configuration", flattenEcsNetworkConfiguration(service.NetworkConfiguration)); err != nil {
+		return fmt.Errorf("Error setting network_configuration for (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("service_registries", flattenEcsServiceRegistries(service.ServiceRegistries)); err != nil {
+		return fmt.Errorf("Error setting service_registries for (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("deployment_controller", flattenEcsDeploymentController(service.DeploymentController)); err != nil {
+		return fmt.Errorf("Error setting deployment_controller for (%s): %s", d.Id(), err)
 	}
 
 	if err := d.Set("placement_strategy", flattenPlacementStrategy(service.PlacementStrategy)); err != nil {
@@ -406,7 +588,17 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		_, n := d.GetChange("desired_count")
 		input.DesiredCount = aws.Int64(int64(n.(int)))
 	}
-	if d.HasChange("task_definition") {
+
+	// Services using the CODE_DEPLOY or EXTERNAL deployment controllers only
+	// accept changes to the desired count, deployment configuration and
+	// health check grace period through UpdateService. Diffs of the other
+	// arguments are suppressed for them.
+	ecsControlled := true
+	if v := d.Get("deployment_controller").([]interface{}); len(v) > 0 && v[0] != nil {
+		ecsControlled = v[0].(map[string]interface{})["type"].(string) == ecs.DeploymentControllerTypeEcs
+	}
+
+	if ecsControlled && d.HasChange("task_definition") {
 		_, n := d.GetChange("task_definition")
 		input.TaskDefinition = aws.String(n.(string))
 	}
@@ -418,6 +610,18 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if ecsControlled && d.HasChange("platform_version") {
+		input.PlatformVersion = aws.String(d.Get("platform_version").(string))
+	}
+
+	if d.HasChange("health_check_grace_period_seconds") {
+		input.HealthCheckGracePeriodSeconds = aws.Int64(int64(d.Get("health_check_grace_period_seconds").(int)))
+	}
+
+	if ecsControlled && d.HasChange("network_configuration") {
+		input.NetworkConfiguration = expandEcsNetworkConfiguration(d.Get("network_configuration").([]interface{}))
+	}
+
 	// Retry due to IAM & ECS eventual consistency
 	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
 		out, err := conn.UpdateService(&input)
@@ -442,9 +646,96 @@ func resourceAwsEcsServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	if d.Get("wait_for_steady_state").(bool) {
+		timeout := d.Timeout(schema.TimeoutUpdate)
+		if d.IsNewResource() {
+			timeout = d.Timeout(schema.TimeoutCreate)
+		}
+
+		if err := waitForEcsServiceSteadyState(conn, d.Get("cluster").(string), d.Get("name").(string), timeout); err != nil {
+			return err
+		}
+	}
+
 	return resourceAwsEcsServiceRead(d, meta)
 }
 
+// waitForEcsServiceSteadyState blocks until the service's deployments have
+// settled and its running count matches the desired count. On failure the
+// reasons given for recently stopped tasks are included in the error, as
+// they are usually what explains a service that never stabilizes.
+func waitForEcsServiceSteadyState(conn *ecs.ECS, cluster, service string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for ECS service %s to reach a steady state", service)
+
+	maxAttempts := int(timeout / ecsServiceStableDelay)
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	err := conn.WaitUntilServicesStableWithContext(
+		aws.BackgroundContext(),
+		&ecs.DescribeServicesInput{
+			Cluster:  aws.String(cluster),
+			Services: []*string{aws.String(service)},
+		},
+		request.WithWaiterDelay(request.ConstantWaiterDelay(ecsServiceStableDelay)),
+		request.WithWaiterMaxAttempts(maxAttempts),
+	)
+	if err == nil {
+		return nil
+	}
+
+	reasons, rErr := ecsServiceStoppedTaskReasons(conn, cluster, service)
+	if rErr != nil {
+		log.Printf("[WARN] Error listing stopped tasks for ECS service %s: %s", service, rErr)
+	}
+	if len(reasons) > 0 {
+		return fmt.Errorf("Error waiting for ECS service %s to reach a steady state: %s\nStopped tasks:\n%s",
+			service, err, strings.Join(reasons, "\n"))
+	}
+
+	return fmt.Errorf("Error waiting for ECS service %s to reach a steady state: %s", service, err)
+}
+
+// ecsServiceStoppedTaskReasons returns a description of why each of the
+// service's recently stopped tasks (and their containers) stopped.
+func ecsServiceStoppedTaskReasons(conn *ecs.ECS, cluster, service string) ([]string, error) {
+	list, err := conn.ListTasks(&ecs.ListTasksInput{
+		Cluster:       aws.String(cluster),
+		ServiceName:   aws.String(service),
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(list.TaskArns) == 0 {
+		return nil, nil
+	}
+
+	// DescribeTasks accepts at most 100 tasks per call, which is the
+	// default page size of ListTasks
+	resp, err := conn.DescribeTasks(&ecs.DescribeTasksInput{
+		Cluster: aws.String(cluster),
+		Tasks:   list.TaskArns,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var reasons []string
+	for _, task := range resp.Tasks {
+		reason := fmt.Sprintf("- %s: %s", aws.StringValue(task.TaskArn), aws.StringValue(task.StoppedReason))
+		for _, c := range task.Containers {
+			if c.Reason != nil {
+				reason += fmt.Sprintf(" (%s: %s)", aws.StringValue(c.Name), aws.StringValue(c.Reason))
+			}
+		}
+		reasons = append(reasons, reason)
+	}
+
+	return reasons, nil
+}
+
 func resourceAwsEcsServiceDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ecsconn
 
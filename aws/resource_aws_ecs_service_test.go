@@ -7,11 +7,62 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestResourceAwsEcsServiceDiff_deploymentController(t *testing.T) {
+	cases := []struct {
+		Controller string
+		Suppressed bool
+	}{
+		{ecs.DeploymentControllerTypeEcs, false},
+		{ecs.DeploymentControllerTypeCodeDeploy, true},
+		{ecs.DeploymentControllerTypeExternal, true},
+	}
+
+	for _, tc := range cases {
+		state := &terraform.InstanceState{
+			ID: "arn:aws:ecs:us-west-2:123456789012:service/app",
+			Attributes: map[string]string{
+				"name":                         "app",
+				"task_definition":              "app:1",
+				"platform_version":             "1.3.0",
+				"deployment_controller.#":      "1",
+				"deployment_controller.0.type": tc.Controller,
+			},
+		}
+		rawConfig, err := config.NewRawConfig(map[string]interface{}{
+			"name":             "app",
+			"task_definition":  "app:2",
+			"platform_version": "1.4.0",
+			"deployment_controller": []interface{}{
+				map[string]interface{}{"type": tc.Controller},
+			},
+		})
+		if err != nil {
+			t.Fatalf("%s: err: %s", tc.Controller, err)
+		}
+
+		diff, err := resourceAwsEcsService().Diff(state, terraform.NewResourceConfig(rawConfig), nil)
+		if err != nil {
+			t.Fatalf("%s: err: %s", tc.Controller, err)
+		}
+
+		for _, k := range []string{"task_definition", "platform_version"} {
+			planned := false
+			if diff != nil {
+				_, planned = diff.Attributes[k]
+			}
+			if planned == tc.Suppressed {
+				t.Fatalf("%s: expected %s to be planned: %t", tc.Controller, k, !tc.Suppressed)
+			}
+		}
+	}
+}
+
 func TestParseTaskDefinition(t *testing.T) {
 	cases := map[string]map[string]interface{}{
 		"invalid": {
@@ -104,6 +155,13 @@ func TestAccAWSEcsServiceWithARN(t *testing.T) {
 					testAccCheckAWSEcsServiceExists("aws_ecs_service.mongo"),
 				),
 			},
+
+			{
+				ResourceName:      "aws_ecs_service.mongo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSEcsServiceImportStateIdFunc("aws_ecs_service.mongo"),
+			},
 		},
 	})
 }
@@ -341,6 +399,110 @@ func TestAccAWSEcsServiceWithPlacementConstraints_emptyExpression(t *testing.T)
 	})
 }
 
+func TestAccAWSEcsService_withLaunchTypeFargate(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsServiceWithLaunchTypeFargate(rInt, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsServiceExists("aws_ecs_service.main"),
+					resource.TestCheckResourceAttr("aws_ecs_service.main", "launch_type", "FARGATE"),
+					resource.TestCheckResourceAttrSet("aws_ecs_service.main", "platform_version"),
+					resource.TestCheckResourceAttr("aws_ecs_service.main", "network_configuration.#", "1"),
+					resource.TestCheckResourceAttr("aws_ecs_service.main", "network_configuration.0.subnets.#", "2"),
+					resource.TestCheckResourceAttr("aws_ecs_service.main", "network_configuration.0.security_groups.#", "1"),
+					resource.TestCheckResourceAttr("aws_ecs_service.main", "network_configuration.0.assign_public_ip", "false"),
+				),
+			},
+			{
+				Config: testAccAWSEcsServiceWithLaunchTypeFargate(rInt, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsServiceExists("aws_ecs_service.main"),
+					resource.TestCheckResourceAttr("aws_ecs_service.main", "network_configuration.0.assign_public_ip", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEcsService_withDeploymentControllerTypeExternal(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsServiceWithDeploymentControllerTypeExternal(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsServiceExists("aws_ecs_service.main"),
+					resource.TestCheckResourceAttr("aws_ecs_service.main", "deployment_controller.#", "1"),
+					resource.TestCheckResourceAttr("aws_ecs_service.main", "deployment_controller.0.type", "EXTERNAL"),
+					resource.TestCheckResourceAttr("aws_ecs_service.main", "task_definition", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSEcsService_waitForSteadyState(t *testing.T) {
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsServiceWaitForSteadyState(rInt, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsServiceExists("aws_ecs_service.main"),
+					testAccCheckAWSEcsServiceRunningCount("aws_ecs_service.main", 1),
+					resource.TestCheckResourceAttr("aws_ecs_service.main", "wait_for_steady_state", "true"),
+					resource.TestCheckResourceAttr("aws_ecs_service.main", "deployment_controller.0.type", "ECS"),
+				),
+			},
+			{
+				Config: testAccAWSEcsServiceWaitForSteadyState(rInt, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsServiceExists("aws_ecs_service.main"),
+					testAccCheckAWSEcsServiceRunningCount("aws_ecs_service.main", 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEcsServiceRunningCount(name string, expected int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ecsconn
+		out, err := conn.DescribeServices(&ecs.DescribeServicesInput{
+			Services: []*string{aws.String(rs.Primary.ID)},
+			Cluster:  aws.String(rs.Primary.Attributes["cluster"]),
+		})
+		if err != nil {
+			return err
+		}
+		if len(out.Services) != 1 {
+			return fmt.Errorf("ECS service %s not found", rs.Primary.ID)
+		}
+
+		if running := aws.Int64Value(out.Services[0].RunningCount); running != expected {
+			return fmt.Errorf("Expected ECS service %s to have %d running tasks, got %d", rs.Primary.ID, expected, running)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckAWSEcsServiceDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ecsconn
 
@@ -581,6 +743,187 @@ resource "aws_ecs_service" "mongo" {
 `, rInt, rInt)
 }
 
+func testAccAWSEcsServiceWithLaunchTypeFargate(rInt int, assignPublicIP bool) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "main" {
+  cidr_block = "10.10.0.0/16"
+  tags {
+    Name = "terraform-testacc-ecs-service-fargate"
+  }
+}
+
+resource "aws_subnet" "main" {
+  count             = 2
+  cidr_block        = "${cidrsubnet(aws_vpc.main.cidr_block, 8, count.index)}"
+  availability_zone = "${data.aws_availability_zones.available.names[count.index]}"
+  vpc_id            = "${aws_vpc.main.id}"
+}
+
+resource "aws_security_group" "allow_all" {
+  name        = "tf-acc-ecs-service-fargate-%d"
+  description = "Allow all inbound traffic"
+  vpc_id      = "${aws_vpc.main.id}"
+
+  ingress {
+    protocol    = "6"
+    from_port   = 80
+    to_port     = 8000
+    cidr_blocks = ["${aws_vpc.main.cidr_block}"]
+  }
+}
+
+resource "aws_ecs_cluster" "main" {
+  name = "tf-acc-ecs-cluster-fargate-%d"
+}
+
+resource "aws_ecs_task_definition" "mongo" {
+  family                   = "tf-acc-ecs-fargate-%d"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 256,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 512,
+    "name": "mongodb",
+    "networkMode": "awsvpc"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "main" {
+  name            = "tf-acc-ecs-service-fargate-%d"
+  cluster         = "${aws_ecs_cluster.main.id}"
+  task_definition = "${aws_ecs_task_definition.mongo.arn}"
+  desired_count   = 1
+  launch_type     = "FARGATE"
+
+  network_configuration {
+    security_groups  = ["${aws_security_group.allow_all.id}"]
+    subnets          = ["${aws_subnet.main.*.id}"]
+    assign_public_ip = %t
+  }
+}
+`, rInt, rInt, rInt, rInt, assignPublicIP)
+}
+
+func testAccAWSEcsServiceWithDeploymentControllerTypeExternal(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_cluster" "main" {
+  name = "tf-acc-ecs-cluster-external-%d"
+}
+
+resource "aws_ecs_service" "main" {
+  name          = "tf-acc-ecs-service-external-%d"
+  cluster       = "${aws_ecs_cluster.main.id}"
+  desired_count = 0
+
+  deployment_controller {
+    type = "EXTERNAL"
+  }
+}
+`, rInt, rInt)
+}
+
+func testAccAWSEcsServiceWaitForSteadyState(rInt, desiredCount int) string {
+	return fmt.Sprintf(`
+data "aws_availability_zones" "available" {}
+
+resource "aws_vpc" "main" {
+  cidr_block = "10.10.0.0/16"
+  tags {
+    Name = "terraform-testacc-ecs-service-steady-state"
+  }
+}
+
+resource "aws_internet_gateway" "main" {
+  vpc_id = "${aws_vpc.main.id}"
+}
+
+resource "aws_route_table" "main" {
+  vpc_id = "${aws_vpc.main.id}"
+
+  route {
+    cidr_block = "0.0.0.0/0"
+    gateway_id = "${aws_internet_gateway.main.id}"
+  }
+}
+
+resource "aws_subnet" "main" {
+  count             = 2
+  cidr_block        = "${cidrsubnet(aws_vpc.main.cidr_block, 8, count.index)}"
+  availability_zone = "${data.aws_availability_zones.available.names[count.index]}"
+  vpc_id            = "${aws_vpc.main.id}"
+}
+
+resource "aws_route_table_association" "main" {
+  count          = 2
+  subnet_id      = "${element(aws_subnet.main.*.id, count.index)}"
+  route_table_id = "${aws_route_table.main.id}"
+}
+
+resource "aws_security_group" "main" {
+  name   = "tf-acc-ecs-service-steady-state-%d"
+  vpc_id = "${aws_vpc.main.id}"
+
+  egress {
+    protocol    = "-1"
+    from_port   = 0
+    to_port     = 0
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+}
+
+resource "aws_ecs_cluster" "main" {
+  name = "tf-acc-ecs-cluster-steady-state-%d"
+}
+
+resource "aws_ecs_task_definition" "sleep" {
+  family                   = "tf-acc-ecs-steady-state-%d"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "essential": true,
+    "image": "busybox",
+    "command": ["sleep", "3600"],
+    "name": "sleep"
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "main" {
+  name                  = "tf-acc-ecs-service-steady-state-%d"
+  cluster               = "${aws_ecs_cluster.main.id}"
+  task_definition       = "${aws_ecs_task_definition.sleep.arn}"
+  desired_count         = %d
+  launch_type           = "FARGATE"
+  wait_for_steady_state = true
+
+  network_configuration {
+    security_groups  = ["${aws_security_group.main.id}"]
+    subnets          = ["${aws_subnet.main.*.id}"]
+    assign_public_ip = true
+  }
+
+  depends_on = ["aws_route_table_association.main"]
+}
+`, rInt, rInt, rInt, rInt, desiredCount)
+}
+
 var testAccAWSEcsService_withIamRole = `
 resource "aws_ecs_cluster" "main" {
 	name = "terraformecstest11"
@@ -1074,3 +1417,14 @@ resource "aws_ecs_service" "with_alb" {
 }
 `, rName, rName, rName, rName, rName, rName, rName)
 }
+
+func testAccAWSEcsServiceImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["cluster"], rs.Primary.Attributes["name"]), nil
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsEcsTaskDefinition() *schema.Resource {
@@ -18,6 +19,10 @@ func resourceAwsEcsTaskDefinition() *schema.Resource {
 		Read:   resourceAwsEcsTaskDefinitionRead,
 		Delete: resourceAwsEcsTaskDefinitionDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEcsTaskDefinitionImport,
+		},
+
 		SchemaVersion: 1,
 		MigrateState:  resourceAwsEcsTaskDefinitionMigrateState,
 
@@ -53,12 +58,44 @@ func resourceAwsEcsTaskDefinition() *schema.Resource {
 				ValidateFunc: validateAwsEcsTaskDefinitionContainerDefinitions,
 			},
 
+			"cpu": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"memory": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
 			"task_role_arn": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
 
+			"execution_role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"requires_compatibilities": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						ecs.CompatibilityEc2,
+						ecs.CompatibilityFargate,
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+
 			"network_mode": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -113,16 +150,22 @@ func resourceAwsEcsTaskDefinition() *schema.Resource {
 	}
 }
 
+func resourceAwsEcsTaskDefinitionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("arn", d.Id())
+	return []*schema.ResourceData{d}, nil
+}
+
 func validateAwsEcsTaskDefinitionNetworkMode(v interface{}, k string) (ws []string, errors []error) {
 	value := strings.ToLower(v.(string))
 	validTypes := map[string]struct{}{
+		"awsvpc": {},
 		"bridge": {},
 		"host":   {},
 		"none":   {},
 	}
 
 	if _, ok := validTypes[value]; !ok {
-		errors = append(errors, fmt.Errorf("ECS Task Definition network_mode %q is invalid, must be `bridge`, `host` or `none`", value))
+		errors = append(errors, fmt.Errorf("ECS Task Definition network_mode %q is invalid, must be `awsvpc`, `bridge`, `host` or `none`", value))
 	}
 	return
 }
@@ -154,6 +197,22 @@ func resourceAwsEcsTaskDefinitionCreate(d *schema.ResourceData, meta interface{}
 		input.TaskRoleArn = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("execution_role_arn"); ok {
+		input.ExecutionRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cpu"); ok {
+		input.Cpu = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("memory"); ok {
+		input.Memory = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("requires_compatibilities"); ok && v.(*schema.Set).Len() > 0 {
+		input.RequiresCompatibilities = expandStringList(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("network_mode"); ok {
 		input.NetworkMode = aws.String(v.(string))
 	}
@@ -230,8 +289,16 @@ func resourceAwsEcsTaskDefinitionRead(d *schema.ResourceData, meta interface{})
 	}
 
 	d.Set("task_role_arn", taskDefinition.TaskRoleArn)
+	d.Set("execution_role_arn", taskDefinition.ExecutionRoleArn)
+	d.Set("cpu", taskDefinition.Cpu)
+	d.Set("memory", taskDefinition.Memory)
 	d.Set("network_mode", taskDefinition.NetworkMode)
-	d.Set("volumes", flattenEcsVolumes(taskDefinition.Volumes))
+
+	if err := d.Set("requires_compatibilities", flattenStringList(taskDefinition.RequiresCompatibilities)); err != nil {
+		return fmt.Errorf("Error setting requires_compatibilities: %s", err)
+	}
+
+	d.Set("volume", flattenEcsVolumes(taskDefinition.Volumes))
 	if err := d.Set("placement_constraints", flattenPlacementConstraints(taskDefinition.PlacementConstraints)); err != nil {
 		log.Printf("[ERR] Error setting placement_constraints for (%s): %s", d.Id(), err)
 	}
@@ -24,6 +24,12 @@ func TestAccAWSEcsTaskDefinition_basic(t *testing.T) {
 					testAccCheckAWSEcsTaskDefinitionExists("aws_ecs_task_definition.jenkins", &def),
 				),
 			},
+			{
+				ResourceName:      "aws_ecs_task_definition.jenkins",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSEcsTaskDefinitionImportStateIdFunc("aws_ecs_task_definition.jenkins"),
+			},
 			{
 				Config: testAccAWSEcsTaskDefinitionModified,
 				Check: resource.ComposeTestCheckFunc(
@@ -116,6 +122,29 @@ func TestAccAWSEcsTaskDefinition_withNetworkMode(t *testing.T) {
 	})
 }
 
+func TestAccAWSEcsTaskDefinition_fargate(t *testing.T) {
+	var def ecs.TaskDefinition
+	rInt := acctest.RandInt()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEcsTaskDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEcsTaskDefinitionFargate(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEcsTaskDefinitionExists("aws_ecs_task_definition.fargate", &def),
+					resource.TestCheckResourceAttr("aws_ecs_task_definition.fargate", "network_mode", "awsvpc"),
+					resource.TestCheckResourceAttr("aws_ecs_task_definition.fargate", "requires_compatibilities.#", "1"),
+					resource.TestCheckResourceAttr("aws_ecs_task_definition.fargate", "cpu", "256"),
+					resource.TestCheckResourceAttr("aws_ecs_task_definition.fargate", "memory", "512"),
+					resource.TestCheckResourceAttrPair("aws_ecs_task_definition.fargate", "execution_role_arn", "aws_iam_role.execution", "arn"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSEcsTaskDefinition_constraint(t *testing.T) {
 	var def ecs.TaskDefinition
 	resource.Test(t, resource.TestCase{
@@ -201,6 +230,7 @@ func testAccCheckAWSTaskDefinitionConstraintsAttrs(def *ecs.TaskDefinition) reso
 }
 func TestValidateAwsEcsTaskDefinitionNetworkMode(t *testing.T) {
 	validNames := []string{
+		"awsvpc",
 		"bridge",
 		"host",
 		"none",
@@ -638,6 +668,51 @@ TASK_DEFINITION
 }`, rInt, rInt)
 }
 
+func testAccAWSEcsTaskDefinitionFargate(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "execution" {
+  name = "tf-acc-ecs-execution-%d"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ecs-tasks.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_ecs_task_definition" "fargate" {
+  family                   = "tf-acc-ecs-fargate-%d"
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+  execution_role_arn       = "${aws_iam_role.execution.arn}"
+
+  container_definitions = <<TASK_DEFINITION
+[
+  {
+    "name": "sleep",
+    "image": "busybox",
+    "cpu": 10,
+    "command": ["sleep","360"],
+    "memory": 10,
+    "essential": true
+  }
+]
+TASK_DEFINITION
+}
+`, rInt, rInt)
+}
+
 func testAccAWSEcsTaskDefinitionWithNetworkMode(rInt int) string {
 	return fmt.Sprintf(`
  resource "aws_iam_role" "role_test" {
@@ -842,3 +917,14 @@ var testValidateAwsEcsTaskDefinitionInvalidCommandContainerDefinitions = `
   }
 ]
 `
+
+func testAccAWSEcsTaskDefinitionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["arn"], nil
+	}
+}
@@ -15,10 +15,11 @@ import (
 
 func resourceAwsEfsFileSystem() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsEfsFileSystemCreate,
-		Read:   resourceAwsEfsFileSystemRead,
-		Update: resourceAwsEfsFileSystemUpdate,
-		Delete: resourceAwsEfsFileSystemDelete,
+		Create:        resourceAwsEfsFileSystemCreate,
+		Read:          resourceAwsEfsFileSystemRead,
+		Update:        resourceAwsEfsFileSystemUpdate,
+		Delete:        resourceAwsEfsFileSystemDelete,
+		CustomizeDiff: setTagsAllDiff,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -69,7 +70,8 @@ func resourceAwsEfsFileSystem() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -212,7 +214,7 @@ func resourceAwsEfsFileSystemRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	err = d.Set("tags", tagsToMapEFS(tags))
+	err = setTagsAll(d, meta, tagsToMapEFS(tags))
 	if err != nil {
 		return err
 	}
@@ -19,6 +19,10 @@ func resourceAwsEgressOnlyInternetGateway() *schema.Resource {
 		Read:   resourceAwsEgressOnlyInternetGatewayRead,
 		Delete: resourceAwsEgressOnlyInternetGatewayDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -103,6 +107,9 @@ func resourceAwsEgressOnlyInternetGatewayRead(d *schema.ResourceData, meta inter
 	for _, igw := range resp.EgressOnlyInternetGateways {
 		if *igw.EgressOnlyInternetGatewayId == d.Id() {
 			found = true
+			if len(igw.Attachments) == 1 {
+				d.Set("vpc_id", igw.Attachments[0].VpcId)
+			}
 		}
 	}
 
@@ -17,6 +17,10 @@ func resourceAwsEipAssociation() *schema.Resource {
 		Read:   resourceAwsEipAssociationRead,
 		Delete: resourceAwsEipAssociationDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"allocation_id": &schema.Schema{
 				Type:     schema.TypeString,
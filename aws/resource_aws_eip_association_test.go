@@ -36,6 +36,13 @@ func TestAccAWSEIPAssociation_basic(t *testing.T) {
 						"aws_eip_association.to_eni", &a),
 				),
 			},
+
+			{
+				ResourceName:            "aws_eip_association.by_allocation_id",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_reassociation"},
+			},
 		},
 	})
 }
@@ -0,0 +1,680 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEksCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEksClusterCreate,
+		Read:   resourceAwsEksClusterRead,
+		Update: resourceAwsEksClusterUpdate,
+		Delete: resourceAwsEksClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsAllDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateEksClusterName,
+			},
+
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"endpoint_private_access": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"endpoint_public_access": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"public_access_cidrs": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateCIDRNetworkAddress,
+							},
+							Set: schema.HashString,
+						},
+						"cluster_security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"enabled_cluster_log_types": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						eks.LogTypeApi,
+						eks.LogTypeAudit,
+						eks.LogTypeAuthenticator,
+						eks.LogTypeControllerManager,
+						eks.LogTypeScheduler,
+					}, false),
+				},
+				Set: schema.HashString,
+			},
+
+			"encryption_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+						"resources": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"secrets"}, false),
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"platform_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"certificate_authority": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"oidc": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"issuer": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
+		},
+	}
+}
+
+func resourceAwsEksClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).eksconn
+	name := d.Get("name").(string)
+
+	input := &eks.CreateClusterInput{
+		Name:               aws.String(name),
+		RoleArn:            aws.String(d.Get("role_arn").(string)),
+		ResourcesVpcConfig: expandEksVpcConfigRequest(d.Get("vpc_config").([]interface{})),
+		Logging:            expandEksLoggingTypes(d.Get("enabled_cluster_log_types").(*schema.Set)),
+		EncryptionConfig:   expandEksEncryptionConfig(d.Get("encryption_config").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("version"); ok {
+		input.Version = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating EKS cluster: %s", input)
+
+	// IAM roles and their policies take a while to propagate to EKS
+	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateCluster(input)
+		if isAWSErr(err, eks.ErrCodeInvalidParameterException, "The role with name") ||
+			isAWSErr(err, eks.ErrCodeInvalidParameterException, "Role could not be assumed") ||
+			isAWSErr(err, eks.ErrCodeInvalidParameterException, "does not exist") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating EKS cluster (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	stateConf := resource.StateChangeConf{
+		Pending: []string{eks.ClusterStatusCreating},
+		Target:  []string{eks.ClusterStatusActive},
+		Timeout: d.Timeout(schema.TimeoutCreate),
+		Refresh: eksClusterStateRefreshFunc(conn, d.Id()),
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EKS cluster (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsEksClusterRead(d, meta)
+}
+
+func resourceAwsEksClusterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).eksconn
+
+	out, err := conn.DescribeCluster(&eks.DescribeClusterInput{
+		Name: aws.String(d.Id()),
+	})
+	if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] EKS cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading EKS cluster (%s): %s", d.Id(), err)
+	}
+
+	cluster := out.Cluster
+	if cluster == nil {
+		log.Printf("[WARN] EKS cluster (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", cluster.Name)
+	d.Set("arn", cluster.Arn)
+	d.Set("role_arn", cluster.RoleArn)
+	d.Set("version", cluster.Version)
+	d.Set("endpoint", cluster.Endpoint)
+	d.Set("platform_version", cluster.PlatformVersion)
+	d.Set("status", cluster.Status)
+
+	if cluster.CreatedAt != nil {
+		d.Set("created_at", aws.TimeValue(cluster.CreatedAt).Format(time.RFC3339))
+	}
+
+	if err := d.Set("certificate_authority", flattenEksCertificate(cluster.CertificateAuthority)); err != nil {
+		return fmt.Errorf("Error setting certificate_authority: %s", err)
+	}
+
+	if err := d.Set("identity", flattenEksIdentity(cluster.Identity)); err != nil {
+		return fmt.Errorf("Error setting identity: %s", err)
+	}
+
+	if err := d.Set("vpc_config", flattenEksVpcConfigResponse(cluster.ResourcesVpcConfig)); err != nil {
+		return fmt.Errorf("Error setting vpc_config: %s", err)
+	}
+
+	if err := d.Set("enabled_cluster_log_types", flattenEksEnabledLogTypes(cluster.Logging)); err != nil {
+		return fmt.Errorf("Error setting enabled_cluster_log_types: %s", err)
+	}
+
+	if err := d.Set("encryption_config", flattenEksEncryptionConfig(cluster.EncryptionConfig)); err != nil {
+		return fmt.Errorf("Error setting encryption_config: %s", err)
+	}
+
+	return setTagsAll(d, meta, tagsToMapGeneric(cluster.Tags))
+}
+
+func resourceAwsEksClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).eksconn
+
+	if d.HasChange("version") {
+		input := &eks.UpdateClusterVersionInput{
+			Name:    aws.String(d.Id()),
+			Version: aws.String(d.Get("version").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating EKS cluster (%s) version: %s", d.Id(), input)
+		out, err := conn.UpdateClusterVersion(input)
+		if err != nil {
+			return fmt.Errorf("Error updating EKS cluster (%s) version: %s", d.Id(), err)
+		}
+
+		if err := waitForEksUpdate(conn, d.Id(), "", aws.StringValue(out.Update.Id), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting for EKS cluster (%s) version update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("vpc_config.0.endpoint_private_access") ||
+		d.HasChange("vpc_config.0.endpoint_public_access") ||
+		d.HasChange("vpc_config.0.public_access_cidrs") {
+		// Subnets and security groups cannot be changed after creation, so
+		// only the endpoint access settings are sent
+		config := &eks.VpcConfigRequest{
+			EndpointPrivateAccess: aws.Bool(d.Get("vpc_config.0.endpoint_private_access").(bool)),
+			EndpointPublicAccess:  aws.Bool(d.Get("vpc_config.0.endpoint_public_access").(bool)),
+		}
+
+		if v, ok := d.GetOk("vpc_config.0.public_access_cidrs"); ok && v.(*schema.Set).Len() > 0 {
+			config.PublicAccessCidrs = expandStringSet(v.(*schema.Set))
+		}
+
+		input := &eks.UpdateClusterConfigInput{
+			Name:               aws.String(d.Id()),
+			ResourcesVpcConfig: config,
+		}
+
+		log.Printf("[DEBUG] Updating EKS cluster (%s) endpoint access: %s", d.Id(), input)
+		out, err := conn.UpdateClusterConfig(input)
+		if err != nil {
+			return fmt.Errorf("Error updating EKS cluster (%s) endpoint access: %s", d.Id(), err)
+		}
+
+		if err := waitForEksUpdate(conn, d.Id(), "", aws.StringValue(out.Update.Id), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting for EKS cluster (%s) endpoint access update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("enabled_cluster_log_types") {
+		input := &eks.UpdateClusterConfigInput{
+			Name:    aws.String(d.Id()),
+			Logging: expandEksLoggingTypes(d.Get("enabled_cluster_log_types").(*schema.Set)),
+		}
+
+		log.Printf("[DEBUG] Updating EKS cluster (%s) logging: %s", d.Id(), input)
+		out, err := conn.UpdateClusterConfig(input)
+		if err != nil {
+			return fmt.Errorf("Error updating EKS cluster (%s) logging: %s", d.Id(), err)
+		}
+
+		if err := waitForEksUpdate(conn, d.Id(), "", aws.StringValue(out.Update.Id), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting for EKS cluster (%s) logging update: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsEKS(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("Error updating EKS cluster (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEksClusterRead(d, meta)
+}
+
+func resourceAwsEksClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).eksconn
+
+	log.Printf("[DEBUG] Deleting EKS cluster %s", d.Id())
+
+	// Node groups and Fargate profiles are removed asynchronously, so the
+	// cluster may still be in use for a little while after they are gone
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteCluster(&eks.DeleteClusterInput{
+			Name: aws.String(d.Id()),
+		})
+		if isAWSErr(err, eks.ErrCodeResourceInUseException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error deleting EKS cluster (%s): %s", d.Id(), err)
+	}
+
+	stateConf := resource.StateChangeConf{
+		Pending: []string{eks.ClusterStatusActive, eks.ClusterStatusDeleting},
+		Target:  []string{""},
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Refresh: eksClusterStateRefreshFunc(conn, d.Id()),
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EKS cluster (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// eksClusterStateRefreshFunc returns the cluster status, or an empty status
+// once the cluster no longer exists.
+func eksClusterStateRefreshFunc(conn *eks.EKS, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := conn.DescribeCluster(&eks.DescribeClusterInput{
+			Name: aws.String(name),
+		})
+		if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
+			return "", "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if out.Cluster == nil {
+			return "", "", nil
+		}
+
+		status := aws.StringValue(out.Cluster.Status)
+		if status == eks.ClusterStatusFailed {
+			return out.Cluster, status, fmt.Errorf("EKS cluster (%s) entered %s state", name, status)
+		}
+
+		return out.Cluster, status, nil
+	}
+}
+
+// waitForEksUpdate waits for an asynchronous cluster or node group update to
+// finish. nodeGroupName is empty for updates to the cluster itself.
+func waitForEksUpdate(conn *eks.EKS, clusterName, nodeGroupName, updateID string, timeout time.Duration) error {
+	input := &eks.DescribeUpdateInput{
+		Name:     aws.String(clusterName),
+		UpdateId: aws.String(updateID),
+	}
+	if nodeGroupName != "" {
+		input.NodegroupName = aws.String(nodeGroupName)
+	}
+
+	stateConf := resource.StateChangeConf{
+		Pending: []string{eks.UpdateStatusInProgress},
+		Target:  []string{eks.UpdateStatusSuccessful},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			out, err := conn.DescribeUpdate(input)
+			if err != nil {
+				return nil, "", err
+			}
+
+			update := out.Update
+			if update == nil {
+				return nil, "", fmt.Errorf("EKS update (%s) not found", updateID)
+			}
+
+			status := aws.StringValue(update.Status)
+			if status == eks.UpdateStatusCancelled || status == eks.UpdateStatusFailed {
+				var errs []string
+				for _, e := range update.Errors {
+					errs = append(errs, fmt.Sprintf("%s: %s", aws.StringValue(e.ErrorCode), aws.StringValue(e.ErrorMessage)))
+				}
+				return update, status, fmt.Errorf("EKS update (%s) %s: %s", updateID, strings.ToLower(status), strings.Join(errs, "; "))
+			}
+
+			return update, status, nil
+		},
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func expandEksVpcConfigRequest(configured []interface{}) *eks.VpcConfigRequest {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+
+	config := &eks.VpcConfigRequest{
+		EndpointPrivateAccess: aws.Bool(data["endpoint_private_access"].(bool)),
+		EndpointPublicAccess:  aws.Bool(data["endpoint_public_access"].(bool)),
+		SecurityGroupIds:      expandStringSet(data["security_group_ids"].(*schema.Set)),
+		SubnetIds:             expandStringSet(data["subnet_ids"].(*schema.Set)),
+	}
+
+	if v, ok := data["public_access_cidrs"].(*schema.Set); ok && v.Len() > 0 {
+		config.PublicAccessCidrs = expandStringSet(v)
+	}
+
+	return config
+}
+
+func flattenEksVpcConfigResponse(config *eks.VpcConfigResponse) []interface{} {
+	if config == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cluster_security_group_id": aws.StringValue(config.ClusterSecurityGroupId),
+			"endpoint_private_access":   aws.BoolValue(config.EndpointPrivateAccess),
+			"endpoint_public_access":    aws.BoolValue(config.EndpointPublicAccess),
+			"public_access_cidrs":       schema.NewSet(schema.HashString, flattenStringList(config.PublicAccessCidrs)),
+			"security_group_ids":        schema.NewSet(schema.HashString, flattenStringList(config.SecurityGroupIds)),
+			"subnet_ids":                schema.NewSet(schema.HashString, flattenStringList(config.SubnetIds)),
+			"vpc_id":                    aws.StringValue(config.VpcId),
+		},
+	}
+}
+
+// expandEksLoggingTypes enables the given log types and explicitly disables
+// all others, so that removing a type from the configuration turns it off.
+func expandEksLoggingTypes(enabled *schema.Set) *eks.Logging {
+	var disabled []*string
+	for _, t := range []string{
+		eks.LogTypeApi,
+		eks.LogTypeAudit,
+		eks.LogTypeAuthenticator,
+		eks.LogTypeControllerManager,
+		eks.LogTypeScheduler,
+	} {
+		if !enabled.Contains(t) {
+			disabled = append(disabled, aws.String(t))
+		}
+	}
+
+	logging := &eks.Logging{}
+	if enabled.Len() > 0 {
+		logging.ClusterLogging = append(logging.ClusterLogging, &eks.LogSetup{
+			Enabled: aws.Bool(true),
+			Types:   expandStringSet(enabled),
+		})
+	}
+	if len(disabled) > 0 {
+		logging.ClusterLogging = append(logging.ClusterLogging, &eks.LogSetup{
+			Enabled: aws.Bool(false),
+			Types:   disabled,
+		})
+	}
+
+	return logging
+}
+
+func flattenEksEnabledLogTypes(logging *eks.Logging) *schema.Set {
+	enabled := make([]interface{}, 0)
+	if logging != nil {
+		for _, setup := range logging.ClusterLogging {
+			if setup == nil || !aws.BoolValue(setup.Enabled) {
+				continue
+			}
+			for _, t := range setup.Types {
+				enabled = append(enabled, aws.StringValue(t))
+			}
+		}
+	}
+
+	return schema.NewSet(schema.HashString, enabled)
+}
+
+func expandEksEncryptionConfig(configured []interface{}) []*eks.EncryptionConfig {
+	if len(configured) == 0 {
+		return nil
+	}
+
+	result := make([]*eks.EncryptionConfig, 0, len(configured))
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+
+		config := &eks.EncryptionConfig{
+			Resources: expandStringSet(data["resources"].(*schema.Set)),
+		}
+
+		if v, ok := data["provider"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			config.Provider = &eks.Provider{
+				KeyArn: aws.String(v[0].(map[string]interface{})["key_arn"].(string)),
+			}
+		}
+
+		result = append(result, config)
+	}
+
+	return result
+}
+
+func flattenEksEncryptionConfig(list []*eks.EncryptionConfig) []interface{} {
+	result := make([]interface{}, 0, len(list))
+	for _, config := range list {
+		m := map[string]interface{}{
+			"resources": schema.NewSet(schema.HashString, flattenStringList(config.Resources)),
+		}
+
+		if config.Provider != nil {
+			m["provider"] = []interface{}{
+				map[string]interface{}{
+					"key_arn": aws.StringValue(config.Provider.KeyArn),
+				},
+			}
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func flattenEksCertificate(certificate *eks.Certificate) []interface{} {
+	if certificate == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"data": aws.StringValue(certificate.Data),
+		},
+	}
+}
+
+func flattenEksIdentity(identity *eks.Identity) []interface{} {
+	if identity == nil || identity.Oidc == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"oidc": []interface{}{
+				map[string]interface{}{
+					"issuer": aws.StringValue(identity.Oidc.Issuer),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,577 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsEksNodeGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsEksNodeGroupCreate,
+		Read:   resourceAwsEksNodeGroupRead,
+		Update: resourceAwsEksNodeGroupUpdate,
+		Delete: resourceAwsEksNodeGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: setTagsAllDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateEksClusterName,
+			},
+
+			"node_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"node_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"subnet_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"scaling_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"max_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"min_size": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+
+			"ami_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					eks.AMITypesAl2X8664,
+					eks.AMITypesAl2X8664Gpu,
+				}, false),
+			},
+
+			"disk_size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"instance_types": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"release_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"remote_access": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ec2_ssh_key": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"source_security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"autoscaling_groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"remote_access_security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
+		},
+	}
+}
+
+func resourceAwsEksNodeGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).eksconn
+	clusterName := d.Get("cluster_name").(string)
+	nodeGroupName := d.Get("node_group_name").(string)
+
+	input := &eks.CreateNodegroupInput{
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		ClusterName:        aws.String(clusterName),
+		NodegroupName:      aws.String(nodeGroupName),
+		NodeRole:           aws.String(d.Get("node_role_arn").(string)),
+		Subnets:            expandStringSet(d.Get("subnet_ids").(*schema.Set)),
+		ScalingConfig:      expandEksNodegroupScalingConfig(d.Get("scaling_config").([]interface{})),
+		RemoteAccess:       expandEksRemoteAccessConfig(d.Get("remote_access").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("ami_type"); ok {
+		input.AmiType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("disk_size"); ok {
+		input.DiskSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("instance_types"); ok && len(v.([]interface{})) > 0 {
+		input.InstanceTypes = expandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("labels"); ok && len(v.(map[string]interface{})) > 0 {
+		input.Labels = stringMapToPointers(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("release_version"); ok {
+		input.ReleaseVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("version"); ok {
+		input.Version = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating EKS node group: %s", input)
+	if _, err := conn.CreateNodegroup(input); err != nil {
+		return fmt.Errorf("Error creating EKS node group (%s:%s): %s", clusterName, nodeGroupName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", clusterName, nodeGroupName))
+
+	stateConf := resource.StateChangeConf{
+		Pending: []string{eks.NodegroupStatusCreating},
+		Target:  []string{eks.NodegroupStatusActive},
+		Timeout: d.Timeout(schema.TimeoutCreate),
+		Refresh: eksNodeGroupStateRefreshFunc(conn, clusterName, nodeGroupName),
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EKS node group (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsEksNodeGroupRead(d, meta)
+}
+
+func resourceAwsEksNodeGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).eksconn
+
+	clusterName, nodeGroupName, err := resourceAwsEksNodeGroupParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	out, err := conn.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(clusterName),
+		NodegroupName: aws.String(nodeGroupName),
+	})
+	if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] EKS node group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading EKS node group (%s): %s", d.Id(), err)
+	}
+
+	nodeGroup := out.Nodegroup
+	if nodeGroup == nil {
+		log.Printf("[WARN] EKS node group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster_name", nodeGroup.ClusterName)
+	d.Set("node_group_name", nodeGroup.NodegroupName)
+	d.Set("node_role_arn", nodeGroup.NodeRole)
+	d.Set("arn", nodeGroup.NodegroupArn)
+	d.Set("ami_type", nodeGroup.AmiType)
+	d.Set("disk_size", nodeGroup.DiskSize)
+	d.Set("release_version", nodeGroup.ReleaseVersion)
+	d.Set("version", nodeGroup.Version)
+	d.Set("status", nodeGroup.Status)
+
+	if err := d.Set("subnet_ids", flattenStringList(nodeGroup.Subnets)); err != nil {
+		return fmt.Errorf("Error setting subnet_ids: %s", err)
+	}
+
+	if err := d.Set("instance_types", flattenStringList(nodeGroup.InstanceTypes)); err != nil {
+		return fmt.Errorf("Error setting instance_types: %s", err)
+	}
+
+	if err := d.Set("labels", aws.StringValueMap(nodeGroup.Labels)); err != nil {
+		return fmt.Errorf("Error setting labels: %s", err)
+	}
+
+	if err := d.Set("scaling_config", flattenEksNodegroupScalingConfig(nodeGroup.ScalingConfig)); err != nil {
+		return fmt.Errorf("Error setting scaling_config: %s", err)
+	}
+
+	if err := d.Set("remote_access", flattenEksRemoteAccessConfig(nodeGroup.RemoteAccess)); err != nil {
+		return fmt.Errorf("Error setting remote_access: %s", err)
+	}
+
+	if err := d.Set("resources", flattenEksNodegroupResources(nodeGroup.Resources)); err != nil {
+		return fmt.Errorf("Error setting resources: %s", err)
+	}
+
+	return setTagsAll(d, meta, tagsToMapGeneric(nodeGroup.Tags))
+}
+
+func resourceAwsEksNodeGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).eksconn
+
+	clusterName, nodeGroupName, err := resourceAwsEksNodeGroupParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("labels") || d.HasChange("scaling_config") {
+		input := &eks.UpdateNodegroupConfigInput{
+			ClientRequestToken: aws.String(resource.UniqueId()),
+			ClusterName:        aws.String(clusterName),
+			NodegroupName:      aws.String(nodeGroupName),
+		}
+
+		if d.HasChange("labels") {
+			o, n := d.GetChange("labels")
+			input.Labels = expandEksUpdateLabelsPayload(o.(map[string]interface{}), n.(map[string]interface{}))
+		}
+
+		if d.HasChange("scaling_config") {
+			input.ScalingConfig = expandEksNodegroupScalingConfig(d.Get("scaling_config").([]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating EKS node group (%s) config: %s", d.Id(), input)
+		out, err := conn.UpdateNodegroupConfig(input)
+		if err != nil {
+			return fmt.Errorf("Error updating EKS node group (%s) config: %s", d.Id(), err)
+		}
+
+		if err := waitForEksUpdate(conn, clusterName, nodeGroupName, aws.StringValue(out.Update.Id), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting for EKS node group (%s) config update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("release_version") || d.HasChange("version") {
+		input := &eks.UpdateNodegroupVersionInput{
+			ClientRequestToken: aws.String(resource.UniqueId()),
+			ClusterName:        aws.String(clusterName),
+			NodegroupName:      aws.String(nodeGroupName),
+		}
+
+		if v, ok := d.GetOk("release_version"); ok && d.HasChange("release_version") {
+			input.ReleaseVersion = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("version"); ok && d.HasChange("version") {
+			input.Version = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating EKS node group (%s) version: %s", d.Id(), input)
+		out, err := conn.UpdateNodegroupVersion(input)
+		if err != nil {
+			return fmt.Errorf("Error updating EKS node group (%s) version: %s", d.Id(), err)
+		}
+
+		if err := waitForEksUpdate(conn, clusterName, nodeGroupName, aws.StringValue(out.Update.Id), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Error waiting for EKS node group (%s) version update: %s", d.Id(), err)
+		}
+	}
+
+	if err := setTagsEKS(conn, d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("Error updating EKS node group (%s) tags: %s", d.Id(), err)
+	}
+
+	return resourceAwsEksNodeGroupRead(d, meta)
+}
+
+func resourceAwsEksNodeGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).eksconn
+
+	clusterName, nodeGroupName, err := resourceAwsEksNodeGroupParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting EKS node group %s", d.Id())
+	_, err = conn.DeleteNodegroup(&eks.DeleteNodegroupInput{
+		ClusterName:   aws.String(clusterName),
+		NodegroupName: aws.String(nodeGroupName),
+	})
+	if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error deleting EKS node group (%s): %s", d.Id(), err)
+	}
+
+	stateConf := resource.StateChangeConf{
+		Pending: []string{
+			eks.NodegroupStatusActive,
+			eks.NodegroupStatusDegraded,
+			eks.NodegroupStatusDeleting,
+		},
+		Target:  []string{""},
+		Timeout: d.Timeout(schema.TimeoutDelete),
+		Refresh: eksNodeGroupStateRefreshFunc(conn, clusterName, nodeGroupName),
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for EKS node group (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsEksNodeGroupParseId(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%q), expected CLUSTER-NAME:NODE-GROUP-NAME", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// eksNodeGroupStateRefreshFunc returns the node group status, or an empty
+// status once the node group no longer exists. Failed creations and
+// deletions report the health issues EKS recorded against the node group.
+func eksNodeGroupStateRefreshFunc(conn *eks.EKS, clusterName, nodeGroupName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := conn.DescribeNodegroup(&eks.DescribeNodegroupInput{
+			ClusterName:   aws.String(clusterName),
+			NodegroupName: aws.String(nodeGroupName),
+		})
+		if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
+			return "", "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if out.Nodegroup == nil {
+			return "", "", nil
+		}
+
+		status := aws.StringValue(out.Nodegroup.Status)
+		if status == eks.NodegroupStatusCreateFailed || status == eks.NodegroupStatusDeleteFailed {
+			var issues []string
+			if out.Nodegroup.Health != nil {
+				for _, issue := range out.Nodegroup.Health.Issues {
+					issues = append(issues, fmt.Sprintf("%s: %s", aws.StringValue(issue.Code), aws.StringValue(issue.Message)))
+				}
+			}
+			return out.Nodegroup, status, fmt.Errorf("EKS node group (%s:%s) entered %s state: %s",
+				clusterName, nodeGroupName, status, strings.Join(issues, "; "))
+		}
+
+		return out.Nodegroup, status, nil
+	}
+}
+
+func expandEksNodegroupScalingConfig(configured []interface{}) *eks.NodegroupScalingConfig {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+
+	return &eks.NodegroupScalingConfig{
+		DesiredSize: aws.Int64(int64(data["desired_size"].(int))),
+		MaxSize:     aws.Int64(int64(data["max_size"].(int))),
+		MinSize:     aws.Int64(int64(data["min_size"].(int))),
+	}
+}
+
+func flattenEksNodegroupScalingConfig(config *eks.NodegroupScalingConfig) []interface{} {
+	if config == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"desired_size": int(aws.Int64Value(config.DesiredSize)),
+			"max_size":     int(aws.Int64Value(config.MaxSize)),
+			"min_size":     int(aws.Int64Value(config.MinSize)),
+		},
+	}
+}
+
+func expandEksRemoteAccessConfig(configured []interface{}) *eks.RemoteAccessConfig {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+	config := &eks.RemoteAccessConfig{}
+
+	if v, ok := data["ec2_ssh_key"].(string); ok && v != "" {
+		config.Ec2SshKey = aws.String(v)
+	}
+
+	if v, ok := data["source_security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		config.SourceSecurityGroups = expandStringSet(v)
+	}
+
+	return config
+}
+
+func flattenEksRemoteAccessConfig(config *eks.RemoteAccessConfig) []interface{} {
+	if config == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"ec2_ssh_key":               aws.StringValue(config.Ec2SshKey),
+			"source_security_group_ids": schema.NewSet(schema.HashString, flattenStringList(config.SourceSecurityGroups)),
+		},
+	}
+}
+
+func flattenEksNodegroupResources(resources *eks.NodegroupResources) []interface{} {
+	if resources == nil {
+		return nil
+	}
+
+	groups := make([]interface{}, 0, len(resources.AutoScalingGroups))
+	for _, group := range resources.AutoScalingGroups {
+		groups = append(groups, map[string]interface{}{
+			"name": aws.StringValue(group.Name),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"autoscaling_groups":              groups,
+			"remote_access_security_group_id": aws.StringValue(resources.RemoteAccessSecurityGroup),
+		},
+	}
+}
+
+// expandEksUpdateLabelsPayload returns the labels to add or change and the
+// labels to remove to get from the old set of labels to the new one.
+func expandEksUpdateLabelsPayload(o, n map[string]interface{}) *eks.UpdateLabelsPayload {
+	payload := &eks.UpdateLabelsPayload{}
+
+	for k, v := range n {
+		if ov, ok := o[k]; !ok || ov != v {
+			if payload.AddOrUpdateLabels == nil {
+				payload.AddOrUpdateLabels = make(map[string]*string)
+			}
+			payload.AddOrUpdateLabels[k] = aws.String(v.(string))
+		}
+	}
+
+	for k := range o {
+		if _, ok := n[k]; !ok {
+			payload.RemoveLabels = append(payload.RemoveLabels, aws.String(k))
+		}
+	}
+
+	return payload
+}
@@ -0,0 +1,192 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSEksNodeGroup_basic(t *testing.T) {
+	var nodeGroup eks.Nodegroup
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+	resourceName := "aws_eks_node_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSEksNodeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSEksNodeGroupConfig(rName, 1, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksNodeGroupExists(resourceName, &nodeGroup),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%[1]s:%[1]s", rName)),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "ami_type", eks.AMITypesAl2X8664),
+					resource.TestCheckResourceAttrPair(resourceName, "cluster_name", "aws_eks_cluster.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "disk_size", "20"),
+					resource.TestCheckResourceAttr(resourceName, "instance_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "labels.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "labels.key", "value1"),
+					resource.TestCheckResourceAttrPair(resourceName, "node_role_arn", "aws_iam_role.node", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resources.0.autoscaling_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_config.0.desired_size", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_config.0.max_size", "2"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_config.0.min_size", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", eks.NodegroupStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "subnet_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "version", "aws_eks_cluster.test", "version"),
+				),
+			},
+			{
+				Config: testAccAWSEksNodeGroupConfig(rName, 2, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSEksNodeGroupExists(resourceName, &nodeGroup),
+					resource.TestCheckResourceAttr(resourceName, "labels.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "labels.key", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "scaling_config.0.desired_size", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSEksNodeGroupExists(n string, nodeGroup *eks.Nodegroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EKS node group ID is set")
+		}
+
+		clusterName, nodeGroupName, err := resourceAwsEksNodeGroupParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).eksconn
+		out, err := conn.DescribeNodegroup(&eks.DescribeNodegroupInput{
+			ClusterName:   aws.String(clusterName),
+			NodegroupName: aws.String(nodeGroupName),
+		})
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(out.Nodegroup.NodegroupName) != nodeGroupName {
+			return fmt.Errorf("EKS node group %s not found", rs.Primary.ID)
+		}
+
+		*nodeGroup = *out.Nodegroup
+
+		return nil
+	}
+}
+
+func testAccCheckAWSEksNodeGroupDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).eksconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_eks_node_group" {
+			continue
+		}
+
+		clusterName, nodeGroupName, err := resourceAwsEksNodeGroupParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.DescribeNodegroup(&eks.DescribeNodegroupInput{
+			ClusterName:   aws.String(clusterName),
+			NodegroupName: aws.String(nodeGroupName),
+		})
+		if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if out.Nodegroup != nil && aws.StringValue(out.Nodegroup.NodegroupName) == nodeGroupName {
+			return fmt.Errorf("EKS node group %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSEksNodeGroupConfig(rName string, desiredSize int, labelValue string) string {
+	return testAccAWSEksClusterConfig(rName, false, "") + fmt.Sprintf(`
+resource "aws_iam_role" "node" {
+  name = "%[1]s-node"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "node-AmazonEKSWorkerNodePolicy" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonEKSWorkerNodePolicy"
+  role       = "${aws_iam_role.node.name}"
+}
+
+resource "aws_iam_role_policy_attachment" "node-AmazonEKS_CNI_Policy" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonEKS_CNI_Policy"
+  role       = "${aws_iam_role.node.name}"
+}
+
+resource "aws_iam_role_policy_attachment" "node-AmazonEC2ContainerRegistryReadOnly" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonEC2ContainerRegistryReadOnly"
+  role       = "${aws_iam_role.node.name}"
+}
+
+resource "aws_eks_node_group" "test" {
+  cluster_name    = "${aws_eks_cluster.test.name}"
+  node_group_name = %[1]q
+  node_role_arn   = "${aws_iam_role.node.arn}"
+  subnet_ids      = ["${aws_subnet.test.*.id}"]
+
+  labels {
+    key = %[3]q
+  }
+
+  scaling_config {
+    desired_size = %[2]d
+    max_size     = 2
+    min_size     = 1
+  }
+
+  depends_on = [
+    "aws_iam_role_policy_attachment.node-AmazonEKSWorkerNodePolicy",
+    "aws_iam_role_policy_attachment.node-AmazonEKS_CNI_Policy",
+    "aws_iam_role_policy_attachment.node-AmazonEC2ContainerRegistryReadOnly",
+  ]
+}
+`, rName, desiredSize, labelValue)
+}
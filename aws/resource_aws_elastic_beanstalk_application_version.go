@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,10 @@ func resourceAwsElasticBeanstalkApplicationVersion() *schema.Resource {
 		Update: resourceAwsElasticBeanstalkApplicationVersionUpdate,
 		Delete: resourceAwsElasticBeanstalkApplicationVersionDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsElasticBeanstalkApplicationVersionImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"application": &schema.Schema{
 				Type:     schema.TypeString,
@@ -52,6 +57,19 @@ func resourceAwsElasticBeanstalkApplicationVersion() *schema.Resource {
 	}
 }
 
+func resourceAwsElasticBeanstalkApplicationVersionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected APPLICATION/VERSION-LABEL", d.Id())
+	}
+
+	d.Set("application", idParts[0])
+	d.Set("name", idParts[1])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsElasticBeanstalkApplicationVersionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticbeanstalkconn
 
@@ -107,7 +125,16 @@ func resourceAwsElasticBeanstalkApplicationVersionRead(d *schema.ResourceData, m
 			len(resp.ApplicationVersions), d.Id())
 	}
 
-	if err := d.Set("description", resp.ApplicationVersions[0].Description); err != nil {
+	version := resp.ApplicationVersions[0]
+
+	d.Set("application", version.ApplicationName)
+	d.Set("name", version.VersionLabel)
+	if version.SourceBundle != nil {
+		d.Set("bucket", version.SourceBundle.S3Bucket)
+		d.Set("key", version.SourceBundle.S3Key)
+	}
+
+	if err := d.Set("description", version.Description); err != nil {
 		return err
 	}
 
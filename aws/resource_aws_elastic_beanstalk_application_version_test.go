@@ -27,6 +27,14 @@ func TestAccAWSBeanstalkAppVersion_basic(t *testing.T) {
 					testAccCheckApplicationVersionExists("aws_elastic_beanstalk_application_version.default", &appVersion),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:            "aws_elastic_beanstalk_application_version.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_delete"},
+				ImportStateIdFunc:       testAccBeanstalkAppVersionImportStateIdFunc("aws_elastic_beanstalk_application_version.default"),
+			},
 		},
 	})
 }
@@ -179,3 +187,14 @@ resource "aws_elastic_beanstalk_application_version" "second" {
 }
  `, randInt, randInt, randInt, randInt, randInt)
 }
+
+func testAccBeanstalkAppVersionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["application"], rs.Primary.ID), nil
+	}
+}
@@ -19,6 +19,10 @@ func resourceAwsElasticBeanstalkConfigurationTemplate() *schema.Resource {
 		Update: resourceAwsElasticBeanstalkConfigurationTemplateUpdate,
 		Delete: resourceAwsElasticBeanstalkConfigurationTemplateDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsElasticBeanstalkConfigurationTemplateImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -55,6 +59,19 @@ func resourceAwsElasticBeanstalkConfigurationTemplate() *schema.Resource {
 	}
 }
 
+func resourceAwsElasticBeanstalkConfigurationTemplateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected APPLICATION/TEMPLATE-NAME", d.Id())
+	}
+
+	d.Set("application", idParts[0])
+	d.Set("name", idParts[1])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsElasticBeanstalkConfigurationTemplateCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticbeanstalkconn
 
@@ -122,7 +139,10 @@ func resourceAwsElasticBeanstalkConfigurationTemplateRead(d *schema.ResourceData
 		return fmt.Errorf("Error reading application properties: found %d applications, expected 1", len(resp.ConfigurationSettings))
 	}
 
+	d.Set("application", resp.ConfigurationSettings[0].ApplicationName)
+	d.Set("name", resp.ConfigurationSettings[0].TemplateName)
 	d.Set("description", resp.ConfigurationSettings[0].Description)
+	d.Set("solution_stack_name", resp.ConfigurationSettings[0].SolutionStackName)
 	return nil
 }
 
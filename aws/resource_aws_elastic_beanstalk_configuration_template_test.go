@@ -26,6 +26,14 @@ func TestAccAWSBeanstalkConfigurationTemplate_basic(t *testing.T) {
 					testAccCheckBeanstalkConfigurationTemplateExists("aws_elastic_beanstalk_configuration_template.tf_template", &config),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:            "aws_elastic_beanstalk_configuration_template.tf_template",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"setting", "environment_id"},
+				ImportStateIdFunc:       testAccBeanstalkConfigurationTemplateImportStateIdFunc("aws_elastic_beanstalk_configuration_template.tf_template"),
+			},
 		},
 	})
 }
@@ -223,3 +231,14 @@ resource "aws_elastic_beanstalk_configuration_template" "tf_template" {
 }
 `, name, name)
 }
+
+func testAccBeanstalkConfigurationTemplateImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["application"], rs.Primary.ID), nil
+	}
+}
@@ -43,10 +43,11 @@ func resourceAwsElasticBeanstalkOptionSetting() *schema.Resource {
 
 func resourceAwsElasticBeanstalkEnvironment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsElasticBeanstalkEnvironmentCreate,
-		Read:   resourceAwsElasticBeanstalkEnvironmentRead,
-		Update: resourceAwsElasticBeanstalkEnvironmentUpdate,
-		Delete: resourceAwsElasticBeanstalkEnvironmentDelete,
+		Create:        resourceAwsElasticBeanstalkEnvironmentCreate,
+		Read:          resourceAwsElasticBeanstalkEnvironmentRead,
+		Update:        resourceAwsElasticBeanstalkEnvironmentUpdate,
+		Delete:        resourceAwsElasticBeanstalkEnvironmentDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -188,7 +189,8 @@ func resourceAwsElasticBeanstalkEnvironment() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -214,7 +216,7 @@ func resourceAwsElasticBeanstalkEnvironmentCreate(d *schema.ResourceData, meta i
 		EnvironmentName: aws.String(name),
 		ApplicationName: aws.String(app),
 		OptionSettings:  extractOptionSettings(settings),
-		Tags:            tagsFromMapBeanstalk(d.Get("tags").(map[string]interface{})),
+		Tags:            tagsFromMapBeanstalk(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	if desc != "" {
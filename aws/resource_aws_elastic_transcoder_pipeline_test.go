@@ -31,6 +31,12 @@ func TestAccAWSElasticTranscoderPipeline_basic(t *testing.T) {
 					testAccCheckAWSElasticTranscoderPipelineExists("aws_elastictranscoder_pipeline.bar", pipeline),
 				),
 			},
+
+			{
+				ResourceName:      "aws_elastictranscoder_pipeline.bar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -76,6 +76,12 @@ func TestAccAWSElasticTranscoderPreset_basic(t *testing.T) {
 					checkExists(true),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:      "aws_elastictranscoder_preset.bar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
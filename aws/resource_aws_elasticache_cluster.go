@@ -128,7 +128,8 @@ func resourceAwsElastiCacheCommonSchema() map[string]*schema.Schema {
 			Computed: true,
 		},
 
-		"tags": tagsSchema(),
+		"tags":     tagsSchema(),
+		"tags_all": tagsSchemaAll(),
 	}
 }
 
@@ -207,10 +208,11 @@ func resourceAwsElasticacheCluster() *schema.Resource {
 	}
 
 	return &schema.Resource{
-		Create: resourceAwsElasticacheClusterCreate,
-		Read:   resourceAwsElasticacheClusterRead,
-		Update: resourceAwsElasticacheClusterUpdate,
-		Delete: resourceAwsElasticacheClusterDelete,
+		Create:        resourceAwsElasticacheClusterCreate,
+		Read:          resourceAwsElasticacheClusterRead,
+		Update:        resourceAwsElasticacheClusterUpdate,
+		Delete:        resourceAwsElasticacheClusterDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -234,7 +236,7 @@ func resourceAwsElasticacheClusterCreate(d *schema.ResourceData, meta interface{
 
 	securityNames := expandStringList(securityNameSet.List())
 	securityIds := expandStringList(securityIdSet.List())
-	tags := tagsFromMapEC(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapEC(d.Get("tags_all").(map[string]interface{}))
 
 	req := &elasticache.CreateCacheClusterInput{
 		CacheClusterId:          aws.String(clusterId),
@@ -406,7 +408,7 @@ func resourceAwsElasticacheClusterRead(d *schema.ResourceData, meta interface{})
 			if len(resp.TagList) > 0 {
 				et = resp.TagList
 			}
-			d.Set("tags", tagsToMapEC(et))
+			setTagsAll(d, meta, tagsToMapEC(et))
 		}
 	}
 
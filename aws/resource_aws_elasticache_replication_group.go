@@ -85,10 +85,11 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 	resourceSchema["engine"].ValidateFunc = validateAwsElastiCacheReplicationGroupEngine
 
 	return &schema.Resource{
-		Create: resourceAwsElasticacheReplicationGroupCreate,
-		Read:   resourceAwsElasticacheReplicationGroupRead,
-		Update: resourceAwsElasticacheReplicationGroupUpdate,
-		Delete: resourceAwsElasticacheReplicationGroupDelete,
+		Create:        resourceAwsElasticacheReplicationGroupCreate,
+		Read:          resourceAwsElasticacheReplicationGroupRead,
+		Update:        resourceAwsElasticacheReplicationGroupUpdate,
+		Delete:        resourceAwsElasticacheReplicationGroupDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -100,7 +101,7 @@ func resourceAwsElasticacheReplicationGroup() *schema.Resource {
 func resourceAwsElasticacheReplicationGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
 
-	tags := tagsFromMapEC(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapEC(d.Get("tags_all").(map[string]interface{}))
 	params := &elasticache.CreateReplicationGroupInput{
 		ReplicationGroupId:          aws.String(d.Get("replication_group_id").(string)),
 		ReplicationGroupDescription: aws.String(d.Get("replication_group_description").(string)),
@@ -18,6 +18,10 @@ func resourceAwsElasticacheSecurityGroup() *schema.Resource {
 		Read:   resourceAwsElasticacheSecurityGroupRead,
 		Delete: resourceAwsElasticacheSecurityGroupDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsElasticacheSecurityGroupImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"description": &schema.Schema{
 				Type:     schema.TypeString,
@@ -41,6 +45,12 @@ func resourceAwsElasticacheSecurityGroup() *schema.Resource {
 	}
 }
 
+func resourceAwsElasticacheSecurityGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsElasticacheSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).elasticacheconn
 
@@ -111,6 +121,12 @@ func resourceAwsElasticacheSecurityGroupRead(d *schema.ResourceData, meta interf
 	d.Set("name", group.CacheSecurityGroupName)
 	d.Set("description", group.Description)
 
+	sgNames := make([]string, 0, len(group.EC2SecurityGroups))
+	for _, sg := range group.EC2SecurityGroups {
+		sgNames = append(sgNames, *sg.EC2SecurityGroupName)
+	}
+	d.Set("security_group_names", sgNames)
+
 	return nil
 }
 
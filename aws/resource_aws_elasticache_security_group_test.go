@@ -26,6 +26,12 @@ func TestAccAWSElasticacheSecurityGroup_basic(t *testing.T) {
 						"aws_elasticache_security_group.bar", "description", "Managed by Terraform"),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:      "aws_elasticache_security_group.bar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -17,10 +17,11 @@ import (
 
 func resourceAwsElasticSearchDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsElasticSearchDomainCreate,
-		Read:   resourceAwsElasticSearchDomainRead,
-		Update: resourceAwsElasticSearchDomainUpdate,
-		Delete: resourceAwsElasticSearchDomainDelete,
+		Create:        resourceAwsElasticSearchDomainCreate,
+		Read:          resourceAwsElasticSearchDomainRead,
+		Update:        resourceAwsElasticSearchDomainUpdate,
+		Delete:        resourceAwsElasticSearchDomainDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsElasticSearchDomainImport,
 		},
@@ -176,7 +177,8 @@ func resourceAwsElasticSearchDomain() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -339,14 +341,15 @@ func resourceAwsElasticSearchDomainCreate(d *schema.ResourceData, meta interface
 	// This should mean that if the creation fails (eg because your token expired
 	// whilst the operation is being performed), we still get the required tags on
 	// the resources.
-	tags := tagsFromMapElasticsearchService(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapElasticsearchService(d.Get("tags_all").(map[string]interface{}))
 
 	if err := setTagsElasticsearchService(conn, d, *out.DomainStatus.ARN); err != nil {
 		return err
 	}
 
-	d.Set("tags", tagsToMapElasticsearchService(tags))
+	d.Set("tags_all", tagsToMapElasticsearchService(tags))
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	log.Printf("[DEBUG] Waiting for ElasticSearch domain %q to be created", d.Id())
 	err = waitForElasticSearchDomainCreation(conn, d.Get("domain_name").(string), d.Id())
@@ -462,7 +465,7 @@ func resourceAwsElasticSearchDomainRead(d *schema.ResourceData, meta interface{}
 		est = listOut.TagList
 	}
 
-	d.Set("tags", tagsToMapElasticsearchService(est))
+	setTagsAll(d, meta, tagsToMapElasticsearchService(est))
 
 	return nil
 }
@@ -477,6 +480,7 @@ func resourceAwsElasticSearchDomainUpdate(d *schema.ResourceData, meta interface
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	input := elasticsearch.UpdateElasticsearchDomainConfigInput{
 		DomainName: aws.String(d.Get("domain_name").(string)),
@@ -19,6 +19,10 @@ func resourceAwsElasticSearchDomainPolicy() *schema.Resource {
 		Update: resourceAwsElasticSearchDomainPolicyUpsert,
 		Delete: resourceAwsElasticSearchDomainPolicyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsElasticSearchDomainPolicyImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Type:     schema.TypeString,
@@ -33,6 +37,13 @@ func resourceAwsElasticSearchDomainPolicy() *schema.Resource {
 	}
 }
 
+func resourceAwsElasticSearchDomainPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("domain_name", d.Id())
+	d.SetId("esd-policy-" + d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsElasticSearchDomainPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).esconn
 	name := d.Get("domain_name").(string)
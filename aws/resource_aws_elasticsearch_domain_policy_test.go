@@ -65,6 +65,14 @@ func TestAccAWSElasticSearchDomainPolicy_basic(t *testing.T) {
 					},
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:            "aws_elasticsearch_domain_policy.main",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_policies"},
+				ImportStateIdFunc:       testAccESDomainPolicyImportStateIdFunc("aws_elasticsearch_domain_policy.main"),
+			},
 		},
 	})
 }
@@ -102,3 +110,14 @@ POLICIES
 }
 `, randInt, policy)
 }
+
+func testAccESDomainPolicyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["domain_name"], nil
+	}
+}
@@ -21,10 +21,11 @@ import (
 
 func resourceAwsElb() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsElbCreate,
-		Read:   resourceAwsElbRead,
-		Update: resourceAwsElbUpdate,
-		Delete: resourceAwsElbDelete,
+		Create:        resourceAwsElbCreate,
+		Read:          resourceAwsElbRead,
+		Update:        resourceAwsElbUpdate,
+		Delete:        resourceAwsElbDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -253,7 +254,8 @@ func resourceAwsElb() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -279,7 +281,7 @@ func resourceAwsElbCreate(d *schema.ResourceData, meta interface{}) error {
 		d.Set("name", elbName)
 	}
 
-	tags := tagsFromMapELB(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapELB(d.Get("tags_all").(map[string]interface{}))
 	// Provision the elb
 	elbOpts := &elb.CreateLoadBalancerInput{
 		LoadBalancerName: aws.String(elbName),
@@ -337,7 +339,7 @@ func resourceAwsElbCreate(d *schema.ResourceData, meta interface{}) error {
 	d.SetPartial("security_groups")
 	d.SetPartial("subnets")
 
-	d.Set("tags", tagsToMapELB(tags))
+	d.Set("tags_all", tagsToMapELB(tags))
 
 	return resourceAwsElbUpdate(d, meta)
 }
@@ -464,7 +466,7 @@ func resourceAwsElbRead(d *schema.ResourceData, meta interface{}) error {
 	if len(resp.TagDescriptions) > 0 {
 		et = resp.TagDescriptions[0].Tags
 	}
-	d.Set("tags", tagsToMapELB(et))
+	setTagsAll(d, meta, tagsToMapELB(et))
 
 	// There's only one health check, so save that to state as we
 	// currently can
@@ -800,6 +802,7 @@ func resourceAwsElbUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 	d.Partial(false)
 
 	return resourceAwsElbRead(d, meta)
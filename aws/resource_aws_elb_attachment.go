@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elb"
@@ -16,6 +17,10 @@ func resourceAwsElbAttachment() *schema.Resource {
 		Read:   resourceAwsElbAttachmentRead,
 		Delete: resourceAwsElbAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsElbAttachmentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"elb": &schema.Schema{
 				Type:     schema.TypeString,
@@ -32,6 +37,19 @@ func resourceAwsElbAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsElbAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected ELB-NAME/INSTANCE-ID", d.Id())
+	}
+
+	d.Set("elb", idParts[0])
+	d.Set("instance", idParts[1])
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", idParts[0])))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsElbAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbconn
 	elbName := d.Get("elb").(string)
@@ -36,6 +36,14 @@ func TestAccAWSELBAttachment_basic(t *testing.T) {
 				),
 			},
 
+			// the attachment ID is generated, so the imported resource
+			// cannot be matched up for verification
+			resource.TestStep{
+				ResourceName:      "aws_elb_attachment.foo1",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSELBAttachmentImportStateIdFunc("aws_elb_attachment.foo1"),
+			},
+
 			resource.TestStep{
 				Config: testAccAWSELBAttachmentConfig2,
 				Check: resource.ComposeTestCheckFunc(
@@ -230,3 +238,14 @@ resource "aws_elb" "bar" {
   }
 }
 `
+
+func testAccAWSELBAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["elb"], rs.Primary.Attributes["instance"]), nil
+	}
+}
@@ -19,10 +19,16 @@ import (
 
 func resourceAwsEMRCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsEMRClusterCreate,
-		Read:   resourceAwsEMRClusterRead,
-		Update: resourceAwsEMRClusterUpdate,
-		Delete: resourceAwsEMRClusterDelete,
+		Create:        resourceAwsEMRClusterCreate,
+		Read:          resourceAwsEMRClusterRead,
+		Update:        resourceAwsEMRClusterUpdate,
+		Delete:        resourceAwsEMRClusterDelete,
+		CustomizeDiff: setTagsAllDiff,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -49,6 +55,7 @@ func resourceAwsEMRCluster() *schema.Resource {
 			"core_instance_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
+				Computed: true,
 			},
 			"cluster_state": {
 				Type:     schema.TypeString,
@@ -214,7 +221,8 @@ func resourceAwsEMRCluster() *schema.Resource {
 					},
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 			"configurations": {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -366,7 +374,7 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 		bootstrapActions := v.(*schema.Set).List()
 		params.BootstrapActions = expandBootstrapActions(bootstrapActions)
 	}
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		tagsIn := v.(map[string]interface{})
 		params.Tags = expandTags(tagsIn)
 	}
@@ -443,10 +451,20 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	instanceGroups, err := fetchAllEMRInstanceGroups(emrconn, d.Id())
 	if err == nil {
+		masterGroup := findGroup(instanceGroups, "MASTER")
+		if masterGroup != nil {
+			d.Set("master_instance_type", masterGroup.InstanceType)
+		}
+
+		// core_instance_count is the total number of instances in the
+		// cluster, including the master node
+		coreInstanceCount := int64(1)
 		coreGroup := findGroup(instanceGroups, "CORE")
 		if coreGroup != nil {
 			d.Set("core_instance_type", coreGroup.InstanceType)
+			coreInstanceCount += aws.Int64Value(coreGroup.RequestedInstanceCount)
 		}
+		d.Set("core_instance_count", coreInstanceCount)
 		if err := d.Set("instance_group", flattenInstanceGroups(instanceGroups)); err != nil {
 			log.Printf("[ERR] Error setting EMR instance groups: %s", err)
 		}
@@ -460,7 +478,11 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("log_uri", cluster.LogUri)
 	d.Set("master_public_dns", cluster.MasterPublicDnsName)
 	d.Set("visible_to_all_users", cluster.VisibleToAllUsers)
-	d.Set("tags", tagsToMapEMR(cluster.Tags))
+	d.Set("termination_protection", cluster.TerminationProtected)
+	if cluster.AutoTerminate != nil {
+		d.Set("keep_job_flow_alive_when_no_steps", !*cluster.AutoTerminate)
+	}
+	setTagsAll(d, meta, tagsToMapEMR(cluster.Tags))
 	d.Set("ebs_root_volume_size", cluster.EbsRootVolumeSize)
 
 	if err := d.Set("applications", flattenApplications(cluster.Applications)); err != nil {
@@ -572,6 +594,7 @@ func resourceAwsEMRClusterUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -772,6 +795,9 @@ func findGroup(grps []*emr.InstanceGroup, typ string) *emr.InstanceGroup {
 func expandTags(m map[string]interface{}) []*emr.Tag {
 	var result []*emr.Tag
 	for k, v := range m {
+		if tagIgnoredKey(k) {
+			continue
+		}
 		result = append(result, &emr.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
@@ -784,7 +810,9 @@ func expandTags(m map[string]interface{}) []*emr.Tag {
 func tagsToMapEMR(ts []*emr.Tag) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		result[*t.Key] = *t.Value
+		if !tagIgnoredKey(*t.Key) {
+			result[*t.Key] = *t.Value
+		}
 	}
 
 	return result
@@ -811,8 +839,8 @@ func diffTagsEMR(oldTags, newTags []*emr.Tag) ([]*emr.Tag, []*emr.Tag) {
 }
 
 func setTagsEMR(conn *emr.EMR, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsEMR(expandTags(o), expandTags(n))
@@ -26,6 +26,13 @@ func TestAccAWSEMRCluster_basic(t *testing.T) {
 				Config: testAccAWSEmrClusterConfig(r),
 				Check:  testAccCheckAWSEmrClusterExists("aws_emr_cluster.tf-test-cluster", &cluster),
 			},
+
+			{
+				ResourceName:            "aws_emr_cluster.tf-test-cluster",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"configurations"},
+			},
 		},
 	})
 }
@@ -3,6 +3,7 @@ package aws
 import (
 	"errors"
 	"log"
+	"strings"
 	"time"
 
 	"fmt"
@@ -21,6 +22,11 @@ func resourceAwsEMRInstanceGroup() *schema.Resource {
 		Read:   resourceAwsEMRInstanceGroupRead,
 		Update: resourceAwsEMRInstanceGroupUpdate,
 		Delete: resourceAwsEMRInstanceGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEMRInstanceGroupImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -85,6 +91,18 @@ func resourceAwsEMRInstanceGroup() *schema.Resource {
 	}
 }
 
+func resourceAwsEMRInstanceGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected CLUSTER-ID/INSTANCE-GROUP-ID", d.Id())
+	}
+
+	d.Set("cluster_id", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // Populates an emr.EbsConfiguration struct
 func readEmrEBSConfig(d *schema.ResourceData) *emr.EbsConfiguration {
 	result := &emr.EbsConfiguration{}
@@ -25,6 +25,14 @@ func TestAccAWSEMRInstanceGroup_basic(t *testing.T) {
 				Config: testAccAWSEmrInstanceGroupConfig(rInt),
 				Check:  testAccCheckAWSEmrInstanceGroupExists("aws_emr_instance_group.task", &ig),
 			},
+
+			{
+				ResourceName:            "aws_emr_instance_group.task",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ebs_config", "ebs_optimized"},
+				ImportStateIdFunc:       testAccAWSEMRInstanceGroupImportStateIdFunc("aws_emr_instance_group.task"),
+			},
 		},
 	})
 }
@@ -430,3 +438,14 @@ func testAccAWSEmrInstanceGroupConfig_ebsBasic(r int) string {
   }
 	`, r, r, r, r, r, r)
 }
+
+func testAccAWSEMRInstanceGroupImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["cluster_id"], rs.Primary.ID), nil
+	}
+}
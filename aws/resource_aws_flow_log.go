@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsFlowLog() *schema.Resource {
@@ -22,16 +23,53 @@ func resourceAwsFlowLog() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"iam_role_arn": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"log_destination": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  validateArn,
+				ConflictsWith: []string{"log_group_name"},
+			},
+
+			"log_destination_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
 				ForceNew: true,
+				Default:  ec2.LogDestinationTypeCloudWatchLogs,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.LogDestinationTypeCloudWatchLogs,
+					ec2.LogDestinationTypeS3,
+				}, false),
 			},
 
 			"log_group_name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"log_destination"},
+			},
+
+			"log_format": &schema.Schema{
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
+			"max_aggregation_interval": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      600,
+				ValidateFunc: validateIntegerInSlice([]int{60, 600}),
+			},
+
 			"vpc_id": &schema.Schema{
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -88,12 +126,39 @@ func resourceAwsLogFlowCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error: Flow Logs require either a VPC, Subnet, or ENI ID")
 	}
 
+	logDestinationType := d.Get("log_destination_type").(string)
+
 	opts := &ec2.CreateFlowLogsInput{
-		DeliverLogsPermissionArn: aws.String(d.Get("iam_role_arn").(string)),
-		LogGroupName:             aws.String(d.Get("log_group_name").(string)),
-		ResourceIds:              []*string{aws.String(resourceId)},
-		ResourceType:             aws.String(resourceType),
-		TrafficType:              aws.String(d.Get("traffic_type").(string)),
+		LogDestinationType:     aws.String(logDestinationType),
+		MaxAggregationInterval: aws.Int64(int64(d.Get("max_aggregation_interval").(int))),
+		ResourceIds:            []*string{aws.String(resourceId)},
+		ResourceType:           aws.String(resourceType),
+		TrafficType:            aws.String(d.Get("traffic_type").(string)),
+	}
+
+	if v, ok := d.GetOk("iam_role_arn"); ok {
+		opts.DeliverLogsPermissionArn = aws.String(v.(string))
+	} else if logDestinationType == ec2.LogDestinationTypeCloudWatchLogs {
+		return fmt.Errorf("Error: Flow Logs delivered to CloudWatch Logs require an iam_role_arn")
+	}
+
+	if v, ok := d.GetOk("log_destination"); ok {
+		opts.LogDestination = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("log_group_name"); ok {
+		if logDestinationType != ec2.LogDestinationTypeCloudWatchLogs {
+			return fmt.Errorf("Error: log_group_name can only be used with a log_destination_type of %q", ec2.LogDestinationTypeCloudWatchLogs)
+		}
+		opts.LogGroupName = aws.String(v.(string))
+	}
+
+	if opts.LogDestination == nil && opts.LogGroupName == nil {
+		return fmt.Errorf("Error: Flow Logs require either a log_destination or a log_group_name")
+	}
+
+	if v, ok := d.GetOk("log_format"); ok {
+		opts.LogFormat = aws.String(v.(string))
 	}
 
 	log.Printf(
@@ -103,8 +168,16 @@ func resourceAwsLogFlowCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating Flow Log for (%s), error: %s", resourceId, err)
 	}
 
-	if len(resp.FlowLogIds) > 1 {
-		return fmt.Errorf("Error: multiple Flow Logs created for (%s)", resourceId)
+	// Delivery errors, such as a missing bucket, are only reported in the
+	// unsuccessful items of the response.
+	for _, item := range resp.Unsuccessful {
+		if item.Error != nil {
+			return fmt.Errorf("Error creating Flow Log for (%s), error: %s", resourceId, aws.StringValue(item.Error.Message))
+		}
+	}
+
+	if len(resp.FlowLogIds) != 1 {
+		return fmt.Errorf("Error: %d Flow Logs created for (%s)", len(resp.FlowLogIds), resourceId)
 	}
 
 	d.SetId(*resp.FlowLogIds[0])
@@ -121,9 +194,12 @@ func resourceAwsLogFlowRead(d *schema.ResourceData, meta interface{}) error {
 
 	resp, err := conn.DescribeFlowLogs(opts)
 	if err != nil {
-		log.Printf("[WARN] Error describing Flow Logs for id (%s)", d.Id())
-		d.SetId("")
-		return nil
+		if isAWSErr(err, "InvalidFlowLogId.NotFound", "") {
+			log.Printf("[WARN] Flow Log (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error describing Flow Log (%s): %s", d.Id(), err)
 	}
 
 	if len(resp.FlowLogs) == 0 {
@@ -136,6 +212,10 @@ func resourceAwsLogFlowRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("traffic_type", fl.TrafficType)
 	d.Set("log_group_name", fl.LogGroupName)
 	d.Set("iam_role_arn", fl.DeliverLogsPermissionArn)
+	d.Set("log_destination", fl.LogDestination)
+	d.Set("log_destination_type", fl.LogDestinationType)
+	d.Set("log_format", fl.LogFormat)
+	d.Set("max_aggregation_interval", fl.MaxAggregationInterval)
 
 	var resourceKey string
 	if strings.HasPrefix(*fl.ResourceId, "vpc-") {
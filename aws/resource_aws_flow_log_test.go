@@ -55,6 +55,39 @@ func TestAccAWSFlowLog_subnet(t *testing.T) {
 	})
 }
 
+func TestAccAWSFlowLog_S3(t *testing.T) {
+	var flowLog ec2.FlowLog
+
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_flow_log.test_flow_log_s3",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckFlowLogDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFlowLogConfig_S3(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowLogExists("aws_flow_log.test_flow_log_s3", &flowLog),
+					testAccCheckAWSFlowLogAttributes(&flowLog),
+					resource.TestCheckResourceAttr("aws_flow_log.test_flow_log_s3", "log_destination_type", "s3"),
+					resource.TestCheckResourceAttrPair("aws_flow_log.test_flow_log_s3", "log_destination", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr("aws_flow_log.test_flow_log_s3", "iam_role_arn", ""),
+					resource.TestCheckResourceAttr("aws_flow_log.test_flow_log_s3", "log_group_name", ""),
+					resource.TestCheckResourceAttr("aws_flow_log.test_flow_log_s3", "log_format", "${version} ${vpc-id} ${subnet-id}"),
+					resource.TestCheckResourceAttr("aws_flow_log.test_flow_log_s3", "max_aggregation_interval", "60"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "aws_flow_log.test_flow_log_s3",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckFlowLogExists(n string, flowLog *ec2.FlowLog) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -218,3 +251,28 @@ resource "aws_flow_log" "test_flow_log_subnet" {
 }
 `, rInt, rInt)
 }
+
+func testAccFlowLogConfig_S3(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "default" {
+        cidr_block = "10.0.0.0/16"
+        tags {
+                Name = "tf-flow-log-test"
+        }
+}
+
+resource "aws_s3_bucket" "test" {
+        bucket = "tf-test-fl-%d"
+        force_destroy = true
+}
+
+resource "aws_flow_log" "test_flow_log_s3" {
+        log_destination = "${aws_s3_bucket.test.arn}"
+        log_destination_type = "s3"
+        log_format = "$${version} $${vpc-id} $${subnet-id}"
+        max_aggregation_interval = 60
+        vpc_id = "${aws_vpc.default.id}"
+        traffic_type = "ALL"
+}
+`, rInt)
+}
@@ -16,10 +16,11 @@ import (
 
 func resourceAwsGlacierVault() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsGlacierVaultCreate,
-		Read:   resourceAwsGlacierVaultRead,
-		Update: resourceAwsGlacierVaultUpdate,
-		Delete: resourceAwsGlacierVaultDelete,
+		Create:        resourceAwsGlacierVaultCreate,
+		Read:          resourceAwsGlacierVaultRead,
+		Update:        resourceAwsGlacierVaultUpdate,
+		Delete:        resourceAwsGlacierVaultDelete,
+		CustomizeDiff: setTagsAllDiff,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -83,7 +84,8 @@ func resourceAwsGlacierVault() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -154,7 +156,7 @@ func resourceAwsGlacierVaultRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return err
 	}
-	d.Set("tags", tags)
+	setTagsAll(d, meta, tags)
 
 	log.Printf("[DEBUG] Getting the access_policy for Vault %s", d.Id())
 	pol, err := glacierconn.GetVaultAccessPolicy(&glacier.GetVaultAccessPolicyInput{
@@ -272,8 +274,8 @@ func resourceAwsGlacierVaultPolicyUpdate(glacierconn *glacier.Glacier, d *schema
 }
 
 func setGlacierVaultTags(conn *glacier.Glacier, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffGlacierVaultTags(mapGlacierVaultTags(o), mapGlacierVaultTags(n))
@@ -356,7 +358,9 @@ func getGlacierVaultTags(glacierconn *glacier.Glacier, vaultName string) (map[st
 func glacierVaultTagsToMap(responseTags map[string]*string) map[string]string {
 	results := make(map[string]string, len(responseTags))
 	for k, v := range responseTags {
-		results[k] = *v
+		if !tagIgnoredKey(k) {
+			results[k] = *v
+		}
 	}
 
 	return results
@@ -20,6 +20,10 @@ func resourceAwsIamAccessKey() *schema.Resource {
 		Read:   resourceAwsIamAccessKeyRead,
 		Delete: resourceAwsIamAccessKeyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamAccessKeyImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"user": &schema.Schema{
 				Type:     schema.TypeString,
@@ -56,6 +60,21 @@ func resourceAwsIamAccessKey() *schema.Resource {
 	}
 }
 
+func resourceAwsIamAccessKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iamconn := meta.(*AWSClient).iamconn
+
+	resp, err := iamconn.GetAccessKeyLastUsed(&iam.GetAccessKeyLastUsedInput{
+		AccessKeyId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error finding the owner of IAM access key %s: %s", d.Id(), err)
+	}
+
+	d.Set("user", resp.UserName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	iamconn := meta.(*AWSClient).iamconn
 
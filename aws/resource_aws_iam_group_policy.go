@@ -22,6 +22,10 @@ func resourceAwsIamGroupPolicy() *schema.Resource {
 		Read:   resourceAwsIamGroupPolicyRead,
 		Delete: resourceAwsIamGroupPolicyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamGroupPolicyImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"policy": &schema.Schema{
 				Type:     schema.TypeString,
@@ -48,6 +52,15 @@ func resourceAwsIamGroupPolicy() *schema.Resource {
 	}
 }
 
+func resourceAwsIamGroupPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), ":", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected GROUP-NAME:POLICY-NAME", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamGroupPolicyPut(d *schema.ResourceData, meta interface{}) error {
 	iamconn := meta.(*AWSClient).iamconn
 
@@ -102,6 +115,9 @@ func resourceAwsIamGroupPolicyRead(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		return err
 	}
+
+	d.Set("group", group)
+	d.Set("name", name)
 	return d.Set("policy", policy)
 }
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -17,6 +18,10 @@ func resourceAwsIamGroupPolicyAttachment() *schema.Resource {
 		Read:   resourceAwsIamGroupPolicyAttachmentRead,
 		Delete: resourceAwsIamGroupPolicyAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamGroupPolicyAttachmentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"group": &schema.Schema{
 				Type:     schema.TypeString,
@@ -32,6 +37,22 @@ func resourceAwsIamGroupPolicyAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsIamGroupPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// IAM names cannot contain "/", so everything after the first one is the
+	// policy ARN
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected GROUP-NAME/POLICY-ARN", d.Id())
+	}
+
+	groupName := idParts[0]
+	d.Set("group", groupName)
+	d.Set("policy_arn", idParts[1])
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", groupName)))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamGroupPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).iamconn
 
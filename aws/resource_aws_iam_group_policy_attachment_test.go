@@ -26,6 +26,13 @@ func TestAccAWSIamGroupPolicyAttachment_basic(t *testing.T) {
 					testAccCheckAWSGroupPolicyAttachmentAttributes([]string{"test-policy"}, &out),
 				),
 			},
+			// the attachment ID is generated, so the imported resource
+			// cannot be matched up for verification
+			resource.TestStep{
+				ResourceName:      "aws_iam_group_policy_attachment.test-attach",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSGroupPolicyAttachmentImportStateIdFunc("aws_iam_group_policy_attachment.test-attach"),
+			},
 			resource.TestStep{
 				Config: testAccAWSGroupPolicyAttachConfigUpdate,
 				Check: resource.ComposeTestCheckFunc(
@@ -190,3 +197,14 @@ resource "aws_iam_group_policy_attachment" "test-attach2" {
     policy_arn = "${aws_iam_policy.policy3.arn}"
 }
 `
+
+func testAccAWSGroupPolicyAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["group"], rs.Primary.Attributes["policy_arn"]), nil
+	}
+}
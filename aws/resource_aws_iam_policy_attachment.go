@@ -20,6 +20,10 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 		Update: resourceAwsIamPolicyAttachmentUpdate,
 		Delete: resourceAwsIamPolicyAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamPolicyAttachmentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -60,6 +64,22 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsIamPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The attachment name is free-form and may itself contain "/", so split
+	// where the policy ARN starts
+	i := strings.Index(d.Id(), "/arn:")
+	if i < 1 {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected NAME/POLICY-ARN", d.Id())
+	}
+
+	name := d.Id()[:i]
+	d.Set("name", name)
+	d.Set("policy_arn", d.Id()[i+1:])
+	d.SetId(name)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).iamconn
 
@@ -30,6 +30,12 @@ func TestAccAWSPolicyAttachment_basic(t *testing.T) {
 					testAccCheckAWSPolicyAttachmentAttributes([]string{user1}, []string{"test-role"}, []string{"test-group"}, &out),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_iam_policy_attachment.test-attach",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSPolicyAttachmentImportStateIdFunc("aws_iam_policy_attachment.test-attach"),
+				ImportStateVerify: true,
+			},
 			resource.TestStep{
 				Config: testAccAWSPolicyAttachConfigUpdate(user1, user2, user3),
 				Check: resource.ComposeTestCheckFunc(
@@ -333,3 +339,14 @@ resource "aws_iam_policy_attachment" "test-paginated-attach" {
 	policy_arn = "${aws_iam_policy.policy.arn}"
 }`, rInt, rInt)
 }
+
+func testAccAWSPolicyAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["name"], rs.Primary.Attributes["policy_arn"]), nil
+	}
+}
@@ -31,6 +31,13 @@ func TestAccAWSRolePolicyAttachment_basic(t *testing.T) {
 					testAccCheckAWSRolePolicyAttachmentAttributes([]string{testPolicy}, &out),
 				),
 			},
+			// the attachment ID is generated, so the imported resource
+			// cannot be matched up for verification
+			{
+				ResourceName:      "aws_iam_role_policy_attachment.test-attach",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRolePolicyAttachmentImportStateIdFunc("aws_iam_role_policy_attachment.test-attach"),
+			},
 			{
 				Config: testAccAWSRolePolicyAttachConfigUpdate(rInt),
 				Check: resource.ComposeTestCheckFunc(
@@ -227,3 +234,14 @@ EOF
 			policy_arn = "${aws_iam_policy.policy3.arn}"
 	}`, rInt, rInt, rInt, rInt)
 }
+
+func testAccAWSRolePolicyAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["role"], rs.Primary.Attributes["policy_arn"]), nil
+	}
+}
@@ -22,6 +22,10 @@ func resourceAwsIamUserPolicy() *schema.Resource {
 		Read:   resourceAwsIamUserPolicyRead,
 		Delete: resourceAwsIamUserPolicyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserPolicyImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"policy": &schema.Schema{
 				Type:     schema.TypeString,
@@ -48,6 +52,15 @@ func resourceAwsIamUserPolicy() *schema.Resource {
 	}
 }
 
+func resourceAwsIamUserPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), ":", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected USER-NAME:POLICY-NAME", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamUserPolicyPut(d *schema.ResourceData, meta interface{}) error {
 	iamconn := meta.(*AWSClient).iamconn
 
@@ -102,6 +115,9 @@ func resourceAwsIamUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return err
 	}
+
+	d.Set("user", user)
+	d.Set("name", name)
 	return d.Set("policy", policy)
 }
 
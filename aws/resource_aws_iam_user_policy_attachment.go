@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -17,6 +18,10 @@ func resourceAwsIamUserPolicyAttachment() *schema.Resource {
 		Read:   resourceAwsIamUserPolicyAttachmentRead,
 		Delete: resourceAwsIamUserPolicyAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserPolicyAttachmentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"user": &schema.Schema{
 				Type:     schema.TypeString,
@@ -32,6 +37,22 @@ func resourceAwsIamUserPolicyAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsIamUserPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// IAM names cannot contain "/", so everything after the first one is the
+	// policy ARN
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected USER-NAME/POLICY-ARN", d.Id())
+	}
+
+	userName := idParts[0]
+	d.Set("user", userName)
+	d.Set("policy_arn", idParts[1])
+	d.SetId(resource.PrefixedUniqueId(fmt.Sprintf("%s-", userName)))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamUserPolicyAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).iamconn
 
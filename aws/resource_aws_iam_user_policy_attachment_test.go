@@ -31,6 +31,13 @@ func TestAccAWSUserPolicyAttachment_basic(t *testing.T) {
 					testAccCheckAWSUserPolicyAttachmentAttributes([]string{policyName1}, &out),
 				),
 			},
+			// the attachment ID is generated, so the imported resource
+			// cannot be matched up for verification
+			{
+				ResourceName:      "aws_iam_user_policy_attachment.test-attach",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSUserPolicyAttachmentImportStateIdFunc("aws_iam_user_policy_attachment.test-attach"),
+			},
 			{
 				Config: testAccAWSUserPolicyAttachConfigUpdate(rName, policyName1, policyName2, policyName3),
 				Check: resource.ComposeTestCheckFunc(
@@ -197,3 +204,14 @@ resource "aws_iam_user_policy_attachment" "test-attach2" {
     policy_arn = "${aws_iam_policy.policy3.arn}"
 }`, rName, policyName1, policyName2, policyName3)
 }
+
+func testAccAWSUserPolicyAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["user"], rs.Primary.Attributes["policy_arn"]), nil
+	}
+}
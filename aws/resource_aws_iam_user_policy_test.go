@@ -38,6 +38,12 @@ func TestAccAWSIAMUserPolicy_basic(t *testing.T) {
 					),
 				),
 			},
+
+			{
+				ResourceName:      "aws_iam_user_policy.bar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -18,6 +19,10 @@ func resourceAwsIamUserSshKey() *schema.Resource {
 		Update: resourceAwsIamUserSshKeyUpdate,
 		Delete: resourceAwsIamUserSshKeyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserSshKeyImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"ssh_public_key_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -52,6 +57,32 @@ func resourceAwsIamUserSshKey() *schema.Resource {
 	}
 }
 
+func resourceAwsIamUserSshKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), ":", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected USERNAME:SSH-KEY-ID:ENCODING", d.Id())
+	}
+
+	username, sshKeyId, encoding := idParts[0], idParts[1], idParts[2]
+
+	iamconn := meta.(*AWSClient).iamconn
+	resp, err := iamconn.GetSSHPublicKey(&iam.GetSSHPublicKeyInput{
+		UserName:       aws.String(username),
+		SSHPublicKeyId: aws.String(sshKeyId),
+		Encoding:       aws.String(encoding),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading IAM User SSH Key %s: %s", sshKeyId, err)
+	}
+
+	d.Set("username", username)
+	d.Set("encoding", encoding)
+	d.Set("public_key", resp.SSHPublicKey.SSHPublicKeyBody)
+	d.SetId(sshKeyId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamUserSshKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	iamconn := meta.(*AWSClient).iamconn
 	username := d.Get("username").(string)
@@ -29,6 +29,14 @@ func TestAccAWSUserSSHKey_basic(t *testing.T) {
 					testAccCheckAWSUserSSHKeyExists("aws_iam_user_ssh_key.user", &conf),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:            "aws_iam_user_ssh_key.user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"public_key"},
+				ImportStateIdFunc:       testAccAWSUserSSHKeyImportStateIdFunc("aws_iam_user_ssh_key.user"),
+			},
 		},
 	})
 }
@@ -141,3 +149,14 @@ resource "aws_iam_user_ssh_key" "user" {
 	public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQD3F6tyPEFEzV0LX3X8BsXdMsQz1x2cEikKDEY0aIj41qgxMCP/iteneqXSIFZBp5vizPvaoIR3Um9xK7PGoW8giupGn+EPuxIA4cDM4vzOqOkiMPhz5XK0whEjkVzTo4+S0puvDZuwIsdiW9mxhJc7tgBNL0cYlWSYVkz4G/fslNfRPW5mYAM49f4fhtxPb5ok4Q2Lg9dPKVHO/Bgeu5woMc7RY0p1ej6D4CKFE6lymSDJpW0YHX/wqE9+cfEauh7xZcG0q9t2ta6F6fmX0agvpFyZo8aFbXeUBr7osSCJNgvavWbM/06niWrOvYX2xwWdhXmXSrbX8ZbabVohBK41 phodgson@thoughtworks.com"
 }
 `
+
+func testAccAWSUserSSHKeyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s:%s", rs.Primary.Attributes["username"], rs.Primary.ID, rs.Primary.Attributes["encoding"]), nil
+	}
+}
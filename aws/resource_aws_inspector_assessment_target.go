@@ -18,6 +18,10 @@ func resourceAWSInspectorAssessmentTarget() *schema.Resource {
 		Update: resourceAwsInspectorAssessmentTargetUpdate,
 		Delete: resourceAwsInspectorAssessmentTargetDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -77,6 +81,7 @@ func resourceAwsInspectorAssessmentTargetRead(d *schema.ResourceData, meta inter
 
 	if resp.AssessmentTargets != nil && len(resp.AssessmentTargets) > 0 {
 		d.Set("name", resp.AssessmentTargets[0].Name)
+		d.Set("resource_group_arn", resp.AssessmentTargets[0].ResourceGroupArn)
 	}
 
 	return nil
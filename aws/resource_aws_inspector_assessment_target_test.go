@@ -35,6 +35,12 @@ func TestAccAWSInspectorTarget_basic(t *testing.T) {
 					testAccCheckAWSInspectorTargetExists("aws_inspector_assessment_target.foo"),
 				),
 			},
+
+			{
+				ResourceName:      "aws_inspector_assessment_target.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -15,6 +15,10 @@ func resourceAWSInspectorAssessmentTemplate() *schema.Resource {
 		Read:   resourceAwsInspectorAssessmentTemplateRead,
 		Delete: resourceAwsInspectorAssessmentTemplateDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -96,7 +100,11 @@ func resourceAwsInspectorAssessmentTemplateRead(d *schema.ResourceData, meta int
 	}
 
 	if resp.AssessmentTemplates != nil && len(resp.AssessmentTemplates) > 0 {
-		d.Set("name", resp.AssessmentTemplates[0].Name)
+		template := resp.AssessmentTemplates[0]
+		d.Set("name", template.Name)
+		d.Set("target_arn", template.AssessmentTargetArn)
+		d.Set("duration", template.DurationInSeconds)
+		d.Set("rules_package_arns", flattenStringList(template.RulesPackageArns))
 	}
 	return nil
 }
@@ -32,6 +32,12 @@ func TestAccAWSInspectorTemplate_basic(t *testing.T) {
 					testAccCheckAWSInspectorTargetExists("aws_inspector_assessment_template.foo"),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:      "aws_inspector_assessment_template.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
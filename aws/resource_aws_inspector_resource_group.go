@@ -15,6 +15,10 @@ func resourceAWSInspectorResourceGroup() *schema.Resource {
 		Read:   resourceAwsInspectorResourceGroupRead,
 		Delete: resourceAwsInspectorResourceGroupDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"tags": &schema.Schema{
 				ForceNew: true,
@@ -50,7 +54,7 @@ func resourceAwsInspectorResourceGroupCreate(d *schema.ResourceData, meta interf
 func resourceAwsInspectorResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).inspectorconn
 
-	_, err := conn.DescribeResourceGroups(&inspector.DescribeResourceGroupsInput{
+	resp, err := conn.DescribeResourceGroups(&inspector.DescribeResourceGroupsInput{
 		ResourceGroupArns: []*string{
 			aws.String(d.Id()),
 		},
@@ -65,6 +69,11 @@ func resourceAwsInspectorResourceGroupRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
+	if len(resp.ResourceGroups) > 0 {
+		d.Set("arn", resp.ResourceGroups[0].Arn)
+		d.Set("tags", tagsToMapInspector(resp.ResourceGroups[0].Tags))
+	}
+
 	return nil
 }
 
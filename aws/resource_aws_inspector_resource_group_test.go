@@ -19,6 +19,11 @@ func TestAccAWSInspectorResourceGroup_basic(t *testing.T) {
 					testAccCheckAWSInspectorResourceGroupExists("aws_inspector_resource_group.foo"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_inspector_resource_group.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			resource.TestStep{
 				Config: testAccCheckAWSInspectorResourceGroupModified,
 				Check: resource.ComposeTestCheckFunc(
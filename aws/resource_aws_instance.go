@@ -17,14 +17,16 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsInstanceCreate,
-		Read:   resourceAwsInstanceRead,
-		Update: resourceAwsInstanceUpdate,
-		Delete: resourceAwsInstanceDelete,
+		Create:        resourceAwsInstanceCreate,
+		Read:          resourceAwsInstanceRead,
+		Update:        resourceAwsInstanceUpdate,
+		Delete:        resourceAwsInstanceDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -41,7 +43,8 @@ func resourceAwsInstance() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"ami": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -68,7 +71,37 @@ func resourceAwsInstance() *schema.Resource {
 
 			"instance_type": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+			},
+
+			"launch_template": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"launch_template.0.name"},
+						},
+						"name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"launch_template.0.id"},
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "$Default",
+						},
+					},
+				},
 			},
 
 			"key_name": {
@@ -260,7 +293,85 @@ func resourceAwsInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"cpu_core_count": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"cpu_threads_per_core": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"credit_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_credits": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"standard",
+								"unlimited",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"hibernation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"metadata_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"http_endpoint": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.InstanceMetadataEndpointStateEnabled,
+								ec2.InstanceMetadataEndpointStateDisabled,
+							}, false),
+						},
+
+						"http_tokens": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.HttpTokensStateOptional,
+								ec2.HttpTokensStateRequired,
+							}, false),
+						},
+
+						"http_put_response_hop_limit": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 64),
+						},
+					},
+				},
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 
 			"volume_tags": tagsSchemaComputed(),
 
@@ -453,6 +564,10 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		SecurityGroups:                    instanceOpts.SecurityGroups,
 		SubnetId:                          instanceOpts.SubnetID,
 		UserData:                          instanceOpts.UserData64,
+		CpuOptions:                        instanceOpts.CpuOptions,
+		CreditSpecification:               instanceOpts.CreditSpecification,
+		HibernationOptions:                instanceOpts.HibernationOptions,
+		MetadataOptions:                   instanceOpts.MetadataOptions,
 	}
 
 	_, ipv6CountOk := d.GetOk("ipv6_address_count")
@@ -462,11 +577,40 @@ func resourceAwsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Only 1 of `ipv6_address_count` or `ipv6_addresses` can be specified")
 	}
 
+	if v, ok := d.GetOk("launch_template"); ok {
+		runOpts.LaunchTemplate = expandLaunchTemplateSpecification(v.([]interface{}), d.HasChange("launch_template.0.name"))
+
+		// Settings left out of the configuration are taken from the template
+		if *runOpts.ImageId == "" {
+			runOpts.ImageId = nil
+		}
+		if *runOpts.InstanceType == "" {
+			runOpts.InstanceType = nil
+		}
+		if *runOpts.IamInstanceProfile.Name == "" {
+			runOpts.IamInstanceProfile = nil
+		}
+		if !*runOpts.DisableApiTermination {
+			runOpts.DisableApiTermination = nil
+		}
+		if !*runOpts.EbsOptimized {
+			runOpts.EbsOptimized = nil
+		}
+		if !*runOpts.Monitoring.Enabled {
+			runOpts.Monitoring = nil
+		}
+		if *runOpts.Placement.AvailabilityZone == "" && *runOpts.Placement.GroupName == "" && runOpts.Placement.Tenancy == nil {
+			runOpts.Placement = nil
+		}
+	} else if *runOpts.ImageId == "" || *runOpts.InstanceType == "" {
+		return fmt.Errorf("`ami` and `instance_type` must be specified unless a `launch_template` is used")
+	}
+
 	restricted := meta.(*AWSClient).IsGovCloud() || meta.(*AWSClient).IsChinaCloud()
 	if !restricted {
 		tagsSpec := make([]*ec2.TagSpecification, 0)
 
-		if v, ok := d.GetOk("tags"); ok {
+		if v, ok := d.GetOk("tags_all"); ok {
 			tags := tagsFromMap(v.(map[string]interface{}))
 
 			spec := &ec2.TagSpecification{
@@ -709,7 +853,28 @@ func resourceAwsInstanceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("monitoring", monitoringState == "enabled" || monitoringState == "pending")
 	}
 
-	d.Set("tags", tagsToMap(instance.Tags))
+	if instance.CpuOptions != nil {
+		d.Set("cpu_core_count", instance.CpuOptions.CoreCount)
+		d.Set("cpu_threads_per_core", instance.CpuOptions.ThreadsPerCore)
+	}
+
+	if instance.HibernationOptions != nil {
+		d.Set("hibernation", instance.HibernationOptions.Configured)
+	}
+
+	if err := d.Set("metadata_options", flattenEc2InstanceMetadataOptions(instance.MetadataOptions)); err != nil {
+		return fmt.Errorf("Error setting metadata_options: %s", err)
+	}
+
+	creditSpecifications, err := readInstanceCreditSpecifications(conn, instance)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("credit_specification", creditSpecifications); err != nil {
+		return fmt.Errorf("Error setting credit_specification: %s", err)
+	}
+
+	setTagsAll(d, meta, tagsToMap(instance.Tags))
 
 	if err := readVolumeTags(conn, d); err != nil {
 		return err
@@ -769,12 +934,13 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	restricted := meta.(*AWSClient).IsGovCloud() || meta.(*AWSClient).IsChinaCloud()
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if !d.IsNewResource() || restricted {
 			if err := setTags(conn, d); err != nil {
 				return err
 			} else {
 				d.SetPartial("tags")
+				d.SetPartial("tags_all")
 			}
 		}
 	}
@@ -1021,6 +1187,48 @@ func resourceAwsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("credit_specification") && !d.IsNewResource() {
+		if v, ok := d.GetOk("credit_specification"); ok {
+			creditSpecification := expandEc2CreditSpecificationRequest(v.([]interface{}))
+			if creditSpecification != nil {
+				log.Printf("[DEBUG] Modifying credit specification for Instance (%s)", d.Id())
+				_, err := conn.ModifyInstanceCreditSpecification(&ec2.ModifyInstanceCreditSpecificationInput{
+					InstanceCreditSpecifications: []*ec2.InstanceCreditSpecificationRequest{
+						{
+							InstanceId: aws.String(d.Id()),
+							CpuCredits: creditSpecification.CpuCredits,
+						},
+					},
+				})
+				if err != nil {
+					return fmt.Errorf("Error updating Instance (%s) credit specification: %s", d.Id(), err)
+				}
+			}
+		}
+	}
+
+	if d.HasChange("metadata_options") && !d.IsNewResource() {
+		if v, ok := d.GetOk("metadata_options"); ok {
+			options := expandEc2InstanceMetadataOptions(v.([]interface{}))
+			if options != nil {
+				log.Printf("[DEBUG] Modifying metadata options for Instance (%s)", d.Id())
+				_, err := conn.ModifyInstanceMetadataOptions(&ec2.ModifyInstanceMetadataOptionsInput{
+					InstanceId:              aws.String(d.Id()),
+					HttpEndpoint:            options.HttpEndpoint,
+					HttpPutResponseHopLimit: options.HttpPutResponseHopLimit,
+					HttpTokens:              options.HttpTokens,
+				})
+				if err != nil {
+					return fmt.Errorf("Error updating Instance (%s) metadata options: %s", d.Id(), err)
+				}
+
+				if err := waitForInstanceMetadataOptionsApplied(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	// TODO(mitchellh): wait for the attributes we modified to
 	// persist the change...
 
@@ -1548,6 +1756,10 @@ type awsInstanceOpts struct {
 	SpotPlacement                     *ec2.SpotPlacement
 	SubnetID                          *string
 	UserData64                        *string
+	CpuOptions                        *ec2.CpuOptionsRequest
+	CreditSpecification               *ec2.CreditSpecificationRequest
+	HibernationOptions                *ec2.HibernationOptionsRequest
+	MetadataOptions                   *ec2.InstanceMetadataOptionsRequest
 }
 
 func buildAwsInstanceOpts(
@@ -1667,6 +1879,32 @@ func buildAwsInstanceOpts(
 		opts.KeyName = aws.String(v.(string))
 	}
 
+	// The remaining options are only supported by RunInstances, and aren't
+	// in the schema of aws_spot_instance_request
+	if v, ok := d.GetOk("cpu_core_count"); ok {
+		opts.CpuOptions = &ec2.CpuOptionsRequest{
+			CoreCount:      aws.Int64(int64(v.(int))),
+			ThreadsPerCore: aws.Int64(int64(d.Get("cpu_threads_per_core").(int))),
+		}
+		if *opts.CpuOptions.ThreadsPerCore == 0 {
+			opts.CpuOptions.ThreadsPerCore = nil
+		}
+	}
+
+	if v, ok := d.GetOk("credit_specification"); ok {
+		opts.CreditSpecification = expandEc2CreditSpecificationRequest(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("hibernation"); ok {
+		opts.HibernationOptions = &ec2.HibernationOptionsRequest{
+			Configured: aws.Bool(v.(bool)),
+		}
+	}
+
+	if v, ok := d.GetOk("metadata_options"); ok {
+		opts.MetadataOptions = expandEc2InstanceMetadataOptions(v.([]interface{}))
+	}
+
 	blockDevices, err := readBlockDeviceMappingsFromConfig(d, conn)
 	if err != nil {
 		return nil, err
@@ -1750,3 +1988,148 @@ func getAwsInstanceVolumeIds(conn *ec2.EC2, d *schema.ResourceData) ([]*string,
 
 	return volumeIds, nil
 }
+
+// expandLaunchTemplateSpecification returns the launch template reference of
+// a launch_template block. Only one of id and name may be sent: the name when
+// byName is set because it was changed, and otherwise the id.
+func expandLaunchTemplateSpecification(l []interface{}, byName bool) *ec2.LaunchTemplateSpecification {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	m := l[0].(map[string]interface{})
+
+	spec := &ec2.LaunchTemplateSpecification{}
+	if v := m["name"].(string); byName && v != "" {
+		spec.LaunchTemplateName = aws.String(v)
+	} else if v := m["id"].(string); v != "" {
+		spec.LaunchTemplateId = aws.String(v)
+	} else if v := m["name"].(string); v != "" {
+		spec.LaunchTemplateName = aws.String(v)
+	}
+	if v := m["version"].(string); v != "" {
+		spec.Version = aws.String(v)
+	}
+	return spec
+}
+
+// isBurstableInstanceType returns whether the instance type earns CPU
+// credits, and so has a credit specification.
+func isBurstableInstanceType(instanceType string) bool {
+	for _, family := range []string{"t2.", "t3.", "t3a.", "t4g."} {
+		if strings.HasPrefix(instanceType, family) {
+			return true
+		}
+	}
+	return false
+}
+
+// readInstanceCreditSpecifications returns the credit specification of a
+// burstable instance, or none for any other instance type.
+func readInstanceCreditSpecifications(conn *ec2.EC2, instance *ec2.Instance) ([]interface{}, error) {
+	if !isBurstableInstanceType(aws.StringValue(instance.InstanceType)) {
+		return []interface{}{}, nil
+	}
+
+	resp, err := conn.DescribeInstanceCreditSpecifications(&ec2.DescribeInstanceCreditSpecificationsInput{
+		InstanceIds: []*string{instance.InstanceId},
+	})
+	// Credit specifications aren't available in every partition
+	if isAWSErr(err, "UnsupportedOperation", "") {
+		log.Printf("[WARN] Unable to read credit specification for Instance (%s): %s", aws.StringValue(instance.InstanceId), err)
+		return []interface{}{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Instance (%s) credit specification: %s", aws.StringValue(instance.InstanceId), err)
+	}
+
+	var specifications []interface{}
+	for _, specification := range resp.InstanceCreditSpecifications {
+		specifications = append(specifications, map[string]interface{}{
+			"cpu_credits": aws.StringValue(specification.CpuCredits),
+		})
+	}
+	return specifications, nil
+}
+
+func expandEc2CreditSpecificationRequest(l []interface{}) *ec2.CreditSpecificationRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v := m["cpu_credits"].(string); v != "" {
+		return &ec2.CreditSpecificationRequest{
+			CpuCredits: aws.String(v),
+		}
+	}
+	return nil
+}
+
+func expandEc2InstanceMetadataOptions(l []interface{}) *ec2.InstanceMetadataOptionsRequest {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	options := &ec2.InstanceMetadataOptionsRequest{}
+	if v := m["http_endpoint"].(string); v != "" {
+		options.HttpEndpoint = aws.String(v)
+	}
+	if v := m["http_tokens"].(string); v != "" {
+		options.HttpTokens = aws.String(v)
+	}
+	if v := m["http_put_response_hop_limit"].(int); v != 0 {
+		options.HttpPutResponseHopLimit = aws.Int64(int64(v))
+	}
+	return options
+}
+
+func flattenEc2InstanceMetadataOptions(options *ec2.InstanceMetadataOptionsResponse) []interface{} {
+	if options == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"http_endpoint":               aws.StringValue(options.HttpEndpoint),
+			"http_tokens":                 aws.StringValue(options.HttpTokens),
+			"http_put_response_hop_limit": int(aws.Int64Value(options.HttpPutResponseHopLimit)),
+		},
+	}
+}
+
+// waitForInstanceMetadataOptionsApplied waits until a change to the
+// metadata options of an instance has taken effect.
+func waitForInstanceMetadataOptionsApplied(conn *ec2.EC2, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{ec2.InstanceMetadataOptionsStatePending},
+		Target:  []string{ec2.InstanceMetadataOptionsStateApplied},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.DescribeInstances(&ec2.DescribeInstancesInput{
+				InstanceIds: []*string{aws.String(id)},
+			})
+			if err != nil {
+				return nil, "", err
+			}
+			if len(resp.Reservations) == 0 || len(resp.Reservations[0].Instances) == 0 {
+				return nil, "", nil
+			}
+
+			instance := resp.Reservations[0].Instances[0]
+			if instance.MetadataOptions == nil {
+				return nil, "", nil
+			}
+			return instance, aws.StringValue(instance.MetadataOptions.State), nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Instance (%s) metadata options to be applied: %s", id, err)
+	}
+	return nil
+}
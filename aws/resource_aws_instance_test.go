@@ -143,6 +143,28 @@ func TestAccAWSInstance_userDataBase64(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_launchTemplate(t *testing.T) {
+	var v ec2.Instance
+	rName := fmt.Sprintf("tf-acc-lt-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigLaunchTemplate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists("aws_instance.foo", &v),
+					resource.TestCheckResourceAttr("aws_instance.foo", "ami", "ami-4fccb37f"),
+					resource.TestCheckResourceAttr("aws_instance.foo", "instance_type", "t2.micro"),
+					resource.TestCheckResourceAttr("aws_instance.foo", "launch_template.0.name", rName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSInstance_GP2IopsDevice(t *testing.T) {
 	var v ec2.Instance
 
@@ -1288,6 +1310,92 @@ func TestAccAWSInstance_associatePublic_overridePrivate(t *testing.T) {
 	})
 }
 
+func TestAccAWSInstance_creditSpecification(t *testing.T) {
+	var before, after ec2.Instance
+	resName := "aws_instance.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigCreditSpecification(rInt, "standard"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resName, &before),
+					resource.TestCheckResourceAttr(resName, "credit_specification.#", "1"),
+					resource.TestCheckResourceAttr(resName, "credit_specification.0.cpu_credits", "standard"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigCreditSpecification(rInt, "unlimited"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resName, &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resName, "credit_specification.#", "1"),
+					resource.TestCheckResourceAttr(resName, "credit_specification.0.cpu_credits", "unlimited"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_metadataOptions(t *testing.T) {
+	var before, after ec2.Instance
+	resName := "aws_instance.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigMetadataOptions(rInt, "optional", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resName, &before),
+					resource.TestCheckResourceAttr(resName, "metadata_options.#", "1"),
+					resource.TestCheckResourceAttr(resName, "metadata_options.0.http_endpoint", "enabled"),
+					resource.TestCheckResourceAttr(resName, "metadata_options.0.http_tokens", "optional"),
+					resource.TestCheckResourceAttr(resName, "metadata_options.0.http_put_response_hop_limit", "1"),
+				),
+			},
+			{
+				Config: testAccInstanceConfigMetadataOptions(rInt, "required", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resName, &after),
+					testAccCheckInstanceNotRecreated(t, &before, &after),
+					resource.TestCheckResourceAttr(resName, "metadata_options.0.http_tokens", "required"),
+					resource.TestCheckResourceAttr(resName, "metadata_options.0.http_put_response_hop_limit", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSInstance_cpuOptions(t *testing.T) {
+	var v ec2.Instance
+	resName := "aws_instance.foo"
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfigCpuOptions(rInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resName, &v),
+					resource.TestCheckResourceAttr(resName, "cpu_core_count", "2"),
+					resource.TestCheckResourceAttr(resName, "cpu_threads_per_core", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckInstanceNotRecreated(t *testing.T,
 	before, after *ec2.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -2719,3 +2827,87 @@ resource "aws_instance" "foo" {
   }
 }`, rInt, rInt)
 }
+
+func testAccInstanceConfigLaunchTemplate(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name          = "%s"
+  image_id      = "ami-4fccb37f"
+  instance_type = "t2.micro"
+}
+
+resource "aws_instance" "foo" {
+  launch_template {
+    name = "${aws_launch_template.foo.name}"
+  }
+}
+`, rName)
+}
+
+const testAccInstanceConfigAmznAmiMinimalHvmEbs = `
+data "aws_ami" "amzn-ami-minimal-hvm-ebs" {
+  most_recent = true
+  owners      = ["amazon"]
+
+  filter {
+    name   = "name"
+    values = ["amzn-ami-minimal-hvm-*"]
+  }
+
+  filter {
+    name   = "root-device-type"
+    values = ["ebs"]
+  }
+}
+`
+
+func testAccInstanceConfigCreditSpecification(rInt int, cpuCredits string) string {
+	return testAccInstanceConfigAmznAmiMinimalHvmEbs + fmt.Sprintf(`
+resource "aws_instance" "foo" {
+  ami           = "${data.aws_ami.amzn-ami-minimal-hvm-ebs.id}"
+  instance_type = "t3.micro"
+
+  credit_specification {
+    cpu_credits = %[2]q
+  }
+
+  tags {
+    Name = "tf-acctest-%[1]d"
+  }
+}
+`, rInt, cpuCredits)
+}
+
+func testAccInstanceConfigMetadataOptions(rInt int, httpTokens string, hopLimit int) string {
+	return testAccInstanceConfigAmznAmiMinimalHvmEbs + fmt.Sprintf(`
+resource "aws_instance" "foo" {
+  ami           = "${data.aws_ami.amzn-ami-minimal-hvm-ebs.id}"
+  instance_type = "t3.micro"
+
+  metadata_options {
+    http_endpoint               = "enabled"
+    http_tokens                 = %[2]q
+    http_put_response_hop_limit = %[3]d
+  }
+
+  tags {
+    Name = "tf-acctest-%[1]d"
+  }
+}
+`, rInt, httpTokens, hopLimit)
+}
+
+func testAccInstanceConfigCpuOptions(rInt int) string {
+	return testAccInstanceConfigAmznAmiMinimalHvmEbs + fmt.Sprintf(`
+resource "aws_instance" "foo" {
+  ami                  = "${data.aws_ami.amzn-ami-minimal-hvm-ebs.id}"
+  instance_type        = "c5.xlarge"
+  cpu_core_count       = 2
+  cpu_threads_per_core = 1
+
+  tags {
+    Name = "tf-acctest-%d"
+  }
+}
+`, rInt)
+}
@@ -15,10 +15,11 @@ import (
 
 func resourceAwsInternetGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsInternetGatewayCreate,
-		Read:   resourceAwsInternetGatewayRead,
-		Update: resourceAwsInternetGatewayUpdate,
-		Delete: resourceAwsInternetGatewayDelete,
+		Create:        resourceAwsInternetGatewayCreate,
+		Read:          resourceAwsInternetGatewayRead,
+		Update:        resourceAwsInternetGatewayUpdate,
+		Delete:        resourceAwsInternetGatewayDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -28,7 +29,8 @@ func resourceAwsInternetGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -95,7 +97,7 @@ func resourceAwsInternetGatewayRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("vpc_id", ig.Attachments[0].VpcId)
 	}
 
-	d.Set("tags", tagsToMap(ig.Tags))
+	setTagsAll(d, meta, tagsToMap(ig.Tags))
 
 	return nil
 }
@@ -120,6 +122,7 @@ func resourceAwsInternetGatewayUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	return nil
 }
@@ -30,6 +30,12 @@ func TestAccAWSIoTPolicy_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("aws_iot_policy.pubsub", "policy"),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:      "aws_iot_policy.pubsub",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
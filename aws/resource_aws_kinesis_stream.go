@@ -17,10 +17,11 @@ import (
 
 func resourceAwsKinesisStream() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsKinesisStreamCreate,
-		Read:   resourceAwsKinesisStreamRead,
-		Update: resourceAwsKinesisStreamUpdate,
-		Delete: resourceAwsKinesisStreamDelete,
+		Create:        resourceAwsKinesisStreamCreate,
+		Read:          resourceAwsKinesisStreamRead,
+		Update:        resourceAwsKinesisStreamUpdate,
+		Delete:        resourceAwsKinesisStreamDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsKinesisStreamImport,
 		},
@@ -87,7 +88,8 @@ func resourceAwsKinesisStream() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -157,6 +159,7 @@ func resourceAwsKinesisStreamUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 	d.Partial(false)
 
 	if err := updateKinesisShardCount(conn, d); err != nil {
@@ -212,7 +215,7 @@ func resourceAwsKinesisStreamRead(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		log.Printf("[DEBUG] Error retrieving tags for Stream: %s. %s", sn, err)
 	} else {
-		d.Set("tags", tagsToMapKinesis(tagsResp.Tags))
+		setTagsAll(d, meta, tagsToMapKinesis(tagsResp.Tags))
 	}
 
 	return nil
@@ -79,7 +79,7 @@ func resourceAwsKmsAliasCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// KMS is eventually consistent
-	_, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+	_, err := retryOnAwsCode(meta.(*AWSClient).retryTimeout, "NotFoundException", func() (interface{}, error) {
 		return conn.CreateAlias(req)
 	})
 	if err != nil {
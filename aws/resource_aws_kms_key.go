@@ -15,11 +15,12 @@ import (
 
 func resourceAwsKmsKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsKmsKeyCreate,
-		Read:   resourceAwsKmsKeyRead,
-		Update: resourceAwsKmsKeyUpdate,
-		Delete: resourceAwsKmsKeyDelete,
-		Exists: resourceAwsKmsKeyExists,
+		Create:        resourceAwsKmsKeyCreate,
+		Read:          resourceAwsKmsKeyRead,
+		Update:        resourceAwsKmsKeyUpdate,
+		Delete:        resourceAwsKmsKeyDelete,
+		Exists:        resourceAwsKmsKeyExists,
+		CustomizeDiff: setTagsAllDiff,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -82,7 +83,8 @@ func resourceAwsKmsKey() *schema.Resource {
 					return
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -101,7 +103,7 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	if v, exists := d.GetOk("policy"); exists {
 		req.Policy = aws.String(v.(string))
 	}
-	if v, exists := d.GetOk("tags"); exists {
+	if v, exists := d.GetOk("tags_all"); exists {
 		req.Tags = tagsFromMapKMS(v.(map[string]interface{}))
 	}
 
@@ -130,6 +132,7 @@ func resourceAwsKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).kmsconn
+	retryTimeout := meta.(*AWSClient).retryTimeout
 
 	req := &kms.DescribeKeyInput{
 		KeyId: aws.String(d.Id()),
@@ -139,7 +142,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	var err error
 	if d.IsNewResource() {
 		var out interface{}
-		out, err = retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+		out, err = retryOnAwsCode(retryTimeout, "NotFoundException", func() (interface{}, error) {
 			return conn.DescribeKey(req)
 		})
 		resp, _ = out.(*kms.DescribeKeyOutput)
@@ -165,7 +168,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("key_usage", metadata.KeyUsage)
 	d.Set("is_enabled", metadata.Enabled)
 
-	pOut, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+	pOut, err := retryOnAwsCode(retryTimeout, "NotFoundException", func() (interface{}, error) {
 		return conn.GetKeyPolicy(&kms.GetKeyPolicyInput{
 			KeyId:      metadata.KeyId,
 			PolicyName: aws.String("default"),
@@ -182,7 +185,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("policy", policy)
 
-	out, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+	out, err := retryOnAwsCode(retryTimeout, "NotFoundException", func() (interface{}, error) {
 		return conn.GetKeyRotationStatus(&kms.GetKeyRotationStatusInput{
 			KeyId: metadata.KeyId,
 		})
@@ -193,7 +196,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	krs, _ := out.(*kms.GetKeyRotationStatusOutput)
 	d.Set("enable_key_rotation", krs.KeyRotationEnabled)
 
-	tOut, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+	tOut, err := retryOnAwsCode(retryTimeout, "NotFoundException", func() (interface{}, error) {
 		return conn.ListResourceTags(&kms.ListResourceTagsInput{
 			KeyId: metadata.KeyId,
 		})
@@ -202,7 +205,7 @@ func resourceAwsKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Failed to get KMS key tags (key: %s): %s", d.Get("key_id").(string), err)
 	}
 	tagList := tOut.(*kms.ListResourceTagsOutput)
-	d.Set("tags", tagsToMapKMS(tagList.Tags))
+	setTagsAll(d, meta, tagsToMapKMS(tagList.Tags))
 
 	return nil
 }
@@ -219,18 +222,18 @@ func resourceAwsKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("enable_key_rotation") {
-		if err := updateKmsKeyRotationStatus(conn, d); err != nil {
+		if err := updateKmsKeyRotationStatus(conn, d, meta.(*AWSClient).retryTimeout); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("description") {
-		if err := resourceAwsKmsKeyDescriptionUpdate(conn, d); err != nil {
+		if err := resourceAwsKmsKeyDescriptionUpdate(conn, d, meta.(*AWSClient).retryTimeout); err != nil {
 			return err
 		}
 	}
 	if d.HasChange("policy") {
-		if err := resourceAwsKmsKeyPolicyUpdate(conn, d); err != nil {
+		if err := resourceAwsKmsKeyPolicyUpdate(conn, d, meta.(*AWSClient).retryTimeout); err != nil {
 			return err
 		}
 	}
@@ -250,7 +253,7 @@ func resourceAwsKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceAwsKmsKeyRead(d, meta)
 }
 
-func resourceAwsKmsKeyDescriptionUpdate(conn *kms.KMS, d *schema.ResourceData) error {
+func resourceAwsKmsKeyDescriptionUpdate(conn *kms.KMS, d *schema.ResourceData, retryTimeout time.Duration) error {
 	description := d.Get("description").(string)
 	keyId := d.Get("key_id").(string)
 
@@ -260,13 +263,13 @@ func resourceAwsKmsKeyDescriptionUpdate(conn *kms.KMS, d *schema.ResourceData) e
 		Description: aws.String(description),
 		KeyId:       aws.String(keyId),
 	}
-	_, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryTimeout, "NotFoundException", func() (interface{}, error) {
 		return conn.UpdateKeyDescription(req)
 	})
 	return err
 }
 
-func resourceAwsKmsKeyPolicyUpdate(conn *kms.KMS, d *schema.ResourceData) error {
+func resourceAwsKmsKeyPolicyUpdate(conn *kms.KMS, d *schema.ResourceData, retryTimeout time.Duration) error {
 	policy, err := normalizeJsonString(d.Get("policy").(string))
 	if err != nil {
 		return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
@@ -280,7 +283,7 @@ func resourceAwsKmsKeyPolicyUpdate(conn *kms.KMS, d *schema.ResourceData) error
 		Policy:     aws.String(policy),
 		PolicyName: aws.String("default"),
 	}
-	_, err = retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+	_, err = retryOnAwsCode(retryTimeout, "NotFoundException", func() (interface{}, error) {
 		return conn.PutKeyPolicy(req)
 	})
 	return err
@@ -341,7 +344,7 @@ func updateKmsKeyStatus(conn *kms.KMS, id string, shouldBeEnabled bool) error {
 	return nil
 }
 
-func updateKmsKeyRotationStatus(conn *kms.KMS, d *schema.ResourceData) error {
+func updateKmsKeyRotationStatus(conn *kms.KMS, d *schema.ResourceData, retryTimeout time.Duration) error {
 	shouldEnableRotation := d.Get("enable_key_rotation").(bool)
 
 	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
@@ -389,7 +392,7 @@ func updateKmsKeyRotationStatus(conn *kms.KMS, d *schema.ResourceData) error {
 			log.Printf("[DEBUG] Checking if KMS key %s rotation status is %t",
 				d.Id(), shouldEnableRotation)
 
-			out, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+			out, err := retryOnAwsCode(retryTimeout, "NotFoundException", func() (interface{}, error) {
 				return conn.GetKeyRotationStatus(&kms.GetKeyRotationStatusInput{
 					KeyId: aws.String(d.Id()),
 				})
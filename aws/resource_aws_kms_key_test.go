@@ -290,7 +290,7 @@ func testAccCheckAWSKmsKeyExists(name string, key *kms.KeyMetadata) resource.Tes
 
 		conn := testAccProvider.Meta().(*AWSClient).kmsconn
 
-		o, err := retryOnAwsCode("NotFoundException", func() (interface{}, error) {
+		o, err := retryOnAwsCode(testAccProvider.Meta().(*AWSClient).retryTimeout, "NotFoundException", func() (interface{}, error) {
 			return conn.DescribeKey(&kms.DescribeKeyInput{
 				KeyId: aws.String(rs.Primary.ID),
 			})
@@ -18,6 +18,10 @@ func resourceAwsLambdaAlias() *schema.Resource {
 		Update: resourceAwsLambdaAliasUpdate,
 		Delete: resourceAwsLambdaAliasDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLambdaAliasImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"description": &schema.Schema{
 				Type:     schema.TypeString,
@@ -43,6 +47,28 @@ func resourceAwsLambdaAlias() *schema.Resource {
 	}
 }
 
+func resourceAwsLambdaAliasImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected FUNCTION-NAME/ALIAS", d.Id())
+	}
+
+	conn := meta.(*AWSClient).lambdaconn
+	aliasConfiguration, err := conn.GetAlias(&lambda.GetAliasInput{
+		FunctionName: aws.String(idParts[0]),
+		Name:         aws.String(idParts[1]),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("function_name", idParts[0])
+	d.Set("name", idParts[1])
+	d.SetId(*aliasConfiguration.AliasArn)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // resourceAwsLambdaAliasCreate maps to:
 // CreateAlias in the API / SDK
 func resourceAwsLambdaAliasCreate(d *schema.ResourceData, meta interface{}) error {
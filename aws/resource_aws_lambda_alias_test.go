@@ -29,6 +29,13 @@ func TestAccAWSLambdaAlias_basic(t *testing.T) {
 					resource.TestMatchResourceAttr("aws_lambda_alias.lambda_alias_test", "arn", regexp.MustCompile(`^arn:aws:lambda:[a-z]+-[a-z]+-[0-9]+:\d{12}:function:example_lambda_name_create:testalias$`)),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:      "aws_lambda_alias.lambda_alias_test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAwsLambdaAliasImportStateIdFunc("aws_lambda_alias.lambda_alias_test"),
+			},
 		},
 	})
 }
@@ -161,3 +168,14 @@ resource "aws_lambda_alias" "lambda_alias_test" {
   function_version = "$LATEST"
 }`, rInt, rInt, rInt)
 }
+
+func testAccAwsLambdaAliasImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["function_name"], rs.Primary.Attributes["name"]), nil
+	}
+}
@@ -22,10 +22,11 @@ const awsMutexLambdaKey = `aws_lambda_function`
 
 func resourceAwsLambdaFunction() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsLambdaFunctionCreate,
-		Read:   resourceAwsLambdaFunctionRead,
-		Update: resourceAwsLambdaFunctionUpdate,
-		Delete: resourceAwsLambdaFunctionDelete,
+		Create:        resourceAwsLambdaFunctionCreate,
+		Read:          resourceAwsLambdaFunctionRead,
+		Update:        resourceAwsLambdaFunctionUpdate,
+		Delete:        resourceAwsLambdaFunctionDelete,
+		CustomizeDiff: setTagsAllDiff,
 
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -102,6 +103,12 @@ func resourceAwsLambdaFunction() *schema.Resource {
 				Optional: true,
 				Default:  3,
 			},
+			"reserved_concurrent_executions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
 			"publish": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -194,7 +201,8 @@ func resourceAwsLambdaFunction() *schema.Resource {
 				ValidateFunc: validateArn,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -326,7 +334,7 @@ func resourceAwsLambdaFunctionCreate(d *schema.ResourceData, meta interface{}) e
 		params.KMSKeyArn = aws.String(v.(string))
 	}
 
-	if v, exists := d.GetOk("tags"); exists {
+	if v, exists := d.GetOk("tags_all"); exists {
 		params.Tags = tagsFromMapGeneric(v.(map[string]interface{}))
 	}
 
@@ -357,6 +365,18 @@ func resourceAwsLambdaFunctionCreate(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(d.Get("function_name").(string))
 
+	if reservedConcurrentExecutions := d.Get("reserved_concurrent_executions").(int); reservedConcurrentExecutions >= 0 {
+		log.Printf("[DEBUG] Setting Concurrency to %d for the Lambda Function %s", reservedConcurrentExecutions, functionName)
+
+		_, err := conn.PutFunctionConcurrency(&lambda.PutFunctionConcurrencyInput{
+			FunctionName:                 aws.String(functionName),
+			ReservedConcurrentExecutions: aws.Int64(int64(reservedConcurrentExecutions)),
+		})
+		if err != nil {
+			return fmt.Errorf("Error setting concurrency for Lambda %s: %s", functionName, err)
+		}
+	}
+
 	return resourceAwsLambdaFunctionRead(d, meta)
 }
 
@@ -396,7 +416,15 @@ func resourceAwsLambdaFunctionRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("runtime", function.Runtime)
 	d.Set("timeout", function.Timeout)
 	d.Set("kms_key_arn", function.KMSKeyArn)
-	d.Set("tags", tagsToMapGeneric(getFunctionOutput.Tags))
+
+	// A nil Concurrency means the function draws from the unreserved pool
+	reservedConcurrentExecutions := int64(-1)
+	if getFunctionOutput.Concurrency != nil && getFunctionOutput.Concurrency.ReservedConcurrentExecutions != nil {
+		reservedConcurrentExecutions = *getFunctionOutput.Concurrency.ReservedConcurrentExecutions
+	}
+	d.Set("reserved_concurrent_executions", reservedConcurrentExecutions)
+
+	setTagsAll(d, meta, tagsToMapGeneric(getFunctionOutput.Tags))
 
 	config := flattenLambdaVpcConfigResponse(function.VpcConfig)
 	log.Printf("[INFO] Setting Lambda %s VPC config %#v from API", d.Id(), config)
@@ -507,6 +535,7 @@ func resourceAwsLambdaFunctionUpdate(d *schema.ResourceData, meta interface{}) e
 		return tagErr
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	configReq := &lambda.UpdateFunctionConfigurationInput{
 		FunctionName: aws.String(d.Id()),
@@ -624,6 +653,33 @@ func resourceAwsLambdaFunctionUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("timeout")
 	}
 
+	if d.HasChange("reserved_concurrent_executions") {
+		reservedConcurrentExecutions := d.Get("reserved_concurrent_executions").(int)
+
+		if reservedConcurrentExecutions >= 0 {
+			log.Printf("[DEBUG] Updating Concurrency to %d for the Lambda Function %s", reservedConcurrentExecutions, d.Id())
+
+			_, err := conn.PutFunctionConcurrency(&lambda.PutFunctionConcurrencyInput{
+				FunctionName:                 aws.String(d.Id()),
+				ReservedConcurrentExecutions: aws.Int64(int64(reservedConcurrentExecutions)),
+			})
+			if err != nil {
+				return fmt.Errorf("Error setting concurrency for Lambda %s: %s", d.Id(), err)
+			}
+		} else {
+			log.Printf("[DEBUG] Removing Concurrency for the Lambda Function %s", d.Id())
+
+			_, err := conn.DeleteFunctionConcurrency(&lambda.DeleteFunctionConcurrencyInput{
+				FunctionName: aws.String(d.Id()),
+			})
+			if err != nil {
+				return fmt.Errorf("Error removing concurrency for Lambda %s: %s", d.Id(), err)
+			}
+		}
+
+		d.SetPartial("reserved_concurrent_executions")
+	}
+
 	if d.HasChange("filename") || d.HasChange("source_code_hash") || d.HasChange("s3_bucket") || d.HasChange("s3_key") || d.HasChange("s3_object_version") {
 		codeReq := &lambda.UpdateFunctionCodeInput{
 			FunctionName: aws.String(d.Id()),
@@ -0,0 +1,324 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLambdaFunctionEventInvokeConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLambdaFunctionEventInvokeConfigCreate,
+		Read:   resourceAwsLambdaFunctionEventInvokeConfigRead,
+		Update: resourceAwsLambdaFunctionEventInvokeConfigUpdate,
+		Delete: resourceAwsLambdaFunctionEventInvokeConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"on_failure": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+						"on_success": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"maximum_event_age_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(60, 21600),
+			},
+			"maximum_retry_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntBetween(0, 2),
+			},
+			"qualifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLambdaFunctionEventInvokeConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lambdaconn
+	functionName := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+
+	id := functionName
+	if qualifier != "" {
+		id = fmt.Sprintf("%s:%s", functionName, qualifier)
+	}
+
+	input := &lambda.PutFunctionEventInvokeConfigInput{
+		DestinationConfig:    expandLambdaEventInvokeDestinationConfig(d.Get("destination_config").([]interface{})),
+		FunctionName:         aws.String(functionName),
+		MaximumRetryAttempts: aws.Int64(int64(d.Get("maximum_retry_attempts").(int))),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if v, ok := d.GetOk("maximum_event_age_in_seconds"); ok {
+		input.MaximumEventAgeInSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if err := putLambdaFunctionEventInvokeConfig(conn, input); err != nil {
+		return fmt.Errorf("Error putting Lambda Function Event Invoke Config (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceAwsLambdaFunctionEventInvokeConfigRead(d, meta)
+}
+
+func resourceAwsLambdaFunctionEventInvokeConfigRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lambdaconn
+
+	functionName, qualifier, err := resourceAwsLambdaFunctionEventInvokeConfigParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &lambda.GetFunctionEventInvokeConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := conn.GetFunctionEventInvokeConfig(input)
+	if isAWSErr(err, lambda.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Lambda Function Event Invoke Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error getting Lambda Function Event Invoke Config (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("destination_config", flattenLambdaEventInvokeDestinationConfig(output.DestinationConfig)); err != nil {
+		return fmt.Errorf("Error setting destination_config: %s", err)
+	}
+
+	d.Set("function_name", functionName)
+	d.Set("maximum_event_age_in_seconds", output.MaximumEventAgeInSeconds)
+	d.Set("maximum_retry_attempts", output.MaximumRetryAttempts)
+	d.Set("qualifier", qualifier)
+
+	return nil
+}
+
+func resourceAwsLambdaFunctionEventInvokeConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lambdaconn
+
+	functionName, qualifier, err := resourceAwsLambdaFunctionEventInvokeConfigParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &lambda.PutFunctionEventInvokeConfigInput{
+		DestinationConfig:    expandLambdaEventInvokeDestinationConfig(d.Get("destination_config").([]interface{})),
+		FunctionName:         aws.String(functionName),
+		MaximumRetryAttempts: aws.Int64(int64(d.Get("maximum_retry_attempts").(int))),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if v, ok := d.GetOk("maximum_event_age_in_seconds"); ok {
+		input.MaximumEventAgeInSeconds = aws.Int64(int64(v.(int)))
+	}
+
+	if err := putLambdaFunctionEventInvokeConfig(conn, input); err != nil {
+		return fmt.Errorf("Error putting Lambda Function Event Invoke Config (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsLambdaFunctionEventInvokeConfigRead(d, meta)
+}
+
+func resourceAwsLambdaFunctionEventInvokeConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lambdaconn
+
+	functionName, qualifier, err := resourceAwsLambdaFunctionEventInvokeConfigParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &lambda.DeleteFunctionEventInvokeConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	log.Printf("[DEBUG] Deleting Lambda Function Event Invoke Config: %s", d.Id())
+	_, err = conn.DeleteFunctionEventInvokeConfig(input)
+	if isAWSErr(err, lambda.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error deleting Lambda Function Event Invoke Config (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// putLambdaFunctionEventInvokeConfig retries while newly granted permissions
+// to the function's destinations propagate through IAM.
+func putLambdaFunctionEventInvokeConfig(conn *lambda.Lambda, input *lambda.PutFunctionEventInvokeConfigInput) error {
+	log.Printf("[DEBUG] Putting Lambda Function Event Invoke Config: %s", input)
+
+	return resource.Retry(2*time.Minute, func() *resource.RetryError {
+		_, err := conn.PutFunctionEventInvokeConfig(input)
+		if isAWSErr(err, lambda.ErrCodeInvalidParameterValueException, "execution role does not have permissions") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+// resourceAwsLambdaFunctionEventInvokeConfigParseId splits the ID into the
+// function name or ARN and the optional qualifier. Both
+// FUNCTION[:QUALIFIER] and unqualified or qualified function ARNs are accepted.
+func resourceAwsLambdaFunctionEventInvokeConfigParseId(id string) (string, string, error) {
+	if arn.IsARN(id) {
+		parsedARN, err := arn.Parse(id)
+		if err != nil {
+			return "", "", fmt.Errorf("Error parsing ARN (%s): %s", id, err)
+		}
+
+		function := strings.TrimPrefix(parsedARN.Resource, "function:")
+
+		if !strings.Contains(function, ":") {
+			return id, "", nil
+		}
+
+		qualifier := function[strings.LastIndex(function, ":")+1:]
+
+		return strings.TrimSuffix(id, ":"+qualifier), qualifier, nil
+	}
+
+	parts := strings.Split(id, ":")
+
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return parts[0], "", nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("Unexpected format of ID (%s), expected FUNCTION_NAME, FUNCTION_NAME:QUALIFIER or a function ARN", id)
+}
+
+func expandLambdaEventInvokeDestinationConfig(l []interface{}) *lambda.DestinationConfig {
+	destinationConfig := &lambda.DestinationConfig{}
+
+	if len(l) == 0 || l[0] == nil {
+		return destinationConfig
+	}
+
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["on_failure"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		destinationConfig.OnFailure = &lambda.OnFailure{
+			Destination: aws.String(v[0].(map[string]interface{})["destination"].(string)),
+		}
+	}
+
+	if v, ok := m["on_success"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		destinationConfig.OnSuccess = &lambda.OnSuccess{
+			Destination: aws.String(v[0].(map[string]interface{})["destination"].(string)),
+		}
+	}
+
+	return destinationConfig
+}
+
+func flattenLambdaEventInvokeDestinationConfig(destinationConfig *lambda.DestinationConfig) []interface{} {
+	if destinationConfig == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := destinationConfig.OnFailure; v != nil && aws.StringValue(v.Destination) != "" {
+		m["on_failure"] = []interface{}{
+			map[string]interface{}{
+				"destination": aws.StringValue(v.Destination),
+			},
+		}
+	}
+
+	if v := destinationConfig.OnSuccess; v != nil && aws.StringValue(v.Destination) != "" {
+		m["on_success"] = []interface{}{
+			map[string]interface{}{
+				"destination": aws.StringValue(v.Destination),
+			},
+		}
+	}
+
+	// The API returns an empty DestinationConfig when no destinations are
+	// configured, which is treated the same as none at all
+	if len(m) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{m}
+}
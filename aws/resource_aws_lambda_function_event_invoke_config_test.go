@@ -0,0 +1,317 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAwsLambdaFunctionEventInvokeConfigParseId(t *testing.T) {
+	testCases := []struct {
+		Id                   string
+		ExpectedFunctionName string
+		ExpectedQualifier    string
+		ExpectError          bool
+	}{
+		{
+			Id:          "",
+			ExpectError: true,
+		},
+		{
+			Id:          "test:",
+			ExpectError: true,
+		},
+		{
+			Id:          "test:1:2",
+			ExpectError: true,
+		},
+		{
+			Id:                   "test",
+			ExpectedFunctionName: "test",
+		},
+		{
+			Id:                   "test:$LATEST",
+			ExpectedFunctionName: "test",
+			ExpectedQualifier:    "$LATEST",
+		},
+		{
+			Id:                   "arn:aws:lambda:us-east-1:123456789012:function:test",
+			ExpectedFunctionName: "arn:aws:lambda:us-east-1:123456789012:function:test",
+		},
+		{
+			Id:                   "arn:aws:lambda:us-east-1:123456789012:function:test:live",
+			ExpectedFunctionName: "arn:aws:lambda:us-east-1:123456789012:function:test",
+			ExpectedQualifier:    "live",
+		},
+	}
+
+	for _, tc := range testCases {
+		functionName, qualifier, err := resourceAwsLambdaFunctionEventInvokeConfigParseId(tc.Id)
+
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("expected error for ID %q", tc.Id)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("unexpected error for ID %q: %s", tc.Id, err)
+			continue
+		}
+
+		if functionName != tc.ExpectedFunctionName {
+			t.Errorf("ID %q: expected function name %q, got %q", tc.Id, tc.ExpectedFunctionName, functionName)
+		}
+
+		if qualifier != tc.ExpectedQualifier {
+			t.Errorf("ID %q: expected qualifier %q, got %q", tc.Id, tc.ExpectedQualifier, qualifier)
+		}
+	}
+}
+
+func TestAccAWSLambdaFunctionEventInvokeConfig_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+	lambdaFunctionResourceName := "aws_lambda_function.test"
+	resourceName := "aws_lambda_function_event_invoke_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLambdaFunctionEventInvokeConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLambdaFunctionEventInvokeConfigConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLambdaFunctionEventInvokeConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_config.#", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", lambdaFunctionResourceName, "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_event_age_in_seconds", "0"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_retry_attempts", "2"),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", ""),
+				),
+			},
+			{
+				Config: testAccAWSLambdaFunctionEventInvokeConfigConfigDestinations(rName, 100, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLambdaFunctionEventInvokeConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_config.0.on_failure.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_config.0.on_failure.0.destination", "aws_sqs_queue.failure", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "destination_config.0.on_success.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_config.0.on_success.0.destination", "aws_sqs_queue.success", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_event_age_in_seconds", "100"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_retry_attempts", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSLambdaFunctionEventInvokeConfigConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLambdaFunctionEventInvokeConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_retry_attempts", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLambdaFunctionEventInvokeConfig_qualifier(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+	lambdaFunctionResourceName := "aws_lambda_function.test"
+	resourceName := "aws_lambda_function_event_invoke_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLambdaFunctionEventInvokeConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLambdaFunctionEventInvokeConfigConfigQualifier(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLambdaFunctionEventInvokeConfigExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", lambdaFunctionResourceName, "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "qualifier", lambdaFunctionResourceName, "version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLambdaFunctionEventInvokeConfigDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lambda_function_event_invoke_config" {
+			continue
+		}
+
+		functionName, qualifier, err := resourceAwsLambdaFunctionEventInvokeConfigParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		input := &lambda.GetFunctionEventInvokeConfigInput{
+			FunctionName: aws.String(functionName),
+		}
+
+		if qualifier != "" {
+			input.Qualifier = aws.String(qualifier)
+		}
+
+		_, err = conn.GetFunctionEventInvokeConfig(input)
+		if isAWSErr(err, lambda.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lambda Function Event Invoke Config (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckLambdaFunctionEventInvokeConfigExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lambda Function Event Invoke Config ID is set")
+		}
+
+		functionName, qualifier, err := resourceAwsLambdaFunctionEventInvokeConfigParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		input := &lambda.GetFunctionEventInvokeConfigInput{
+			FunctionName: aws.String(functionName),
+		}
+
+		if qualifier != "" {
+			input.Qualifier = aws.String(qualifier)
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		_, err = conn.GetFunctionEventInvokeConfig(input)
+
+		return err
+	}
+}
+
+func testAccAWSLambdaFunctionEventInvokeConfigConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = "${aws_iam_role.test.id}"
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sqs:SendMessage",
+      "Effect": "Allow",
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = "${aws_iam_role.test.arn}"
+  handler       = "exports.example"
+  publish       = true
+  runtime       = "nodejs12.x"
+}
+`, rName)
+}
+
+func testAccAWSLambdaFunctionEventInvokeConfigConfig(rName string) string {
+	return testAccAWSLambdaFunctionEventInvokeConfigConfigBase(rName) + `
+resource "aws_lambda_function_event_invoke_config" "test" {
+  function_name = "${aws_lambda_function.test.function_name}"
+}
+`
+}
+
+func testAccAWSLambdaFunctionEventInvokeConfigConfigDestinations(rName string, maximumEventAgeInSeconds, maximumRetryAttempts int) string {
+	return testAccAWSLambdaFunctionEventInvokeConfigConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sqs_queue" "failure" {
+  name = "%[1]s-failure"
+}
+
+resource "aws_sqs_queue" "success" {
+  name = "%[1]s-success"
+}
+
+resource "aws_lambda_function_event_invoke_config" "test" {
+  depends_on = ["aws_iam_role_policy.test"]
+
+  function_name                = "${aws_lambda_function.test.function_name}"
+  maximum_event_age_in_seconds = %[2]d
+  maximum_retry_attempts       = %[3]d
+
+  destination_config {
+    on_failure {
+      destination = "${aws_sqs_queue.failure.arn}"
+    }
+
+    on_success {
+      destination = "${aws_sqs_queue.success.arn}"
+    }
+  }
+}
+`, rName, maximumEventAgeInSeconds, maximumRetryAttempts)
+}
+
+func testAccAWSLambdaFunctionEventInvokeConfigConfigQualifier(rName string) string {
+	return testAccAWSLambdaFunctionEventInvokeConfigConfigBase(rName) + `
+resource "aws_lambda_function_event_invoke_config" "test" {
+  function_name = "${aws_lambda_function.test.arn}"
+  qualifier     = "${aws_lambda_function.test.version}"
+}
+`
+}
@@ -23,6 +23,10 @@ func resourceAwsLambdaPermission() *schema.Resource {
 		Read:   resourceAwsLambdaPermissionRead,
 		Delete: resourceAwsLambdaPermissionDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLambdaPermissionImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"action": {
 				Type:         schema.TypeString,
@@ -69,6 +73,31 @@ func resourceAwsLambdaPermission() *schema.Resource {
 	}
 }
 
+func resourceAwsLambdaPermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected FUNCTION-NAME[:QUALIFIER]/STATEMENT-ID", d.Id())
+	}
+
+	functionName, statementId := idParts[0], idParts[1]
+
+	// The qualifier, if any, is the last ":"-separated component of the
+	// function name or ARN
+	fnParts := strings.Split(functionName, ":")
+	if (strings.HasPrefix(functionName, "arn:") && len(fnParts) == 8) ||
+		(!strings.HasPrefix(functionName, "arn:") && len(fnParts) == 2) {
+		qualifier := fnParts[len(fnParts)-1]
+		functionName = strings.TrimSuffix(functionName, ":"+qualifier)
+		d.Set("qualifier", qualifier)
+	}
+
+	d.Set("function_name", functionName)
+	d.Set("statement_id", statementId)
+	d.SetId(statementId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsLambdaPermissionCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lambdaconn
 
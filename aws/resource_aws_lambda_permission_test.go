@@ -178,6 +178,13 @@ func TestAccAWSLambdaPermission_basic(t *testing.T) {
 					resource.TestMatchResourceAttr("aws_lambda_permission.allow_cloudwatch", "function_name", endsWithFuncName),
 				),
 			},
+
+			{
+				ResourceName:      "aws_lambda_permission.allow_cloudwatch",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAwsLambdaPermissionImportStateIdFunc("aws_lambda_permission.allow_cloudwatch"),
+			},
 		},
 	})
 }
@@ -813,3 +820,14 @@ var testLambdaPolicy = []byte(`{
 	],
 	"Id":"default"
 }`)
+
+func testAccAwsLambdaPermissionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["function_name"], rs.Primary.ID), nil
+	}
+}
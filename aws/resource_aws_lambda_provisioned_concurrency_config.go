@@ -0,0 +1,218 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLambdaProvisionedConcurrencyConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLambdaProvisionedConcurrencyConfigCreate,
+		Read:   resourceAwsLambdaProvisionedConcurrencyConfigRead,
+		Update: resourceAwsLambdaProvisionedConcurrencyConfigUpdate,
+		Delete: resourceAwsLambdaProvisionedConcurrencyConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"provisioned_concurrent_executions": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"qualifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceAwsLambdaProvisionedConcurrencyConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lambdaconn
+	functionName := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+
+	input := &lambda.PutProvisionedConcurrencyConfigInput{
+		FunctionName:                    aws.String(functionName),
+		ProvisionedConcurrentExecutions: aws.Int64(int64(d.Get("provisioned_concurrent_executions").(int))),
+		Qualifier:                       aws.String(qualifier),
+	}
+
+	log.Printf("[DEBUG] Creating Lambda Provisioned Concurrency Config: %s", input)
+	_, err := conn.PutProvisionedConcurrencyConfig(input)
+	if err != nil {
+		return fmt.Errorf("Error putting Lambda Provisioned Concurrency Config (%s:%s): %s", functionName, qualifier, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", functionName, qualifier))
+
+	if err := waitForLambdaProvisionedConcurrencyConfigStatusReady(conn, functionName, qualifier, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for Lambda Provisioned Concurrency Config (%s) to be ready: %s", d.Id(), err)
+	}
+
+	return resourceAwsLambdaProvisionedConcurrencyConfigRead(d, meta)
+}
+
+func resourceAwsLambdaProvisionedConcurrencyConfigRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lambdaconn
+
+	functionName, qualifier, err := resourceAwsLambdaProvisionedConcurrencyConfigParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	output, err := conn.GetProvisionedConcurrencyConfig(&lambda.GetProvisionedConcurrencyConfigInput{
+		FunctionName: aws.String(functionName),
+		Qualifier:    aws.String(qualifier),
+	})
+	if isAWSErr(err, lambda.ErrCodeProvisionedConcurrencyConfigNotFoundException, "") || isAWSErr(err, lambda.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Lambda Provisioned Concurrency Config (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error getting Lambda Provisioned Concurrency Config (%s): %s", d.Id(), err)
+	}
+
+	d.Set("function_name", functionName)
+	d.Set("provisioned_concurrent_executions", output.RequestedProvisionedConcurrentExecutions)
+	d.Set("qualifier", qualifier)
+
+	return nil
+}
+
+func resourceAwsLambdaProvisionedConcurrencyConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lambdaconn
+
+	functionName, qualifier, err := resourceAwsLambdaProvisionedConcurrencyConfigParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	input := &lambda.PutProvisionedConcurrencyConfigInput{
+		FunctionName:                    aws.String(functionName),
+		ProvisionedConcurrentExecutions: aws.Int64(int64(d.Get("provisioned_concurrent_executions").(int))),
+		Qualifier:                       aws.String(qualifier),
+	}
+
+	log.Printf("[DEBUG] Updating Lambda Provisioned Concurrency Config: %s", input)
+	_, err = conn.PutProvisionedConcurrencyConfig(input)
+	if err != nil {
+		return fmt.Errorf("Error putting Lambda Provisioned Concurrency Config (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForLambdaProvisionedConcurrencyConfigStatusReady(conn, functionName, qualifier, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("Error waiting for Lambda Provisioned Concurrency Config (%s) to be ready: %s", d.Id(), err)
+	}
+
+	return resourceAwsLambdaProvisionedConcurrencyConfigRead(d, meta)
+}
+
+func resourceAwsLambdaProvisionedConcurrencyConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lambdaconn
+
+	functionName, qualifier, err := resourceAwsLambdaProvisionedConcurrencyConfigParseId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Lambda Provisioned Concurrency Config: %s", d.Id())
+	_, err = conn.DeleteProvisionedConcurrencyConfig(&lambda.DeleteProvisionedConcurrencyConfigInput{
+		FunctionName: aws.String(functionName),
+		Qualifier:    aws.String(qualifier),
+	})
+	if isAWSErr(err, lambda.ErrCodeProvisionedConcurrencyConfigNotFoundException, "") || isAWSErr(err, lambda.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error deleting Lambda Provisioned Concurrency Config (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceAwsLambdaProvisionedConcurrencyConfigParseId(id string) (string, string, error) {
+	if arn.IsARN(id) {
+		parsedARN, err := arn.Parse(id)
+		if err != nil {
+			return "", "", fmt.Errorf("Error parsing ARN (%s): %s", id, err)
+		}
+
+		function := strings.TrimPrefix(parsedARN.Resource, "function:")
+
+		if !strings.Contains(function, ":") {
+			return "", "", fmt.Errorf("Unexpected format of ID (%s), expected FUNCTION_NAME:QUALIFIER or FUNCTION_ARN:QUALIFIER", id)
+		}
+
+		qualifier := function[strings.LastIndex(function, ":")+1:]
+
+		return strings.TrimSuffix(id, ":"+qualifier), qualifier, nil
+	}
+
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Unexpected format of ID (%s), expected FUNCTION_NAME:QUALIFIER or FUNCTION_ARN:QUALIFIER", id)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func refreshLambdaProvisionedConcurrencyConfigStatus(conn *lambda.Lambda, functionName, qualifier string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetProvisionedConcurrencyConfig(&lambda.GetProvisionedConcurrencyConfigInput{
+			FunctionName: aws.String(functionName),
+			Qualifier:    aws.String(qualifier),
+		})
+		if err != nil {
+			return "", "", err
+		}
+
+		status := aws.StringValue(output.Status)
+
+		if status == lambda.ProvisionedConcurrencyStatusEnumFailed {
+			return output, status, fmt.Errorf("status reason: %s", aws.StringValue(output.StatusReason))
+		}
+
+		return output, status, nil
+	}
+}
+
+func waitForLambdaProvisionedConcurrencyConfigStatusReady(conn *lambda.Lambda, functionName, qualifier string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{lambda.ProvisionedConcurrencyStatusEnumInProgress},
+		Target:  []string{lambda.ProvisionedConcurrencyStatusEnumReady},
+		Refresh: refreshLambdaProvisionedConcurrencyConfigStatus(conn, functionName, qualifier),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+
+	return err
+}
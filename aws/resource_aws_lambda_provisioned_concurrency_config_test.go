@@ -0,0 +1,212 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestResourceAwsLambdaProvisionedConcurrencyConfigParseId(t *testing.T) {
+	testCases := []struct {
+		Id                   string
+		ExpectedFunctionName string
+		ExpectedQualifier    string
+		ExpectError          bool
+	}{
+		{
+			Id:          "",
+			ExpectError: true,
+		},
+		{
+			Id:          "test",
+			ExpectError: true,
+		},
+		{
+			Id:          "test:",
+			ExpectError: true,
+		},
+		{
+			Id:                   "test:live",
+			ExpectedFunctionName: "test",
+			ExpectedQualifier:    "live",
+		},
+		{
+			Id:          "arn:aws:lambda:us-east-1:123456789012:function:test",
+			ExpectError: true,
+		},
+		{
+			Id:                   "arn:aws:lambda:us-east-1:123456789012:function:test:live",
+			ExpectedFunctionName: "arn:aws:lambda:us-east-1:123456789012:function:test",
+			ExpectedQualifier:    "live",
+		},
+	}
+
+	for _, tc := range testCases {
+		functionName, qualifier, err := resourceAwsLambdaProvisionedConcurrencyConfigParseId(tc.Id)
+
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("expected error for ID %q", tc.Id)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("unexpected error for ID %q: %s", tc.Id, err)
+			continue
+		}
+
+		if functionName != tc.ExpectedFunctionName {
+			t.Errorf("ID %q: expected function name %q, got %q", tc.Id, tc.ExpectedFunctionName, functionName)
+		}
+
+		if qualifier != tc.ExpectedQualifier {
+			t.Errorf("ID %q: expected qualifier %q, got %q", tc.Id, tc.ExpectedQualifier, qualifier)
+		}
+	}
+}
+
+func TestAccAWSLambdaProvisionedConcurrencyConfig_basic(t *testing.T) {
+	rName := fmt.Sprintf("tf-acc-test-%d", acctest.RandInt())
+	lambdaFunctionResourceName := "aws_lambda_function.test"
+	resourceName := "aws_lambda_provisioned_concurrency_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLambdaProvisionedConcurrencyConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLambdaProvisionedConcurrencyConfigConfig(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLambdaProvisionedConcurrencyConfigExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", lambdaFunctionResourceName, "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_concurrent_executions", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "qualifier", lambdaFunctionResourceName, "version"),
+				),
+			},
+			{
+				Config: testAccAWSLambdaProvisionedConcurrencyConfigConfig(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLambdaProvisionedConcurrencyConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "provisioned_concurrent_executions", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLambdaProvisionedConcurrencyConfigDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lambda_provisioned_concurrency_config" {
+			continue
+		}
+
+		functionName, qualifier, err := resourceAwsLambdaProvisionedConcurrencyConfigParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetProvisionedConcurrencyConfig(&lambda.GetProvisionedConcurrencyConfigInput{
+			FunctionName: aws.String(functionName),
+			Qualifier:    aws.String(qualifier),
+		})
+		if isAWSErr(err, lambda.ErrCodeProvisionedConcurrencyConfigNotFoundException, "") || isAWSErr(err, lambda.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lambda Provisioned Concurrency Config (%s) still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckLambdaProvisionedConcurrencyConfigExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lambda Provisioned Concurrency Config ID is set")
+		}
+
+		functionName, qualifier, err := resourceAwsLambdaProvisionedConcurrencyConfigParseId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lambdaconn
+		output, err := conn.GetProvisionedConcurrencyConfig(&lambda.GetProvisionedConcurrencyConfigInput{
+			FunctionName: aws.String(functionName),
+			Qualifier:    aws.String(qualifier),
+		})
+		if err != nil {
+			return err
+		}
+
+		if status := aws.StringValue(output.Status); status != lambda.ProvisionedConcurrencyStatusEnumReady {
+			return fmt.Errorf("Lambda Provisioned Concurrency Config (%s) expected status (%s), got: %s", rs.Primary.ID, lambda.ProvisionedConcurrencyStatusEnumReady, status)
+		}
+
+		return nil
+	}
+}
+
+func testAccAWSLambdaProvisionedConcurrencyConfigConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "lambda.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = "${aws_iam_role.test.arn}"
+  handler       = "exports.example"
+  publish       = true
+  runtime       = "nodejs12.x"
+}
+`, rName)
+}
+
+func testAccAWSLambdaProvisionedConcurrencyConfigConfig(rName string, provisionedConcurrentExecutions int) string {
+	return testAccAWSLambdaProvisionedConcurrencyConfigConfigBase(rName) + fmt.Sprintf(`
+resource "aws_lambda_provisioned_concurrency_config" "test" {
+  function_name                     = "${aws_lambda_function.test.function_name}"
+  provisioned_concurrent_executions = %d
+  qualifier                         = "${aws_lambda_function.test.version}"
+}
+`, provisionedConcurrentExecutions)
+}
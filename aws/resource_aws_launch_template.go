@@ -0,0 +1,953 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLaunchTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceAwsLaunchTemplateCreate,
+		Read:          resourceAwsLaunchTemplateRead,
+		Update:        resourceAwsLaunchTemplateUpdate,
+		Delete:        resourceAwsLaunchTemplateDelete,
+		CustomizeDiff: resourceAwsLaunchTemplateCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"name_prefix"},
+				ValidateFunc:  validateLaunchTemplateName,
+			},
+
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					// uuid is 26 characters, limit the prefix to 102.
+					value := v.(string)
+					if len(value) > 102 {
+						errors = append(errors, fmt.Errorf(
+							"%q cannot be longer than 102 characters, name is limited to 128", k))
+					}
+					return
+				},
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+			},
+
+			"default_version": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"update_default_version"},
+				ValidateFunc:  validation.IntAtLeast(1),
+			},
+
+			"update_default_version": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"default_version"},
+			},
+
+			"latest_version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"block_device_mappings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"no_device": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"virtual_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ebs": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delete_on_termination": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  true,
+									},
+									"encrypted": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"iops": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"kms_key_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateArn,
+									},
+									"snapshot_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"volume_size": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+									"volume_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"credit_specification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_credits": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"standard",
+								"unlimited",
+							}, false),
+						},
+					},
+				},
+			},
+
+			"disable_api_termination": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"ebs_optimized": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"iam_instance_profile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"iam_instance_profile.0.name"},
+							ValidateFunc:  validateArn,
+						},
+						"name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"iam_instance_profile.0.arn"},
+						},
+					},
+				},
+			},
+
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"instance_initiated_shutdown_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.ShutdownBehaviorStop,
+					ec2.ShutdownBehaviorTerminate,
+				}, false),
+			},
+
+			"instance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"kernel_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"key_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"monitoring": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"network_interfaces": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"associate_public_ip_address": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"delete_on_termination": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"device_index": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"security_groups": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ipv4_addresses": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"ipv4_address_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"placement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"affinity": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"group_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"host_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tenancy": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.TenancyDedicated,
+								ec2.TenancyDefault,
+								ec2.TenancyHost,
+							}, false),
+						},
+					},
+				},
+			},
+
+			"ram_disk_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"security_group_names": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"vpc_security_group_ids"},
+			},
+
+			"vpc_security_group_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"security_group_names"},
+			},
+
+			"tag_specifications": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								ec2.ResourceTypeInstance,
+								ec2.ResourceTypeVolume,
+							}, false),
+						},
+						"tags": tagsSchema(),
+					},
+				},
+			},
+
+			"user_data": {
+				Type:     schema.TypeString,
+				Optional: true,
+				StateFunc: func(v interface{}) string {
+					switch v.(type) {
+					case string:
+						return userDataHashSum(v.(string))
+					default:
+						return ""
+					}
+				},
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
+		},
+	}
+}
+
+// launchTemplateDataKeys lists the arguments making up the launch template
+// data. A change to any of them creates a new version of the template.
+var launchTemplateDataKeys = []string{
+	"description",
+	"block_device_mappings",
+	"credit_specification",
+	"disable_api_termination",
+	"ebs_optimized",
+	"iam_instance_profile",
+	"image_id",
+	"instance_initiated_shutdown_behavior",
+	"instance_type",
+	"kernel_id",
+	"key_name",
+	"monitoring",
+	"network_interfaces",
+	"placement",
+	"ram_disk_id",
+	"security_group_names",
+	"vpc_security_group_ids",
+	"tag_specifications",
+	"user_data",
+}
+
+func launchTemplateDataChanged(d interface {
+	HasChange(string) bool
+}) bool {
+	for _, k := range launchTemplateDataKeys {
+		if d.HasChange(k) {
+			return true
+		}
+	}
+	return false
+}
+
+func resourceAwsLaunchTemplateCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && launchTemplateDataChanged(d) {
+		if err := d.SetNewComputed("latest_version"); err != nil {
+			return err
+		}
+		if d.Get("update_default_version").(bool) {
+			if err := d.SetNewComputed("default_version"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return setTagsAllDiff(d, meta)
+}
+
+func resourceAwsLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	var ltName string
+	if v, ok := d.GetOk("name"); ok {
+		ltName = v.(string)
+	} else if v, ok := d.GetOk("name_prefix"); ok {
+		ltName = resource.PrefixedUniqueId(v.(string))
+	} else {
+		ltName = resource.UniqueId()
+	}
+
+	ltData, err := buildLaunchTemplateData(d)
+	if err != nil {
+		return err
+	}
+
+	launchTemplateOpts := &ec2.CreateLaunchTemplateInput{
+		ClientToken:        aws.String(resource.UniqueId()),
+		LaunchTemplateName: aws.String(ltName),
+		LaunchTemplateData: ltData,
+	}
+	if v, ok := d.GetOk("description"); ok {
+		launchTemplateOpts.VersionDescription = aws.String(v.(string))
+	}
+	if tags := tagsFromMap(d.Get("tags_all").(map[string]interface{})); len(tags) > 0 {
+		launchTemplateOpts.TagSpecifications = []*ec2.TagSpecification{
+			{
+				ResourceType: aws.String(ec2.ResourceTypeLaunchTemplate),
+				Tags:         tags,
+			},
+		}
+	}
+
+	log.Printf("[DEBUG] Launch Template create config: %s", launchTemplateOpts)
+	resp, err := conn.CreateLaunchTemplate(launchTemplateOpts)
+	if err != nil {
+		return fmt.Errorf("Error creating Launch Template: %s", err)
+	}
+
+	d.SetId(*resp.LaunchTemplate.LaunchTemplateId)
+	log.Printf("[INFO] Launch Template ID: %s", d.Id())
+
+	if v, ok := d.GetOk("default_version"); ok && int64(v.(int)) != *resp.LaunchTemplate.DefaultVersionNumber {
+		if err := setLaunchTemplateDefaultVersion(conn, d.Id(), int64(v.(int))); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsLaunchTemplateRead(d, meta)
+}
+
+func resourceAwsLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Reading Launch Template %s", d.Id())
+	resp, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateIds: []*string{aws.String(d.Id())},
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") ||
+			isAWSErr(err, "InvalidLaunchTemplateId.Malformed", "") {
+			log.Printf("[WARN] Launch Template %q not found - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading Launch Template %s: %s", d.Id(), err)
+	}
+	if len(resp.LaunchTemplates) == 0 {
+		log.Printf("[WARN] Launch Template %q not found - removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	lt := resp.LaunchTemplates[0]
+
+	// The configuration describes the latest version of the template
+	version := strconv.FormatInt(*lt.LatestVersionNumber, 10)
+	vresp, err := conn.DescribeLaunchTemplateVersions(&ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(d.Id()),
+		Versions:         []*string{aws.String(version)},
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading version %s of Launch Template %s: %s", version, d.Id(), err)
+	}
+	if len(vresp.LaunchTemplateVersions) == 0 {
+		return fmt.Errorf("Version %s of Launch Template %s not found", version, d.Id())
+	}
+	ltv := vresp.LaunchTemplateVersions[0]
+
+	d.Set("name", lt.LaunchTemplateName)
+	d.Set("latest_version", lt.LatestVersionNumber)
+	d.Set("default_version", lt.DefaultVersionNumber)
+	d.Set("description", ltv.VersionDescription)
+	d.Set("arn", arnString(
+		meta.(*AWSClient).partition,
+		meta.(*AWSClient).region,
+		"ec2",
+		meta.(*AWSClient).accountid,
+		fmt.Sprintf("launch-template/%s", d.Id()),
+	))
+
+	if err := setTagsAll(d, meta, tagsToMap(lt.Tags)); err != nil {
+		return err
+	}
+
+	return setLaunchTemplateData(d, ltv.LaunchTemplateData)
+}
+
+func resourceAwsLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	d.Partial(true)
+
+	if d.HasChange("tags_all") {
+		if err := setTags(conn, d); err != nil {
+			return err
+		}
+		d.SetPartial("tags")
+		d.SetPartial("tags_all")
+	}
+
+	var newVersion int64
+	if launchTemplateDataChanged(d) {
+		ltData, err := buildLaunchTemplateData(d)
+		if err != nil {
+			return err
+		}
+
+		launchTemplateVersionOpts := &ec2.CreateLaunchTemplateVersionInput{
+			ClientToken:        aws.String(resource.UniqueId()),
+			LaunchTemplateId:   aws.String(d.Id()),
+			LaunchTemplateData: ltData,
+		}
+		if v, ok := d.GetOk("description"); ok {
+			launchTemplateVersionOpts.VersionDescription = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Creating Launch Template version: %s", launchTemplateVersionOpts)
+		resp, err := conn.CreateLaunchTemplateVersion(launchTemplateVersionOpts)
+		if err != nil {
+			return fmt.Errorf("Error creating version of Launch Template %s: %s", d.Id(), err)
+		}
+		newVersion = *resp.LaunchTemplateVersion.VersionNumber
+		log.Printf("[INFO] Created version %d of Launch Template %s", newVersion, d.Id())
+	}
+
+	var defaultVersion int64
+	if d.Get("update_default_version").(bool) && newVersion != 0 {
+		defaultVersion = newVersion
+	} else if d.HasChange("default_version") {
+		defaultVersion = int64(d.Get("default_version").(int))
+	}
+	if defaultVersion != 0 {
+		if err := setLaunchTemplateDefaultVersion(conn, d.Id(), defaultVersion); err != nil {
+			return err
+		}
+	}
+
+	d.Partial(false)
+
+	return resourceAwsLaunchTemplateRead(d, meta)
+}
+
+func resourceAwsLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting Launch Template %s", d.Id())
+	_, err := conn.DeleteLaunchTemplate(&ec2.DeleteLaunchTemplateInput{
+		LaunchTemplateId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Launch Template %s: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func setLaunchTemplateDefaultVersion(conn *ec2.EC2, id string, version int64) error {
+	log.Printf("[DEBUG] Setting default version of Launch Template %s to %d", id, version)
+	_, err := conn.ModifyLaunchTemplate(&ec2.ModifyLaunchTemplateInput{
+		LaunchTemplateId: aws.String(id),
+		DefaultVersion:   aws.String(strconv.FormatInt(version, 10)),
+	})
+	if err != nil {
+		return fmt.Errorf("Error setting default version of Launch Template %s: %s", id, err)
+	}
+	return nil
+}
+
+func buildLaunchTemplateData(d *schema.ResourceData) (*ec2.RequestLaunchTemplateData, error) {
+	opts := &ec2.RequestLaunchTemplateData{
+		DisableApiTermination: aws.Bool(d.Get("disable_api_termination").(bool)),
+		EbsOptimized:          aws.Bool(d.Get("ebs_optimized").(bool)),
+	}
+
+	if v, ok := d.GetOk("image_id"); ok {
+		opts.ImageId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("instance_initiated_shutdown_behavior"); ok {
+		opts.InstanceInitiatedShutdownBehavior = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("instance_type"); ok {
+		opts.InstanceType = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("kernel_id"); ok {
+		opts.KernelId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("key_name"); ok {
+		opts.KeyName = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("ram_disk_id"); ok {
+		opts.RamDiskId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("user_data"); ok {
+		opts.UserData = aws.String(base64Encode([]byte(v.(string))))
+	}
+	if v, ok := d.GetOk("security_group_names"); ok {
+		opts.SecurityGroups = expandStringSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk("vpc_security_group_ids"); ok {
+		opts.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("block_device_mappings"); ok {
+		opts.BlockDeviceMappings = expandLaunchTemplateBlockDeviceMappings(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("credit_specification"); ok {
+		if m, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			opts.CreditSpecification = &ec2.CreditSpecificationRequest{
+				CpuCredits: aws.String(m["cpu_credits"].(string)),
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("iam_instance_profile"); ok {
+		if m, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			profile := &ec2.LaunchTemplateIamInstanceProfileSpecificationRequest{}
+			if v := m["arn"].(string); v != "" {
+				profile.Arn = aws.String(v)
+			}
+			if v := m["name"].(string); v != "" {
+				profile.Name = aws.String(v)
+			}
+			opts.IamInstanceProfile = profile
+		}
+	}
+
+	if v, ok := d.GetOk("monitoring"); ok {
+		if m, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			opts.Monitoring = &ec2.LaunchTemplatesMonitoringRequest{
+				Enabled: aws.Bool(m["enabled"].(bool)),
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("network_interfaces"); ok {
+		opts.NetworkInterfaces = expandLaunchTemplateNetworkInterfaces(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("placement"); ok {
+		if m, ok := v.([]interface{})[0].(map[string]interface{}); ok {
+			placement := &ec2.LaunchTemplatePlacementRequest{}
+			if v := m["affinity"].(string); v != "" {
+				placement.Affinity = aws.String(v)
+			}
+			if v := m["availability_zone"].(string); v != "" {
+				placement.AvailabilityZone = aws.String(v)
+			}
+			if v := m["group_name"].(string); v != "" {
+				placement.GroupName = aws.String(v)
+			}
+			if v := m["host_id"].(string); v != "" {
+				placement.HostId = aws.String(v)
+			}
+			if v := m["tenancy"].(string); v != "" {
+				placement.Tenancy = aws.String(v)
+			}
+			opts.Placement = placement
+		}
+	}
+
+	if v, ok := d.GetOk("tag_specifications"); ok {
+		for _, raw := range v.([]interface{}) {
+			m := raw.(map[string]interface{})
+			opts.TagSpecifications = append(opts.TagSpecifications, &ec2.LaunchTemplateTagSpecificationRequest{
+				ResourceType: aws.String(m["resource_type"].(string)),
+				Tags:         tagsFromMap(m["tags"].(map[string]interface{})),
+			})
+		}
+	}
+
+	return opts, nil
+}
+
+func expandLaunchTemplateBlockDeviceMappings(l []interface{}) []*ec2.LaunchTemplateBlockDeviceMappingRequest {
+	var mappings []*ec2.LaunchTemplateBlockDeviceMappingRequest
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		mapping := &ec2.LaunchTemplateBlockDeviceMappingRequest{
+			DeviceName: aws.String(m["device_name"].(string)),
+		}
+		if v := m["no_device"].(string); v != "" {
+			mapping.NoDevice = aws.String(v)
+		}
+		if v := m["virtual_name"].(string); v != "" {
+			mapping.VirtualName = aws.String(v)
+		}
+		if v, ok := m["ebs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			ebs := v[0].(map[string]interface{})
+			mapping.Ebs = &ec2.LaunchTemplateEbsBlockDeviceRequest{
+				DeleteOnTermination: aws.Bool(ebs["delete_on_termination"].(bool)),
+			}
+			if ebs["encrypted"].(bool) {
+				mapping.Ebs.Encrypted = aws.Bool(true)
+			}
+			if v := ebs["iops"].(int); v > 0 {
+				mapping.Ebs.Iops = aws.Int64(int64(v))
+			}
+			if v := ebs["kms_key_id"].(string); v != "" {
+				mapping.Ebs.KmsKeyId = aws.String(v)
+			}
+			if v := ebs["snapshot_id"].(string); v != "" {
+				mapping.Ebs.SnapshotId = aws.String(v)
+			}
+			if v := ebs["volume_size"].(int); v > 0 {
+				mapping.Ebs.VolumeSize = aws.Int64(int64(v))
+			}
+			if v := ebs["volume_type"].(string); v != "" {
+				mapping.Ebs.VolumeType = aws.String(v)
+			}
+		}
+		mappings = append(mappings, mapping)
+	}
+	return mappings
+}
+
+func expandLaunchTemplateNetworkInterfaces(l []interface{}) []*ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest {
+	var interfaces []*ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest
+	for _, raw := range l {
+		m := raw.(map[string]interface{})
+		ni := &ec2.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{
+			DeviceIndex: aws.Int64(int64(m["device_index"].(int))),
+		}
+		if m["associate_public_ip_address"].(bool) {
+			ni.AssociatePublicIpAddress = aws.Bool(true)
+		}
+		if m["delete_on_termination"].(bool) {
+			ni.DeleteOnTermination = aws.Bool(true)
+		}
+		if v := m["description"].(string); v != "" {
+			ni.Description = aws.String(v)
+		}
+		if v := m["network_interface_id"].(string); v != "" {
+			ni.NetworkInterfaceId = aws.String(v)
+		}
+		if v := m["private_ip_address"].(string); v != "" {
+			ni.PrivateIpAddress = aws.String(v)
+		}
+		if v := m["subnet_id"].(string); v != "" {
+			ni.SubnetId = aws.String(v)
+		}
+		if v := m["security_groups"].(*schema.Set); v.Len() > 0 {
+			ni.Groups = expandStringSet(v)
+		}
+		if v := m["ipv4_address_count"].(int); v > 0 {
+			ni.SecondaryPrivateIpAddressCount = aws.Int64(int64(v))
+		}
+		addresses := expandStringSet(m["ipv4_addresses"].(*schema.Set))
+		sort.Slice(addresses, func(i, j int) bool { return *addresses[i] < *addresses[j] })
+		for _, address := range addresses {
+			ni.PrivateIpAddresses = append(ni.PrivateIpAddresses, &ec2.PrivateIpAddressSpecification{
+				Primary:          aws.Bool(false),
+				PrivateIpAddress: address,
+			})
+		}
+		interfaces = append(interfaces, ni)
+	}
+	return interfaces
+}
+
+func setLaunchTemplateData(d *schema.ResourceData, ltData *ec2.ResponseLaunchTemplateData) error {
+	if ltData == nil {
+		return nil
+	}
+
+	d.Set("disable_api_termination", ltData.DisableApiTermination)
+	d.Set("ebs_optimized", ltData.EbsOptimized)
+	d.Set("image_id", ltData.ImageId)
+	d.Set("instance_initiated_shutdown_behavior", ltData.InstanceInitiatedShutdownBehavior)
+	d.Set("instance_type", ltData.InstanceType)
+	d.Set("kernel_id", ltData.KernelId)
+	d.Set("key_name", ltData.KeyName)
+	d.Set("ram_disk_id", ltData.RamDiskId)
+	d.Set("security_group_names", flattenStringList(ltData.SecurityGroups))
+	d.Set("vpc_security_group_ids", flattenStringList(ltData.SecurityGroupIds))
+	if ltData.UserData != nil {
+		d.Set("user_data", userDataHashSum(*ltData.UserData))
+	} else {
+		d.Set("user_data", "")
+	}
+
+	if err := d.Set("block_device_mappings", flattenLaunchTemplateBlockDeviceMappings(ltData.BlockDeviceMappings)); err != nil {
+		return fmt.Errorf("Error setting block_device_mappings: %s", err)
+	}
+
+	var creditSpecification []interface{}
+	if ltData.CreditSpecification != nil {
+		creditSpecification = append(creditSpecification, map[string]interface{}{
+			"cpu_credits": aws.StringValue(ltData.CreditSpecification.CpuCredits),
+		})
+	}
+	if err := d.Set("credit_specification", creditSpecification); err != nil {
+		return fmt.Errorf("Error setting credit_specification: %s", err)
+	}
+
+	var iamInstanceProfile []interface{}
+	if ltData.IamInstanceProfile != nil {
+		iamInstanceProfile = append(iamInstanceProfile, map[string]interface{}{
+			"arn":  aws.StringValue(ltData.IamInstanceProfile.Arn),
+			"name": aws.StringValue(ltData.IamInstanceProfile.Name),
+		})
+	}
+	if err := d.Set("iam_instance_profile", iamInstanceProfile); err != nil {
+		return fmt.Errorf("Error setting iam_instance_profile: %s", err)
+	}
+
+	var monitoring []interface{}
+	if ltData.Monitoring != nil {
+		monitoring = append(monitoring, map[string]interface{}{
+			"enabled": aws.BoolValue(ltData.Monitoring.Enabled),
+		})
+	}
+	if err := d.Set("monitoring", monitoring); err != nil {
+		return fmt.Errorf("Error setting monitoring: %s", err)
+	}
+
+	if err := d.Set("network_interfaces", flattenLaunchTemplateNetworkInterfaces(ltData.NetworkInterfaces)); err != nil {
+		return fmt.Errorf("Error setting network_interfaces: %s", err)
+	}
+
+	var placement []interface{}
+	if p := ltData.Placement; p != nil {
+		placement = append(placement, map[string]interface{}{
+			"affinity":          aws.StringValue(p.Affinity),
+			"availability_zone": aws.StringValue(p.AvailabilityZone),
+			"group_name":        aws.StringValue(p.GroupName),
+			"host_id":           aws.StringValue(p.HostId),
+			"tenancy":           aws.StringValue(p.Tenancy),
+		})
+	}
+	if err := d.Set("placement", placement); err != nil {
+		return fmt.Errorf("Error setting placement: %s", err)
+	}
+
+	var tagSpecifications []interface{}
+	for _, ts := range ltData.TagSpecifications {
+		tagSpecifications = append(tagSpecifications, map[string]interface{}{
+			"resource_type": aws.StringValue(ts.ResourceType),
+			"tags":          tagsToMap(ts.Tags),
+		})
+	}
+	if err := d.Set("tag_specifications", tagSpecifications); err != nil {
+		return fmt.Errorf("Error setting tag_specifications: %s", err)
+	}
+
+	return nil
+}
+
+func flattenLaunchTemplateBlockDeviceMappings(mappings []*ec2.LaunchTemplateBlockDeviceMapping) []interface{} {
+	var result []interface{}
+	for _, mapping := range mappings {
+		m := map[string]interface{}{
+			"device_name":  aws.StringValue(mapping.DeviceName),
+			"no_device":    aws.StringValue(mapping.NoDevice),
+			"virtual_name": aws.StringValue(mapping.VirtualName),
+		}
+		if ebs := mapping.Ebs; ebs != nil {
+			m["ebs"] = []interface{}{
+				map[string]interface{}{
+					"delete_on_termination": aws.BoolValue(ebs.DeleteOnTermination),
+					"encrypted":             aws.BoolValue(ebs.Encrypted),
+					"iops":                  int(aws.Int64Value(ebs.Iops)),
+					"kms_key_id":            aws.StringValue(ebs.KmsKeyId),
+					"snapshot_id":           aws.StringValue(ebs.SnapshotId),
+					"volume_size":           int(aws.Int64Value(ebs.VolumeSize)),
+					"volume_type":           aws.StringValue(ebs.VolumeType),
+				},
+			}
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+func flattenLaunchTemplateNetworkInterfaces(interfaces []*ec2.LaunchTemplateInstanceNetworkInterfaceSpecification) []interface{} {
+	var result []interface{}
+	for _, ni := range interfaces {
+		var addresses []*string
+		for _, address := range ni.PrivateIpAddresses {
+			addresses = append(addresses, address.PrivateIpAddress)
+		}
+		result = append(result, map[string]interface{}{
+			"associate_public_ip_address": aws.BoolValue(ni.AssociatePublicIpAddress),
+			"delete_on_termination":       aws.BoolValue(ni.DeleteOnTermination),
+			"description":                 aws.StringValue(ni.Description),
+			"device_index":                int(aws.Int64Value(ni.DeviceIndex)),
+			"security_groups":             schema.NewSet(schema.HashString, flattenStringList(ni.Groups)),
+			"network_interface_id":        aws.StringValue(ni.NetworkInterfaceId),
+			"private_ip_address":          aws.StringValue(ni.PrivateIpAddress),
+			"ipv4_addresses":              schema.NewSet(schema.HashString, flattenStringList(addresses)),
+			"ipv4_address_count":          int(aws.Int64Value(ni.SecondaryPrivateIpAddressCount)),
+			"subnet_id":                   aws.StringValue(ni.SubnetId),
+		})
+	}
+	return result
+}
@@ -0,0 +1,346 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLaunchTemplate_basic(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rName := fmt.Sprintf("tf-acc-lt-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "name", rName),
+					resource.TestCheckResourceAttr(resName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "1"),
+					resource.TestCheckResourceAttrSet(resName, "arn"),
+					resource.TestCheckResourceAttr(resName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSLaunchTemplate_data(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rName := fmt.Sprintf("tf-acc-lt-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_data(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "block_device_mappings.#", "1"),
+					resource.TestCheckResourceAttr(resName, "block_device_mappings.0.ebs.0.volume_size", "20"),
+					resource.TestCheckResourceAttr(resName, "credit_specification.0.cpu_credits", "unlimited"),
+					resource.TestCheckResourceAttr(resName, "iam_instance_profile.#", "1"),
+					resource.TestCheckResourceAttr(resName, "monitoring.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resName, "network_interfaces.#", "1"),
+					resource.TestCheckResourceAttr(resName, "network_interfaces.0.associate_public_ip_address", "true"),
+					resource.TestCheckResourceAttr(resName, "tag_specifications.#", "1"),
+					resource.TestCheckResourceAttrSet(resName, "user_data"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLaunchTemplate_update(t *testing.T) {
+	var template ec2.LaunchTemplate
+	resName := "aws_launch_template.foo"
+	rName := fmt.Sprintf("tf-acc-lt-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLaunchTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLaunchTemplateConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "instance_type", "t2.micro"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSLaunchTemplateConfig_updateDefaultVersion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLaunchTemplateExists(resName, &template),
+					resource.TestCheckResourceAttr(resName, "instance_type", "t2.small"),
+					resource.TestCheckResourceAttr(resName, "default_version", "2"),
+					resource.TestCheckResourceAttr(resName, "latest_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildLaunchTemplateData(t *testing.T) {
+	r := resourceAwsLaunchTemplate()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"image_id":      "ami-12345678",
+		"instance_type": "t2.micro",
+		"user_data":     "#!/bin/sh\necho hello",
+		"block_device_mappings": []interface{}{
+			map[string]interface{}{
+				"device_name": "/dev/sda1",
+				"ebs": []interface{}{
+					map[string]interface{}{
+						"volume_size": 20,
+					},
+				},
+			},
+		},
+		"credit_specification": []interface{}{
+			map[string]interface{}{
+				"cpu_credits": "unlimited",
+			},
+		},
+		"iam_instance_profile": []interface{}{
+			map[string]interface{}{
+				"name": "test",
+			},
+		},
+		"network_interfaces": []interface{}{
+			map[string]interface{}{
+				"associate_public_ip_address": true,
+				"subnet_id":                   "subnet-12345678",
+				"ipv4_addresses":              []interface{}{"10.0.0.11", "10.0.0.10"},
+			},
+		},
+		"tag_specifications": []interface{}{
+			map[string]interface{}{
+				"resource_type": "instance",
+				"tags": map[string]interface{}{
+					"Name": "test",
+				},
+			},
+		},
+	})
+
+	data, err := buildLaunchTemplateData(d)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if aws.StringValue(data.ImageId) != "ami-12345678" || aws.StringValue(data.InstanceType) != "t2.micro" {
+		t.Fatalf("Unexpected image and instance type: %s", data)
+	}
+	if aws.StringValue(data.UserData) != "IyEvYmluL3NoCmVjaG8gaGVsbG8=" {
+		t.Fatalf("Expected base64 encoded user data, got %s", aws.StringValue(data.UserData))
+	}
+
+	if len(data.BlockDeviceMappings) != 1 {
+		t.Fatalf("Expected 1 block device mapping, got %d", len(data.BlockDeviceMappings))
+	}
+	ebs := data.BlockDeviceMappings[0].Ebs
+	if aws.Int64Value(ebs.VolumeSize) != 20 || !aws.BoolValue(ebs.DeleteOnTermination) || ebs.Encrypted != nil {
+		t.Fatalf("Unexpected EBS settings: %s", ebs)
+	}
+
+	if aws.StringValue(data.CreditSpecification.CpuCredits) != "unlimited" {
+		t.Fatalf("Unexpected credit specification: %s", data.CreditSpecification)
+	}
+	if aws.StringValue(data.IamInstanceProfile.Name) != "test" || data.IamInstanceProfile.Arn != nil {
+		t.Fatalf("Unexpected IAM instance profile: %s", data.IamInstanceProfile)
+	}
+
+	if len(data.NetworkInterfaces) != 1 {
+		t.Fatalf("Expected 1 network interface, got %d", len(data.NetworkInterfaces))
+	}
+	ni := data.NetworkInterfaces[0]
+	if !aws.BoolValue(ni.AssociatePublicIpAddress) || aws.StringValue(ni.SubnetId) != "subnet-12345678" {
+		t.Fatalf("Unexpected network interface: %s", ni)
+	}
+	if len(ni.PrivateIpAddresses) != 2 || aws.StringValue(ni.PrivateIpAddresses[0].PrivateIpAddress) != "10.0.0.10" {
+		t.Fatalf("Unexpected private IP addresses: %s", ni.PrivateIpAddresses)
+	}
+
+	if len(data.TagSpecifications) != 1 || aws.StringValue(data.TagSpecifications[0].ResourceType) != "instance" {
+		t.Fatalf("Unexpected tag specifications: %s", data.TagSpecifications)
+	}
+	if data.Placement != nil || data.Monitoring != nil {
+		t.Fatalf("Expected no placement and monitoring, got %s, %s", data.Placement, data.Monitoring)
+	}
+}
+
+func testAccCheckAWSLaunchTemplateExists(n string, t *ec2.LaunchTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Launch Template ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+		resp, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+			LaunchTemplateIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(resp.LaunchTemplates) != 1 || *resp.LaunchTemplates[0].LaunchTemplateId != rs.Primary.ID {
+			return fmt.Errorf("Launch Template not found")
+		}
+
+		*t = *resp.LaunchTemplates[0]
+
+		return nil
+	}
+}
+
+func testAccCheckAWSLaunchTemplateDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_launch_template" {
+			continue
+		}
+
+		resp, err := conn.DescribeLaunchTemplates(&ec2.DescribeLaunchTemplatesInput{
+			LaunchTemplateIds: []*string{aws.String(rs.Primary.ID)},
+		})
+		if err == nil {
+			if len(resp.LaunchTemplates) != 0 && *resp.LaunchTemplates[0].LaunchTemplateId == rs.Primary.ID {
+				return fmt.Errorf("Launch Template still exists")
+			}
+		}
+
+		if isAWSErr(err, "InvalidLaunchTemplateId.NotFound", "") {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAWSLaunchTemplateConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name          = "%s"
+  image_id      = "ami-21f78e11"
+  instance_type = "t2.micro"
+
+  tags {
+    Name = "%s"
+  }
+}
+`, rName, rName)
+}
+
+func testAccAWSLaunchTemplateConfig_updateDefaultVersion(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "foo" {
+  name                   = "%s"
+  image_id               = "ami-21f78e11"
+  instance_type          = "t2.small"
+  update_default_version = true
+
+  tags {
+    Name = "%s"
+  }
+}
+`, rName, rName)
+}
+
+func testAccAWSLaunchTemplateConfig_data(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = "%s"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Action": "sts:AssumeRole",
+      "Principal": {
+        "Service": "ec2.amazonaws.com"
+      },
+      "Effect": "Allow"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_instance_profile" "test" {
+  name = "%s"
+  role = "${aws_iam_role.test.name}"
+}
+
+resource "aws_launch_template" "foo" {
+  name          = "%s"
+  image_id      = "ami-21f78e11"
+  instance_type = "t2.micro"
+  user_data     = "#!/bin/sh\necho hello"
+
+  block_device_mappings {
+    device_name = "/dev/sda1"
+
+    ebs {
+      volume_size = 20
+    }
+  }
+
+  credit_specification {
+    cpu_credits = "unlimited"
+  }
+
+  iam_instance_profile {
+    name = "${aws_iam_instance_profile.test.name}"
+  }
+
+  monitoring {
+    enabled = true
+  }
+
+  network_interfaces {
+    associate_public_ip_address = true
+  }
+
+  tag_specifications {
+    resource_type = "instance"
+
+    tags {
+      Name = "%s"
+    }
+  }
+}
+`, rName, rName, rName, rName)
+}
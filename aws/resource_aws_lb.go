@@ -20,12 +20,11 @@ import (
 
 func resourceAwsLb() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsLbCreate,
-		Read:   resoureAwsLbRead,
-		Update: resourceAwsLbUpdate,
-		Delete: resourceAwsLbDelete,
-		// Subnets are ForceNew for Network Load Balancers
-		CustomizeDiff: customizeDiffNLBSubnets,
+		Create:        resourceAwsLbCreate,
+		Read:          resoureAwsLbRead,
+		Update:        resourceAwsLbUpdate,
+		Delete:        resourceAwsLbDelete,
+		CustomizeDiff: resourceAwsLbCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -188,7 +187,8 @@ func resourceAwsLb() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -209,7 +209,7 @@ func resourceAwsLbCreate(d *schema.ResourceData, meta interface{}) error {
 	elbOpts := &elbv2.CreateLoadBalancerInput{
 		Name: aws.String(name),
 		Type: aws.String(d.Get("load_balancer_type").(string)),
-		Tags: tagsFromMapELBv2(d.Get("tags").(map[string]interface{})),
+		Tags: tagsFromMapELBv2(d.Get("tags_all").(map[string]interface{})),
 	}
 
 	if scheme, ok := d.GetOk("internal"); ok && scheme.(bool) {
@@ -660,7 +660,7 @@ func flattenAwsLbResource(d *schema.ResourceData, meta interface{}, lb *elbv2.Lo
 		et = respTags.TagDescriptions[0].Tags
 	}
 
-	if err := d.Set("tags", tagsToMapELBv2(et)); err != nil {
+	if err := setTagsAll(d, meta, tagsToMapELBv2(et)); err != nil {
 		log.Printf("[WARN] Error setting tags for AWS LB (%s): %s", d.Id(), err)
 	}
 
@@ -704,6 +704,15 @@ func flattenAwsLbResource(d *schema.ResourceData, meta interface{}, lb *elbv2.Lo
 	return nil
 }
 
+func resourceAwsLbCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if err := setTagsAllDiff(diff, v); err != nil {
+		return err
+	}
+
+	// Subnets are ForceNew for Network Load Balancers
+	return customizeDiffNLBSubnets(diff, v)
+}
+
 // Load balancers of type 'network' cannot have their subnets updated at
 // this time. If the type is 'network' and subnets have changed, mark the
 // diff as a ForceNew operation
@@ -21,6 +21,10 @@ func resourceAwsLBSSLNegotiationPolicy() *schema.Resource {
 		Read:   resourceAwsLBSSLNegotiationPolicyRead,
 		Delete: resourceAwsLBSSLNegotiationPolicyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -31,6 +31,13 @@ func TestAccAWSLBSSLNegotiationPolicy_basic(t *testing.T) {
 						"aws_lb_ssl_negotiation_policy.foo", "attribute.#", "7"),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:            "aws_lb_ssl_negotiation_policy.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attribute"},
+			},
 		},
 	})
 }
@@ -181,7 +181,8 @@ func resourceAwsLbTargetGroup() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -559,7 +560,7 @@ func flattenAwsLbTargetGroupResource(d *schema.ResourceData, meta interface{}, t
 	}
 	for _, t := range tagsResp.TagDescriptions {
 		if *t.ResourceArn == d.Id() {
-			if err := d.Set("tags", tagsToMapELBv2(t.Tags)); err != nil {
+			if err := setTagsAll(d, meta, tagsToMapELBv2(t.Tags)); err != nil {
 				return err
 			}
 		}
@@ -569,6 +570,10 @@ func flattenAwsLbTargetGroupResource(d *schema.ResourceData, meta interface{}, t
 }
 
 func resourceAwsLbTargetGroupCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if err := setTagsAllDiff(diff, v); err != nil {
+		return err
+	}
+
 	protocol := diff.Get("protocol").(string)
 	if protocol != "TCP" {
 		return nil
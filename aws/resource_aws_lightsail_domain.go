@@ -15,6 +15,10 @@ func resourceAwsLightsailDomain() *schema.Resource {
 		Read:   resourceAwsLightsailDomainRead,
 		Delete: resourceAwsLightsailDomainDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Type:     schema.TypeString,
@@ -63,6 +67,7 @@ func resourceAwsLightsailDomainRead(d *schema.ResourceData, meta interface{}) er
 	}
 
 	d.Set("arn", resp.Domain.Arn)
+	d.Set("domain_name", resp.Domain.Name)
 	return nil
 }
 
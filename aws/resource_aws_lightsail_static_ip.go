@@ -15,6 +15,10 @@ func resourceAwsLightsailStaticIp() *schema.Resource {
 		Read:   resourceAwsLightsailStaticIpRead,
 		Delete: resourceAwsLightsailStaticIpDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLightsailStaticIpImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -37,6 +41,12 @@ func resourceAwsLightsailStaticIp() *schema.Resource {
 	}
 }
 
+func resourceAwsLightsailStaticIpImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsLightsailStaticIpCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lightsailconn
 
@@ -15,6 +15,10 @@ func resourceAwsLightsailStaticIpAttachment() *schema.Resource {
 		Read:   resourceAwsLightsailStaticIpAttachmentRead,
 		Delete: resourceAwsLightsailStaticIpAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsLightsailStaticIpAttachmentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"static_ip_name": {
 				Type:     schema.TypeString,
@@ -30,6 +34,12 @@ func resourceAwsLightsailStaticIpAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsLightsailStaticIpAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("static_ip_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsLightsailStaticIpAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).lightsailconn
 
@@ -30,6 +30,12 @@ func TestAccAWSLightsailStaticIpAttachment_basic(t *testing.T) {
 					testAccCheckAWSLightsailStaticIpAttachmentExists("aws_lightsail_static_ip_attachment.test", &staticIp),
 				),
 			},
+
+			{
+				ResourceName:      "aws_lightsail_static_ip_attachment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
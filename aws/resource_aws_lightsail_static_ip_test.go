@@ -28,6 +28,12 @@ func TestAccAWSLightsailStaticIp_basic(t *testing.T) {
 					testAccCheckAWSLightsailStaticIpExists("aws_lightsail_static_ip.test", &staticIp),
 				),
 			},
+
+			{
+				ResourceName:      "aws_lightsail_static_ip.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
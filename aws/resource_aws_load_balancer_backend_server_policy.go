@@ -18,6 +18,10 @@ func resourceAwsLoadBalancerBackendServerPolicies() *schema.Resource {
 		Update: resourceAwsLoadBalancerBackendServerPoliciesCreate,
 		Delete: resourceAwsLoadBalancerBackendServerPoliciesDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"load_balancer_name": &schema.Schema{
 				Type:     schema.TypeString,
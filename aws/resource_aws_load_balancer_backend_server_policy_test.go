@@ -30,6 +30,11 @@ func TestAccAWSLoadBalancerBackendServerPolicy_basic(t *testing.T) {
 					testAccCheckAWSLoadBalancerBackendServerPolicyState("test-aws-policies-lb", "test-backend-auth-policy0", true),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_load_balancer_backend_server_policy.test-backend-auth-policies-443",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			resource.TestStep{
 				Config: testAccAWSLoadBalancerBackendServerPolicyConfig_basic1,
 				Check: resource.ComposeTestCheckFunc(
@@ -18,6 +18,10 @@ func resourceAwsLoadBalancerListenerPolicies() *schema.Resource {
 		Update: resourceAwsLoadBalancerListenerPoliciesCreate,
 		Delete: resourceAwsLoadBalancerListenerPoliciesDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"load_balancer_name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -17,6 +17,10 @@ func resourceAwsLoadBalancerPolicy() *schema.Resource {
 		Update: resourceAwsLoadBalancerPolicyUpdate,
 		Delete: resourceAwsLoadBalancerPolicyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"load_balancer_name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -28,6 +28,11 @@ func TestAccAWSLoadBalancerPolicy_basic(t *testing.T) {
 					testAccCheckAWSLoadBalancerPolicyState("aws_elb.test-lb", "aws_load_balancer_policy.test-policy"),
 				),
 			},
+			{
+				ResourceName:      "aws_load_balancer_policy.test-policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -15,10 +15,11 @@ import (
 
 func resourceAwsNatGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsNatGatewayCreate,
-		Read:   resourceAwsNatGatewayRead,
-		Update: resourceAwsNatGatewayUpdate,
-		Delete: resourceAwsNatGatewayDelete,
+		Create:        resourceAwsNatGatewayCreate,
+		Read:          resourceAwsNatGatewayRead,
+		Update:        resourceAwsNatGatewayUpdate,
+		Delete:        resourceAwsNatGatewayDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -54,7 +55,8 @@ func resourceAwsNatGateway() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -129,7 +131,7 @@ func resourceAwsNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("public_ip", address.PublicIp)
 
 	// Tags
-	d.Set("tags", tagsToMap(ng.Tags))
+	setTagsAll(d, meta, tagsToMap(ng.Tags))
 
 	return nil
 }
@@ -144,6 +146,7 @@ func resourceAwsNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	d.Partial(false)
 	return resourceAwsNatGatewayRead(d, meta)
@@ -20,10 +20,11 @@ import (
 func resourceAwsNetworkAcl() *schema.Resource {
 
 	return &schema.Resource{
-		Create: resourceAwsNetworkAclCreate,
-		Read:   resourceAwsNetworkAclRead,
-		Delete: resourceAwsNetworkAclDelete,
-		Update: resourceAwsNetworkAclUpdate,
+		Create:        resourceAwsNetworkAclCreate,
+		Read:          resourceAwsNetworkAclRead,
+		Delete:        resourceAwsNetworkAclDelete,
+		Update:        resourceAwsNetworkAclUpdate,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsNetworkAclImportState,
 		},
@@ -156,7 +157,8 @@ func resourceAwsNetworkAcl() *schema.Resource {
 				},
 				Set: resourceAwsNetworkAclEntryHash,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -227,7 +229,7 @@ func resourceAwsNetworkAclRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("vpc_id", networkAcl.VpcId)
-	d.Set("tags", tagsToMap(networkAcl.Tags))
+	setTagsAll(d, meta, tagsToMap(networkAcl.Tags))
 
 	var s []string
 	for _, a := range networkAcl.Associations {
@@ -343,6 +345,7 @@ func resourceAwsNetworkAclUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
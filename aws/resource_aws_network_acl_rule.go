@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -20,6 +21,10 @@ func resourceAwsNetworkAclRule() *schema.Resource {
 		Read:   resourceAwsNetworkAclRuleRead,
 		Delete: resourceAwsNetworkAclRuleDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkAclRuleImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"network_acl_id": {
 				Type:     schema.TypeString,
@@ -89,6 +94,31 @@ func resourceAwsNetworkAclRule() *schema.Resource {
 	}
 }
 
+func resourceAwsNetworkAclRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected NETWORK-ACL-ID:RULE-NUMBER:PROTOCOL:EGRESS", d.Id())
+	}
+
+	networkAclId := idParts[0]
+	ruleNumber, err := strconv.Atoi(idParts[1])
+	if err != nil {
+		return nil, fmt.Errorf("Invalid rule number %q in ID (%q): %s", idParts[1], d.Id(), err)
+	}
+	protocol := idParts[2]
+	egress, err := strconv.ParseBool(idParts[3])
+	if err != nil {
+		return nil, fmt.Errorf("Invalid egress %q in ID (%q): %s", idParts[3], d.Id(), err)
+	}
+
+	d.Set("network_acl_id", networkAclId)
+	d.Set("rule_number", ruleNumber)
+	d.Set("egress", egress)
+	d.SetId(networkAclIdRuleNumberEgressHash(networkAclId, ruleNumber, egress, protocol))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsNetworkAclRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -29,6 +29,13 @@ func TestAccAWSNetworkAclRule_basic(t *testing.T) {
 					testAccCheckAWSNetworkAclRuleExists("aws_network_acl_rule.wibble", &networkAcl),
 				),
 			},
+
+			{
+				ResourceName:      "aws_network_acl_rule.baz",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSNetworkAclRuleImportStateIdFunc("aws_network_acl_rule.baz"),
+			},
 		},
 	})
 }
@@ -396,3 +403,14 @@ resource "aws_network_acl_rule" "baz" {
 }
 
 `
+
+func testAccAWSNetworkAclRuleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s:%s:%s", rs.Primary.Attributes["network_acl_id"], rs.Primary.Attributes["rule_number"], rs.Primary.Attributes["protocol"], rs.Primary.Attributes["egress"]), nil
+	}
+}
@@ -18,10 +18,11 @@ import (
 
 func resourceAwsNetworkInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsNetworkInterfaceCreate,
-		Read:   resourceAwsNetworkInterfaceRead,
-		Update: resourceAwsNetworkInterfaceUpdate,
-		Delete: resourceAwsNetworkInterfaceDelete,
+		Create:        resourceAwsNetworkInterfaceCreate,
+		Read:          resourceAwsNetworkInterfaceRead,
+		Update:        resourceAwsNetworkInterfaceUpdate,
+		Delete:        resourceAwsNetworkInterfaceDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -101,7 +102,8 @@ func resourceAwsNetworkInterface() *schema.Resource {
 				Set: resourceAwsEniAttachmentHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -177,7 +179,7 @@ func resourceAwsNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) e
 	}
 
 	// Tags
-	d.Set("tags", tagsToMap(eni.TagSet))
+	setTagsAll(d, meta, tagsToMap(eni.TagSet))
 
 	if eni.Attachment != nil {
 		attachment := []map[string]interface{}{flattenAttachment(eni.Attachment)}
@@ -399,6 +401,7 @@ func resourceAwsNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
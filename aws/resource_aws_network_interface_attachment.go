@@ -18,6 +18,10 @@ func resourceAwsNetworkInterfaceAttachment() *schema.Resource {
 		Read:   resourceAwsNetworkInterfaceAttachmentRead,
 		Delete: resourceAwsNetworkInterfaceAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsNetworkInterfaceAttachmentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"device_index": {
 				Type:     schema.TypeInt,
@@ -50,6 +54,26 @@ func resourceAwsNetworkInterfaceAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsNetworkInterfaceAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	interfaceId := d.Id()
+	resp, err := conn.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+		NetworkInterfaceIds: []*string{aws.String(interfaceId)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving ENI: %s", err)
+	}
+	if len(resp.NetworkInterfaces) != 1 || resp.NetworkInterfaces[0].Attachment == nil {
+		return nil, fmt.Errorf("Unable to find an attachment for ENI (%s)", interfaceId)
+	}
+
+	d.Set("network_interface_id", interfaceId)
+	d.SetId(*resp.NetworkInterfaces[0].Attachment.AttachmentId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
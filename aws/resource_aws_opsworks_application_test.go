@@ -62,6 +62,12 @@ func TestAccAWSOpsworksApplication(t *testing.T) {
 					),
 				),
 			},
+			{
+				ResourceName:            "aws_opsworks_application.tf-acc-app",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"environment"},
+			},
 			{
 				Config: testAccAwsOpsworksApplicationUpdate(name),
 				Check: resource.ComposeTestCheckFunc(
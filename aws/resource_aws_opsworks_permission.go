@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,7 +18,12 @@ func resourceAwsOpsworksPermission() *schema.Resource {
 		Create: resourceAwsOpsworksSetPermission,
 		Update: resourceAwsOpsworksSetPermission,
 		Delete: resourceAwsOpsworksPermissionDelete,
-		Read:   resourceAwsOpsworksPermissionRead,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsOpsworksPermissionImport,
+		},
+
+		Read: resourceAwsOpsworksPermissionRead,
 
 		Schema: map[string]*schema.Schema{
 			"allow_ssh": {
@@ -66,6 +72,21 @@ func resourceAwsOpsworksPermission() *schema.Resource {
 	}
 }
 
+func resourceAwsOpsworksPermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// IAM user ARNs may contain "/" but stack IDs never do
+	idx := strings.LastIndex(d.Id(), "/")
+	if idx < 1 || idx == len(d.Id())-1 {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected USER-ARN/STACK-ID", d.Id())
+	}
+
+	userArn, stackId := d.Id()[:idx], d.Id()[idx+1:]
+	d.Set("user_arn", userArn)
+	d.Set("stack_id", stackId)
+	d.SetId(userArn + stackId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsOpsworksPermissionDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
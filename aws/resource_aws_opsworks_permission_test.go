@@ -37,6 +37,12 @@ func TestAccAWSOpsworksPermission(t *testing.T) {
 					),
 				),
 			},
+			{
+				ResourceName:      "aws_opsworks_permission.tf-acc-perm",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSOpsworksPermissionImportStateIdFunc("aws_opsworks_permission.tf-acc-perm"),
+			},
 			{
 				Config: testAccAwsOpsworksPermissionCreate(sName, "true", "false", "iam_only"),
 				Check: resource.ComposeTestCheckFunc(
@@ -197,3 +203,14 @@ resource "aws_iam_user" "user" {
 %s
 `, ssh, sudo, level, name, testAccAwsOpsworksStackConfigVpcCreate(name))
 }
+
+func testAccAWSOpsworksPermissionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["user_arn"], rs.Primary.Attributes["stack_id"]), nil
+	}
+}
@@ -20,10 +20,11 @@ import (
 
 func resourceAwsOpsworksStack() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsOpsworksStackCreate,
-		Read:   resourceAwsOpsworksStackRead,
-		Update: resourceAwsOpsworksStackUpdate,
-		Delete: resourceAwsOpsworksStackDelete,
+		Create:        resourceAwsOpsworksStackCreate,
+		Read:          resourceAwsOpsworksStackRead,
+		Update:        resourceAwsOpsworksStackUpdate,
+		Delete:        resourceAwsOpsworksStackDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -171,7 +172,8 @@ func resourceAwsOpsworksStack() *schema.Resource {
 				Default:  "Layer_Dependent",
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 
 			"use_custom_cookbooks": {
 				Type:     schema.TypeBool,
@@ -17,6 +17,10 @@ func resourceAwsOpsworksUserProfile() *schema.Resource {
 		Update: resourceAwsOpsworksUserProfileUpdate,
 		Delete: resourceAwsOpsworksUserProfileDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"user_arn": {
 				Type:     schema.TypeString,
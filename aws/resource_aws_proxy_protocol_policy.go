@@ -18,6 +18,10 @@ func resourceAwsProxyProtocolPolicy() *schema.Resource {
 		Update: resourceAwsProxyProtocolPolicyUpdate,
 		Delete: resourceAwsProxyProtocolPolicyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsProxyProtocolPolicyImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"load_balancer": &schema.Schema{
 				Type:     schema.TypeString,
@@ -34,6 +38,17 @@ func resourceAwsProxyProtocolPolicy() *schema.Resource {
 	}
 }
 
+func resourceAwsProxyProtocolPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !strings.Contains(d.Id(), ":") {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected LOAD-BALANCER-NAME:POLICY-NAME", d.Id())
+	}
+
+	elbname, _ := resourceAwsProxyProtocolPolicyParseId(d.Id())
+	d.Set("load_balancer", elbname)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsProxyProtocolPolicyCreate(d *schema.ResourceData, meta interface{}) error {
 	elbconn := meta.(*AWSClient).elbconn
 	elbname := aws.String(d.Get("load_balancer").(string))
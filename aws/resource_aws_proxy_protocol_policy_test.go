@@ -29,6 +29,11 @@ func TestAccAWSProxyProtocolPolicy_basic(t *testing.T) {
 						"aws_proxy_protocol_policy.smtp", "instance_ports.4196041389", "25"),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_proxy_protocol_policy.smtp",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			resource.TestStep{
 				Config: testAccProxyProtocolPolicyConfigUpdate(lbName),
 				Check: resource.ComposeTestCheckFunc(
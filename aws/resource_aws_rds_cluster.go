@@ -16,10 +16,11 @@ import (
 
 func resourceAwsRDSCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsRDSClusterCreate,
-		Read:   resourceAwsRDSClusterRead,
-		Update: resourceAwsRDSClusterUpdate,
-		Delete: resourceAwsRDSClusterDelete,
+		Create:        resourceAwsRDSClusterCreate,
+		Read:          resourceAwsRDSClusterRead,
+		Update:        resourceAwsRDSClusterUpdate,
+		Delete:        resourceAwsRDSClusterDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsRdsClusterImport,
 		},
@@ -249,7 +250,8 @@ func resourceAwsRDSCluster() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -265,7 +267,7 @@ func resourceAwsRdsClusterImport(
 
 func resourceAwsRDSClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var identifier string
 	if v, ok := d.GetOk("cluster_identifier"); ok {
@@ -621,7 +623,7 @@ func flattenAwsRdsClusterResource(d *schema.ResourceData, meta interface{}, dbc
 	if err != nil {
 		log.Printf("[DEBUG] Error building ARN for RDS Cluster (%s), not setting Tags", *dbc.DBClusterIdentifier)
 	} else {
-		if err := saveTagsRDS(conn, d, arn); err != nil {
+		if err := saveTagsRDS(conn, d, meta, arn); err != nil {
 			log.Printf("[WARN] Failed to save tags for RDS Cluster (%s): %s", *dbc.DBClusterIdentifier, err)
 		}
 	}
@@ -729,6 +731,7 @@ func resourceAwsRDSClusterUpdate(d *schema.ResourceData, meta interface{}) error
 			return err
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
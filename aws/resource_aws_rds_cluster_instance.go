@@ -14,10 +14,11 @@ import (
 
 func resourceAwsRDSClusterInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsRDSClusterInstanceCreate,
-		Read:   resourceAwsRDSClusterInstanceRead,
-		Update: resourceAwsRDSClusterInstanceUpdate,
-		Delete: resourceAwsRDSClusterInstanceDelete,
+		Create:        resourceAwsRDSClusterInstanceCreate,
+		Read:          resourceAwsRDSClusterInstanceRead,
+		Update:        resourceAwsRDSClusterInstanceUpdate,
+		Delete:        resourceAwsRDSClusterInstanceDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -179,14 +180,15 @@ func resourceAwsRDSClusterInstance() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsRDSClusterInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	createOpts := &rds.CreateDBInstanceInput{
 		DBInstanceClass:         aws.String(d.Get("instance_class").(string)),
@@ -344,7 +346,7 @@ func resourceAwsRDSClusterInstanceRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		log.Printf("[DEBUG] Error building ARN for RDS Cluster Instance (%s), not setting Tags", *db.DBInstanceIdentifier)
 	} else {
-		if err := saveTagsRDS(conn, d, arn); err != nil {
+		if err := saveTagsRDS(conn, d, meta, arn); err != nil {
 			log.Printf("[WARN] Failed to save tags for RDS Cluster Instance (%s): %s", *db.DBClusterIdentifier, err)
 		}
 	}
@@ -17,10 +17,11 @@ const rdsClusterParameterGroupMaxParamsBulkEdit = 20
 
 func resourceAwsRDSClusterParameterGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsRDSClusterParameterGroupCreate,
-		Read:   resourceAwsRDSClusterParameterGroupRead,
-		Update: resourceAwsRDSClusterParameterGroupUpdate,
-		Delete: resourceAwsRDSClusterParameterGroupDelete,
+		Create:        resourceAwsRDSClusterParameterGroupCreate,
+		Read:          resourceAwsRDSClusterParameterGroupRead,
+		Update:        resourceAwsRDSClusterParameterGroupUpdate,
+		Delete:        resourceAwsRDSClusterParameterGroupDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -88,14 +89,15 @@ func resourceAwsRDSClusterParameterGroup() *schema.Resource {
 				Set: resourceAwsDbParameterHash,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsRDSClusterParameterGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	rdsconn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var groupName string
 	if v, ok := d.GetOk("name"); ok {
@@ -187,7 +189,7 @@ func resourceAwsRDSClusterParameterGroupRead(d *schema.ResourceData, meta interf
 		if len(resp.TagList) > 0 {
 			dt = resp.TagList
 		}
-		d.Set("tags", tagsToMapRDS(dt))
+		setTagsAll(d, meta, tagsToMapRDS(dt))
 	}
 
 	return nil
@@ -247,6 +249,7 @@ func resourceAwsRDSClusterParameterGroupUpdate(d *schema.ResourceData, meta inte
 			return err
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
@@ -16,10 +16,11 @@ import (
 
 func resourceAwsRedshiftCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsRedshiftClusterCreate,
-		Read:   resourceAwsRedshiftClusterRead,
-		Update: resourceAwsRedshiftClusterUpdate,
-		Delete: resourceAwsRedshiftClusterDelete,
+		Create:        resourceAwsRedshiftClusterCreate,
+		Read:          resourceAwsRedshiftClusterRead,
+		Update:        resourceAwsRedshiftClusterUpdate,
+		Delete:        resourceAwsRedshiftClusterDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsRedshiftClusterImport,
 		},
@@ -309,7 +310,8 @@ func resourceAwsRedshiftCluster() *schema.Resource {
 				Optional: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -325,7 +327,7 @@ func resourceAwsRedshiftClusterImport(
 
 func resourceAwsRedshiftClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).redshiftconn
-	tags := tagsFromMapRedshift(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRedshift(d.Get("tags_all").(map[string]interface{}))
 
 	if v, ok := d.GetOk("snapshot_identifier"); ok {
 		restoreOpts := &redshift.RestoreFromClusterSnapshotInput{
@@ -613,7 +615,7 @@ func resourceAwsRedshiftClusterRead(d *schema.ResourceData, meta interface{}) er
 
 	d.Set("cluster_public_key", rsc.ClusterPublicKey)
 	d.Set("cluster_revision_number", rsc.ClusterRevisionNumber)
-	d.Set("tags", tagsToMapRedshift(rsc.Tags))
+	setTagsAll(d, meta, tagsToMapRedshift(rsc.Tags))
 
 	d.Set("snapshot_copy", flattenRedshiftSnapshotCopy(rsc.ClusterSnapshotCopyStatus))
 
@@ -639,6 +641,7 @@ func resourceAwsRedshiftClusterUpdate(d *schema.ResourceData, meta interface{})
 			return tagErr
 		} else {
 			d.SetPartial("tags")
+			d.SetPartial("tags_all")
 		}
 	}
 
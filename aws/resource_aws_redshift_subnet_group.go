@@ -12,10 +12,11 @@ import (
 
 func resourceAwsRedshiftSubnetGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsRedshiftSubnetGroupCreate,
-		Read:   resourceAwsRedshiftSubnetGroupRead,
-		Update: resourceAwsRedshiftSubnetGroupUpdate,
-		Delete: resourceAwsRedshiftSubnetGroupDelete,
+		Create:        resourceAwsRedshiftSubnetGroupCreate,
+		Read:          resourceAwsRedshiftSubnetGroupRead,
+		Update:        resourceAwsRedshiftSubnetGroupUpdate,
+		Delete:        resourceAwsRedshiftSubnetGroupDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -41,7 +42,8 @@ func resourceAwsRedshiftSubnetGroup() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -54,7 +56,7 @@ func resourceAwsRedshiftSubnetGroupCreate(d *schema.ResourceData, meta interface
 	for i, subnetId := range subnetIdsSet.List() {
 		subnetIds[i] = aws.String(subnetId.(string))
 	}
-	tags := tagsFromMapRedshift(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRedshift(d.Get("tags_all").(map[string]interface{}))
 
 	createOpts := redshift.CreateClusterSubnetGroupInput{
 		ClusterSubnetGroupName: aws.String(d.Get("name").(string)),
@@ -98,7 +100,7 @@ func resourceAwsRedshiftSubnetGroupRead(d *schema.ResourceData, meta interface{}
 	d.Set("name", d.Id())
 	d.Set("description", describeResp.ClusterSubnetGroups[0].Description)
 	d.Set("subnet_ids", subnetIdsToSlice(describeResp.ClusterSubnetGroups[0].Subnets))
-	if err := d.Set("tags", tagsToMapRedshift(describeResp.ClusterSubnetGroups[0].Tags)); err != nil {
+	if err := setTagsAll(d, meta, tagsToMapRedshift(describeResp.ClusterSubnetGroups[0].Tags)); err != nil {
 		return fmt.Errorf("[DEBUG] Error setting Redshift Subnet Group Tags: %#v", err)
 	}
 
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -26,6 +27,11 @@ func resourceAwsRoute() *schema.Resource {
 		Read:   resourceAwsRouteRead,
 		Update: resourceAwsRouteUpdate,
 		Delete: resourceAwsRouteDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsRouteImport,
+		},
+
 		Exists: resourceAwsRouteExists,
 
 		Schema: map[string]*schema.Schema{
@@ -41,8 +47,11 @@ func resourceAwsRoute() *schema.Resource {
 			},
 
 			"destination_prefix_list_id": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"destination_cidr_block", "destination_ipv6_cidr_block"},
 			},
 
 			"gateway_id": {
@@ -103,6 +112,30 @@ func resourceAwsRoute() *schema.Resource {
 	}
 }
 
+func resourceAwsRouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "_", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected ROUTE-TABLE-ID_DESTINATION", d.Id())
+	}
+
+	routeTableId, destination := idParts[0], idParts[1]
+	route := &ec2.Route{}
+	d.Set("route_table_id", routeTableId)
+	if strings.HasPrefix(destination, "pl-") {
+		d.Set("destination_prefix_list_id", destination)
+		route.DestinationPrefixListId = aws.String(destination)
+	} else if strings.Contains(destination, ":") {
+		d.Set("destination_ipv6_cidr_block", destination)
+		route.DestinationIpv6CidrBlock = aws.String(destination)
+	} else {
+		d.Set("destination_cidr_block", destination)
+		route.DestinationCidrBlock = aws.String(destination)
+	}
+	d.SetId(routeIDHash(d, route))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	var numTargets int
@@ -186,6 +219,12 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 	default:
 		return fmt.Errorf("An invalid target type specified: %s", setTarget)
 	}
+
+	if v, ok := d.GetOk("destination_prefix_list_id"); ok {
+		createOpts.DestinationCidrBlock = nil
+		createOpts.DestinationIpv6CidrBlock = nil
+		createOpts.DestinationPrefixListId = aws.String(v.(string))
+	}
 	log.Printf("[DEBUG] Route create config: %s", createOpts)
 
 	// Create the route
@@ -217,7 +256,7 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 
 	if v, ok := d.GetOk("destination_cidr_block"); ok {
 		err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-			route, err = findResourceRoute(conn, d.Get("route_table_id").(string), v.(string), "")
+			route, err = findResourceRoute(conn, d.Get("route_table_id").(string), v.(string), "", "")
 			return resource.RetryableError(err)
 		})
 		if err != nil {
@@ -227,7 +266,17 @@ func resourceAwsRouteCreate(d *schema.ResourceData, meta interface{}) error {
 
 	if v, ok := d.GetOk("destination_ipv6_cidr_block"); ok {
 		err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-			route, err = findResourceRoute(conn, d.Get("route_table_id").(string), "", v.(string))
+			route, err = findResourceRoute(conn, d.Get("route_table_id").(string), "", v.(string), "")
+			return resource.RetryableError(err)
+		})
+		if err != nil {
+			return fmt.Errorf("Error finding route after creating it: %s", err)
+		}
+	}
+
+	if v, ok := d.GetOk("destination_prefix_list_id"); ok {
+		err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+			route, err = findResourceRoute(conn, d.Get("route_table_id").(string), "", "", v.(string))
 			return resource.RetryableError(err)
 		})
 		if err != nil {
@@ -246,8 +295,9 @@ func resourceAwsRouteRead(d *schema.ResourceData, meta interface{}) error {
 
 	destinationCidrBlock := d.Get("destination_cidr_block").(string)
 	destinationIpv6CidrBlock := d.Get("destination_ipv6_cidr_block").(string)
+	destinationPrefixListId := d.Get("destination_prefix_list_id").(string)
 
-	route, err := findResourceRoute(conn, routeTableId, destinationCidrBlock, destinationIpv6CidrBlock)
+	route, err := findResourceRoute(conn, routeTableId, destinationCidrBlock, destinationIpv6CidrBlock, destinationPrefixListId)
 	if err != nil {
 		if ec2err, ok := err.(awserr.Error); ok && ec2err.Code() == "InvalidRouteTableID.NotFound" {
 			log.Printf("[WARN] Route Table %q could not be found. Removing Route from state.",
@@ -352,6 +402,12 @@ func resourceAwsRouteUpdate(d *schema.ResourceData, meta interface{}) error {
 	default:
 		return fmt.Errorf("An invalid target type specified: %s", setTarget)
 	}
+
+	if v, ok := d.GetOk("destination_prefix_list_id"); ok {
+		replaceOpts.DestinationCidrBlock = nil
+		replaceOpts.DestinationIpv6CidrBlock = nil
+		replaceOpts.DestinationPrefixListId = aws.String(v.(string))
+	}
 	log.Printf("[DEBUG] Route replace config: %s", replaceOpts)
 
 	// Replace the route
@@ -375,6 +431,11 @@ func resourceAwsRouteDelete(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("destination_ipv6_cidr_block"); ok {
 		deleteOpts.DestinationIpv6CidrBlock = aws.String(v.(string))
 	}
+	if deleteOpts.DestinationCidrBlock == nil && deleteOpts.DestinationIpv6CidrBlock == nil {
+		if v, ok := d.GetOk("destination_prefix_list_id"); ok {
+			deleteOpts.DestinationPrefixListId = aws.String(v.(string))
+		}
+	}
 	log.Printf("[DEBUG] Route delete opts: %s", deleteOpts)
 
 	var err error
@@ -447,6 +508,21 @@ func resourceAwsRouteExists(d *schema.ResourceData, meta interface{}) (bool, err
 		}
 	}
 
+	if _, ok := d.GetOk("destination_cidr_block"); ok {
+		return false, nil
+	}
+	if _, ok := d.GetOk("destination_ipv6_cidr_block"); ok {
+		return false, nil
+	}
+
+	if v, ok := d.GetOk("destination_prefix_list_id"); ok {
+		for _, route := range (*res.RouteTables[0]).Routes {
+			if route.DestinationPrefixListId != nil && *route.DestinationPrefixListId == v.(string) {
+				return true, nil
+			}
+		}
+	}
+
 	return false, nil
 }
 
@@ -457,11 +533,15 @@ func routeIDHash(d *schema.ResourceData, r *ec2.Route) string {
 		return fmt.Sprintf("r-%s%d", d.Get("route_table_id").(string), hashcode.String(*r.DestinationIpv6CidrBlock))
 	}
 
+	if r.DestinationPrefixListId != nil && *r.DestinationPrefixListId != "" {
+		return fmt.Sprintf("r-%s%d", d.Get("route_table_id").(string), hashcode.String(*r.DestinationPrefixListId))
+	}
+
 	return fmt.Sprintf("r-%s%d", d.Get("route_table_id").(string), hashcode.String(*r.DestinationCidrBlock))
 }
 
 // Helper: retrieve a route
-func findResourceRoute(conn *ec2.EC2, rtbid string, cidr string, ipv6cidr string) (*ec2.Route, error) {
+func findResourceRoute(conn *ec2.EC2, rtbid string, cidr string, ipv6cidr string, prefixListId string) (*ec2.Route, error) {
 	routeTableID := rtbid
 
 	findOpts := &ec2.DescribeRouteTablesInput{
@@ -500,7 +580,18 @@ func findResourceRoute(conn *ec2.EC2, rtbid string, cidr string, ipv6cidr string
 			"and destination IPv6 CIDR block (%s).", rtbid, ipv6cidr)
 	}
 
+	if prefixListId != "" {
+		for _, route := range (*resp.RouteTables[0]).Routes {
+			if route.DestinationPrefixListId != nil && *route.DestinationPrefixListId == prefixListId {
+				return route, nil
+			}
+		}
+
+		return nil, fmt.Errorf("Unable to find matching route for Route Table (%s) "+
+			"and destination prefix list (%s).", rtbid, prefixListId)
+	}
+
 	return nil, fmt.Errorf("When trying to find a matching route for Route Table %q "+
-		"you need to specify a CIDR block of IPv6 CIDR Block", rtbid)
+		"you need to specify a CIDR block, IPv6 CIDR Block or prefix list", rtbid)
 
 }
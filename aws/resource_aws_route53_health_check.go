@@ -15,10 +15,11 @@ import (
 
 func resourceAwsRoute53HealthCheck() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsRoute53HealthCheckCreate,
-		Read:   resourceAwsRoute53HealthCheckRead,
-		Update: resourceAwsRoute53HealthCheckUpdate,
-		Delete: resourceAwsRoute53HealthCheckDelete,
+		Create:        resourceAwsRoute53HealthCheckCreate,
+		Read:          resourceAwsRoute53HealthCheckRead,
+		Update:        resourceAwsRoute53HealthCheckUpdate,
+		Delete:        resourceAwsRoute53HealthCheckDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -128,7 +129,8 @@ func resourceAwsRoute53HealthCheck() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -367,7 +369,7 @@ func resourceAwsRoute53HealthCheckRead(d *schema.ResourceData, meta interface{})
 		tags = resp.ResourceTagSet.Tags
 	}
 
-	if err := d.Set("tags", tagsToMapR53(tags)); err != nil {
+	if err := setTagsAll(d, meta, tagsToMapR53(tags)); err != nil {
 		return err
 	}
 
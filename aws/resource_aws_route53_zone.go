@@ -18,10 +18,11 @@ import (
 
 func resourceAwsRoute53Zone() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsRoute53ZoneCreate,
-		Read:   resourceAwsRoute53ZoneRead,
-		Update: resourceAwsRoute53ZoneUpdate,
-		Delete: resourceAwsRoute53ZoneDelete,
+		Create:        resourceAwsRoute53ZoneCreate,
+		Read:          resourceAwsRoute53ZoneRead,
+		Update:        resourceAwsRoute53ZoneUpdate,
+		Delete:        resourceAwsRoute53ZoneDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -71,7 +72,8 @@ func resourceAwsRoute53Zone() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 
 			"force_destroy": &schema.Schema{
 				Type:     schema.TypeBool,
@@ -225,7 +227,7 @@ func resourceAwsRoute53ZoneRead(d *schema.ResourceData, meta interface{}) error
 		tags = resp.ResourceTagSet.Tags
 	}
 
-	if err := d.Set("tags", tagsToMapR53(tags)); err != nil {
+	if err := setTagsAll(d, meta, tagsToMapR53(tags)); err != nil {
 		return err
 	}
 
@@ -255,6 +257,7 @@ func resourceAwsRoute53ZoneUpdate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -21,6 +21,10 @@ func resourceAwsRoute53ZoneAssociation() *schema.Resource {
 		Update: resourceAwsRoute53ZoneAssociationUpdate,
 		Delete: resourceAwsRoute53ZoneAssociationDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -105,6 +109,9 @@ func resourceAwsRoute53ZoneAssociationRead(d *schema.ResourceData, meta interfac
 	for _, vpc := range zone.VPCs {
 		if vpc_id == *vpc.VPCId {
 			// association is there, return
+			d.Set("zone_id", zone_id)
+			d.Set("vpc_id", vpc_id)
+			d.Set("vpc_region", vpc.VPCRegion)
 			return nil
 		}
 	}
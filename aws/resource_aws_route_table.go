@@ -16,10 +16,11 @@ import (
 
 func resourceAwsRouteTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsRouteTableCreate,
-		Read:   resourceAwsRouteTableRead,
-		Update: resourceAwsRouteTableUpdate,
-		Delete: resourceAwsRouteTableDelete,
+		Create:        resourceAwsRouteTableCreate,
+		Read:          resourceAwsRouteTableRead,
+		Update:        resourceAwsRouteTableUpdate,
+		Delete:        resourceAwsRouteTableDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsRouteTableImportState,
 		},
@@ -31,7 +32,8 @@ func resourceAwsRouteTable() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 
 			"propagating_vgws": {
 				Type:     schema.TypeSet,
@@ -205,7 +207,7 @@ func resourceAwsRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("route", route)
 
 	// Tags
-	d.Set("tags", tagsToMap(rt.Tags))
+	setTagsAll(d, meta, tagsToMap(rt.Tags))
 
 	return nil
 }
@@ -380,6 +382,7 @@ func resourceAwsRouteTableUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	return resourceAwsRouteTableRead(d, meta)
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,10 @@ func resourceAwsRouteTableAssociation() *schema.Resource {
 		Update: resourceAwsRouteTableAssociationUpdate,
 		Delete: resourceAwsRouteTableAssociationDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsRouteTableAssociationImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"subnet_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -34,6 +39,36 @@ func resourceAwsRouteTableAssociation() *schema.Resource {
 	}
 }
 
+func resourceAwsRouteTableAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected SUBNET-ID/ROUTE-TABLE-ID", d.Id())
+	}
+
+	subnetId, routeTableId := idParts[0], idParts[1]
+
+	conn := meta.(*AWSClient).ec2conn
+	rtRaw, _, err := resourceAwsRouteTableStateRefreshFunc(conn, routeTableId)()
+	if err != nil {
+		return nil, err
+	}
+	if rtRaw == nil {
+		return nil, fmt.Errorf("Route table %s not found", routeTableId)
+	}
+
+	rt := rtRaw.(*ec2.RouteTable)
+	for _, a := range rt.Associations {
+		if a.SubnetId != nil && *a.SubnetId == subnetId {
+			d.Set("subnet_id", subnetId)
+			d.Set("route_table_id", routeTableId)
+			d.SetId(*a.RouteTableAssociationId)
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("No association found between subnet %s and route table %s", subnetId, routeTableId)
+}
+
 func resourceAwsRouteTableAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
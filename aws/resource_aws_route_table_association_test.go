@@ -26,6 +26,12 @@ func TestAccAWSRouteTableAssociation_basic(t *testing.T) {
 						"aws_route_table_association.foo", &v),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_route_table_association.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSRouteTableAssociationImportStateIdFunc("aws_route_table_association.foo"),
+			},
 
 			resource.TestStep{
 				Config: testAccRouteTableAssociationConfigChange,
@@ -160,3 +166,14 @@ resource "aws_route_table_association" "foo" {
 	subnet_id = "${aws_subnet.foo.id}"
 }
 `
+
+func testAccAWSRouteTableAssociationImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["subnet_id"], rs.Primary.Attributes["route_table_id"]), nil
+	}
+}
@@ -45,6 +45,13 @@ func TestAccAWSRoute_basic(t *testing.T) {
 					testCheck,
 				),
 			},
+
+			{
+				ResourceName:      "aws_route.bar",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc("aws_route.bar"),
+			},
 		},
 	})
 }
@@ -253,6 +260,34 @@ func TestAccAWSRoute_doesNotCrashWithVPCEndpoint(t *testing.T) {
 	})
 }
 
+func TestAccAWSRoute_prefixList(t *testing.T) {
+	var route ec2.Route
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSRouteConfigPrefixList,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSRouteExists("aws_route.bar", &route),
+					resource.TestCheckResourceAttrPair("aws_route.bar", "destination_prefix_list_id", "aws_ec2_managed_prefix_list.test", "id"),
+					resource.TestCheckResourceAttr("aws_route.bar", "destination_cidr_block", ""),
+				),
+			},
+			{
+				ResourceName:      "aws_route.bar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSRouteImportStateIdFunc("aws_route.bar"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAWSRouteExists(n string, res *ec2.Route) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -270,6 +305,7 @@ func testAccCheckAWSRouteExists(n string, res *ec2.Route) resource.TestCheckFunc
 			rs.Primary.Attributes["route_table_id"],
 			rs.Primary.Attributes["destination_cidr_block"],
 			rs.Primary.Attributes["destination_ipv6_cidr_block"],
+			rs.Primary.Attributes["destination_prefix_list_id"],
 		)
 
 		if err != nil {
@@ -298,6 +334,7 @@ func testAccCheckAWSRouteDestroy(s *terraform.State) error {
 			rs.Primary.Attributes["route_table_id"],
 			rs.Primary.Attributes["destination_cidr_block"],
 			rs.Primary.Attributes["destination_ipv6_cidr_block"],
+			rs.Primary.Attributes["destination_prefix_list_id"],
 		)
 
 		if route == nil && err == nil {
@@ -328,6 +365,40 @@ resource "aws_route" "bar" {
 }
 `)
 
+var testAccAWSRouteConfigPrefixList = fmt.Sprint(`
+resource "aws_vpc" "foo" {
+	cidr_block = "10.1.0.0/16"
+
+	tags {
+		Name = "terraform-testacc-route-prefix-list"
+	}
+}
+
+resource "aws_internet_gateway" "foo" {
+	vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_route_table" "foo" {
+	vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_ec2_managed_prefix_list" "test" {
+	name           = "terraform-testacc-route-prefix-list"
+	address_family = "IPv4"
+	max_entries    = 2
+
+	entry {
+		cidr = "10.3.0.0/16"
+	}
+}
+
+resource "aws_route" "bar" {
+	route_table_id             = "${aws_route_table.foo.id}"
+	destination_prefix_list_id = "${aws_ec2_managed_prefix_list.test.id}"
+	gateway_id                 = "${aws_internet_gateway.foo.id}"
+}
+`)
+
 var testAccAWSRouteConfigIpv6InternetGateway = fmt.Sprintf(`
 resource "aws_vpc" "foo" {
   cidr_block = "10.1.0.0/16"
@@ -507,3 +578,19 @@ resource "aws_vpc_endpoint" "baz" {
   route_table_ids = ["${aws_route_table.foo.id}"]
 }
 `)
+
+func testAccAWSRouteImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		destination := rs.Primary.Attributes["destination_cidr_block"]
+		if v := rs.Primary.Attributes["destination_prefix_list_id"]; v != "" {
+			destination = v
+		}
+
+		return fmt.Sprintf("%s_%s", rs.Primary.Attributes["route_table_id"], destination), nil
+	}
+}
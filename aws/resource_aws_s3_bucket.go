@@ -21,10 +21,11 @@ import (
 
 func resourceAwsS3Bucket() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsS3BucketCreate,
-		Read:   resourceAwsS3BucketRead,
-		Update: resourceAwsS3BucketUpdate,
-		Delete: resourceAwsS3BucketDelete,
+		Create:        resourceAwsS3BucketCreate,
+		Read:          resourceAwsS3BucketRead,
+		Update:        resourceAwsS3BucketUpdate,
+		Delete:        resourceAwsS3BucketDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsS3BucketImportState,
 		},
@@ -392,7 +393,8 @@ func resourceAwsS3Bucket() *schema.Resource {
 				},
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -468,41 +470,42 @@ func resourceAwsS3BucketCreate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
-	if err := setTagsS3(s3conn, d); err != nil {
+	retryTimeout := meta.(*AWSClient).retryTimeout
+	if err := setTagsS3(s3conn, d, retryTimeout); err != nil {
 		return fmt.Errorf("%q: %s", d.Get("bucket").(string), err)
 	}
 
 	if d.HasChange("policy") {
-		if err := resourceAwsS3BucketPolicyUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketPolicyUpdate(s3conn, d, retryTimeout); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("cors_rule") {
-		if err := resourceAwsS3BucketCorsUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketCorsUpdate(s3conn, d, retryTimeout); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("website") {
-		if err := resourceAwsS3BucketWebsiteUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketWebsiteUpdate(s3conn, d, retryTimeout); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("versioning") {
-		if err := resourceAwsS3BucketVersioningUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketVersioningUpdate(s3conn, d, retryTimeout); err != nil {
 			return err
 		}
 	}
 	if d.HasChange("acl") {
-		if err := resourceAwsS3BucketAclUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketAclUpdate(s3conn, d, retryTimeout); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("logging") {
-		if err := resourceAwsS3BucketLoggingUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketLoggingUpdate(s3conn, d, retryTimeout); err != nil {
 			return err
 		}
 	}
@@ -514,19 +517,19 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if d.HasChange("acceleration_status") {
-		if err := resourceAwsS3BucketAccelerationUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketAccelerationUpdate(s3conn, d, retryTimeout); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("request_payer") {
-		if err := resourceAwsS3BucketRequestPayerUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketRequestPayerUpdate(s3conn, d, retryTimeout); err != nil {
 			return err
 		}
 	}
 
 	if d.HasChange("replication_configuration") {
-		if err := resourceAwsS3BucketReplicationConfigurationUpdate(s3conn, d); err != nil {
+		if err := resourceAwsS3BucketReplicationConfigurationUpdate(s3conn, d, retryTimeout); err != nil {
 			return err
 		}
 	}
@@ -536,10 +539,11 @@ func resourceAwsS3BucketUpdate(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
+	retryTimeout := meta.(*AWSClient).retryTimeout
 
 	var err error
 
-	_, err = retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err = retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.HeadBucket(&s3.HeadBucketInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -566,7 +570,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	// Read the policy
 	if _, ok := d.GetOk("policy"); ok {
 
-		pol, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		pol, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 			return s3conn.GetBucketPolicy(&s3.GetBucketPolicyInput{
 				Bucket: aws.String(d.Id()),
 			})
@@ -592,7 +596,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Read the CORS
-	corsResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	corsResponse, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketCors(&s3.GetBucketCorsInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -628,7 +632,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Read the website configuration
-	wsResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	wsResponse, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketWebsite(&s3.GetBucketWebsiteInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -688,7 +692,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the versioning configuration
 
-	versioningResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	versioningResponse, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketVersioning(&s3.GetBucketVersioningInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -720,7 +724,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the acceleration status
 
-	accelerateResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	accelerateResponse, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketAccelerateConfiguration(&s3.GetBucketAccelerateConfigurationInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -749,7 +753,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the request payer configuration.
 
-	payerResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	payerResponse, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketRequestPayment(&s3.GetBucketRequestPaymentInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -766,7 +770,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Read the logging configuration
-	loggingResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	loggingResponse, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketLogging(&s3.GetBucketLoggingInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -794,7 +798,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the lifecycle configuration
 
-	lifecycleResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	lifecycleResponse, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketLifecycleConfiguration(&s3.GetBucketLifecycleConfigurationInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -921,7 +925,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Read the bucket replication configuration
 
-	replicationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	replicationResponse, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketReplication(&s3.GetBucketReplicationInput{
 			Bucket: aws.String(d.Id()),
 		})
@@ -943,7 +947,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Add the region as an attribute
 
-	locationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	locationResponse, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketLocation(
 			&s3.GetBucketLocationInput{
 				Bucket: aws.String(d.Id()),
@@ -970,7 +974,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// Add website_endpoint as an attribute
-	websiteEndpoint, err := websiteEndpoint(s3conn, d)
+	websiteEndpoint, err := websiteEndpoint(s3conn, d, retryTimeout)
 	if err != nil {
 		return err
 	}
@@ -988,7 +992,7 @@ func resourceAwsS3BucketRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	if err := d.Set("tags", tagsToMapS3(tagSet)); err != nil {
+	if err := setTagsAll(d, meta, tagsToMapS3(tagSet)); err != nil {
 		return err
 	}
 
@@ -1065,7 +1069,7 @@ func resourceAwsS3BucketDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceAwsS3BucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData, retryTimeout time.Duration) error {
 	bucket := d.Get("bucket").(string)
 	policy := d.Get("policy").(string)
 
@@ -1094,7 +1098,7 @@ func resourceAwsS3BucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData) erro
 		}
 	} else {
 		log.Printf("[DEBUG] S3 bucket: %s, delete policy: %s", bucket, policy)
-		_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		_, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 			return s3conn.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{
 				Bucket: aws.String(bucket),
 			})
@@ -1108,7 +1112,7 @@ func resourceAwsS3BucketPolicyUpdate(s3conn *s3.S3, d *schema.ResourceData) erro
 	return nil
 }
 
-func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData, retryTimeout time.Duration) error {
 	bucket := d.Get("bucket").(string)
 	rawCors := d.Get("cors_rule").([]interface{})
 
@@ -1116,7 +1120,7 @@ func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error
 		// Delete CORS
 		log.Printf("[DEBUG] S3 bucket: %s, delete CORS", bucket)
 
-		_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		_, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 			return s3conn.DeleteBucketCors(&s3.DeleteBucketCorsInput{
 				Bucket: aws.String(bucket),
 			})
@@ -1162,7 +1166,7 @@ func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error
 		}
 		log.Printf("[DEBUG] S3 bucket: %s, put CORS: %#v", bucket, corsInput)
 
-		_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+		_, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 			return s3conn.PutBucketCors(corsInput)
 		})
 		if err != nil {
@@ -1173,7 +1177,7 @@ func resourceAwsS3BucketCorsUpdate(s3conn *s3.S3, d *schema.ResourceData) error
 	return nil
 }
 
-func resourceAwsS3BucketWebsiteUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketWebsiteUpdate(s3conn *s3.S3, d *schema.ResourceData, retryTimeout time.Duration) error {
 	ws := d.Get("website").([]interface{})
 
 	if len(ws) == 1 {
@@ -1183,15 +1187,15 @@ func resourceAwsS3BucketWebsiteUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 		} else {
 			w = make(map[string]interface{})
 		}
-		return resourceAwsS3BucketWebsitePut(s3conn, d, w)
+		return resourceAwsS3BucketWebsitePut(s3conn, d, w, retryTimeout)
 	} else if len(ws) == 0 {
-		return resourceAwsS3BucketWebsiteDelete(s3conn, d)
+		return resourceAwsS3BucketWebsiteDelete(s3conn, d, retryTimeout)
 	} else {
 		return fmt.Errorf("Cannot specify more than one website.")
 	}
 }
 
-func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, website map[string]interface{}) error {
+func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, website map[string]interface{}, retryTimeout time.Duration) error {
 	bucket := d.Get("bucket").(string)
 
 	var indexDocument, errorDocument, redirectAllRequestsTo, routingRules string
@@ -1255,7 +1259,7 @@ func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, websit
 
 	log.Printf("[DEBUG] S3 put bucket website: %#v", putInput)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.PutBucketWebsite(putInput)
 	})
 	if err != nil {
@@ -1265,13 +1269,13 @@ func resourceAwsS3BucketWebsitePut(s3conn *s3.S3, d *schema.ResourceData, websit
 	return nil
 }
 
-func resourceAwsS3BucketWebsiteDelete(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketWebsiteDelete(s3conn *s3.S3, d *schema.ResourceData, retryTimeout time.Duration) error {
 	bucket := d.Get("bucket").(string)
 	deleteInput := &s3.DeleteBucketWebsiteInput{Bucket: aws.String(bucket)}
 
 	log.Printf("[DEBUG] S3 delete bucket website: %#v", deleteInput)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.DeleteBucketWebsite(deleteInput)
 	})
 	if err != nil {
@@ -1284,7 +1288,7 @@ func resourceAwsS3BucketWebsiteDelete(s3conn *s3.S3, d *schema.ResourceData) err
 	return nil
 }
 
-func websiteEndpoint(s3conn *s3.S3, d *schema.ResourceData) (*S3Website, error) {
+func websiteEndpoint(s3conn *s3.S3, d *schema.ResourceData, retryTimeout time.Duration) (*S3Website, error) {
 	// If the bucket doesn't have a website configuration, return an empty
 	// endpoint
 	if _, ok := d.GetOk("website"); !ok {
@@ -1295,7 +1299,7 @@ func websiteEndpoint(s3conn *s3.S3, d *schema.ResourceData) (*S3Website, error)
 
 	// Lookup the region for this bucket
 
-	locationResponse, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	locationResponse, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.GetBucketLocation(
 			&s3.GetBucketLocationInput{
 				Bucket: aws.String(bucket),
@@ -1354,7 +1358,7 @@ func isOldRegion(region string) bool {
 	return false
 }
 
-func resourceAwsS3BucketAclUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketAclUpdate(s3conn *s3.S3, d *schema.ResourceData, retryTimeout time.Duration) error {
 	acl := d.Get("acl").(string)
 	bucket := d.Get("bucket").(string)
 
@@ -1364,7 +1368,7 @@ func resourceAwsS3BucketAclUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	}
 	log.Printf("[DEBUG] S3 put bucket ACL: %#v", i)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.PutBucketAcl(i)
 	})
 	if err != nil {
@@ -1374,7 +1378,7 @@ func resourceAwsS3BucketAclUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
-func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData, retryTimeout time.Duration) error {
 	v := d.Get("versioning").([]interface{})
 	bucket := d.Get("bucket").(string)
 	vc := &s3.VersioningConfiguration{}
@@ -1404,7 +1408,7 @@ func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData)
 	}
 	log.Printf("[DEBUG] S3 put bucket versioning: %#v", i)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.PutBucketVersioning(i)
 	})
 	if err != nil {
@@ -1414,7 +1418,7 @@ func resourceAwsS3BucketVersioningUpdate(s3conn *s3.S3, d *schema.ResourceData)
 	return nil
 }
 
-func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData, retryTimeout time.Duration) error {
 	logging := d.Get("logging").(*schema.Set).List()
 	bucket := d.Get("bucket").(string)
 	loggingStatus := &s3.BucketLoggingStatus{}
@@ -1439,7 +1443,7 @@ func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 	}
 	log.Printf("[DEBUG] S3 put bucket logging: %#v", i)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.PutBucketLogging(i)
 	})
 	if err != nil {
@@ -1449,7 +1453,7 @@ func resourceAwsS3BucketLoggingUpdate(s3conn *s3.S3, d *schema.ResourceData) err
 	return nil
 }
 
-func resourceAwsS3BucketAccelerationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketAccelerationUpdate(s3conn *s3.S3, d *schema.ResourceData, retryTimeout time.Duration) error {
 	bucket := d.Get("bucket").(string)
 	enableAcceleration := d.Get("acceleration_status").(string)
 
@@ -1461,7 +1465,7 @@ func resourceAwsS3BucketAccelerationUpdate(s3conn *s3.S3, d *schema.ResourceData
 	}
 	log.Printf("[DEBUG] S3 put bucket acceleration: %#v", i)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.PutBucketAccelerateConfiguration(i)
 	})
 	if err != nil {
@@ -1471,7 +1475,7 @@ func resourceAwsS3BucketAccelerationUpdate(s3conn *s3.S3, d *schema.ResourceData
 	return nil
 }
 
-func resourceAwsS3BucketRequestPayerUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketRequestPayerUpdate(s3conn *s3.S3, d *schema.ResourceData, retryTimeout time.Duration) error {
 	bucket := d.Get("bucket").(string)
 	payer := d.Get("request_payer").(string)
 
@@ -1483,7 +1487,7 @@ func resourceAwsS3BucketRequestPayerUpdate(s3conn *s3.S3, d *schema.ResourceData
 	}
 	log.Printf("[DEBUG] S3 put bucket request payer: %#v", i)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.PutBucketRequestPayment(i)
 	})
 	if err != nil {
@@ -1493,7 +1497,7 @@ func resourceAwsS3BucketRequestPayerUpdate(s3conn *s3.S3, d *schema.ResourceData
 	return nil
 }
 
-func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.ResourceData, retryTimeout time.Duration) error {
 	bucket := d.Get("bucket").(string)
 	replicationConfiguration := d.Get("replication_configuration").([]interface{})
 
@@ -1570,7 +1574,7 @@ func resourceAwsS3BucketReplicationConfigurationUpdate(s3conn *s3.S3, d *schema.
 	}
 	log.Printf("[DEBUG] S3 put bucket replication configuration: %#v", i)
 
-	_, err := retryOnAwsCode("NoSuchBucket", func() (interface{}, error) {
+	_, err := retryOnAwsCode(retryTimeout, "NoSuchBucket", func() (interface{}, error) {
 		return s3conn.PutBucketReplication(i)
 	})
 	if err != nil {
@@ -21,10 +21,15 @@ import (
 
 func resourceAwsS3BucketObject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsS3BucketObjectPut,
-		Read:   resourceAwsS3BucketObjectRead,
-		Update: resourceAwsS3BucketObjectPut,
-		Delete: resourceAwsS3BucketObjectDelete,
+		Create:        resourceAwsS3BucketObjectPut,
+		Read:          resourceAwsS3BucketObjectRead,
+		Update:        resourceAwsS3BucketObjectPut,
+		Delete:        resourceAwsS3BucketObjectDelete,
+		CustomizeDiff: setTagsAllDiff,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsS3BucketObjectImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -119,7 +124,8 @@ func resourceAwsS3BucketObject() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 
 			"website_redirect": {
 				Type:     schema.TypeString,
@@ -129,6 +135,20 @@ func resourceAwsS3BucketObject() *schema.Resource {
 	}
 }
 
+func resourceAwsS3BucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Object keys may contain "/", bucket names may not
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected BUCKET/KEY", d.Id())
+	}
+
+	d.Set("bucket", idParts[0])
+	d.Set("key", idParts[1])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) error {
 	s3conn := meta.(*AWSClient).s3conn
 
@@ -198,11 +218,11 @@ func resourceAwsS3BucketObjectPut(d *schema.ResourceData, meta interface{}) erro
 		putInput.ServerSideEncryption = aws.String(s3.ServerSideEncryptionAwsKms)
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
-		if restricted {
-			return fmt.Errorf("This region does not allow for tags on S3 objects")
-		}
+	if _, ok := d.GetOk("tags"); ok && restricted {
+		return fmt.Errorf("This region does not allow for tags on S3 objects")
+	}
 
+	if v, ok := d.GetOk("tags_all"); ok && !restricted {
 		// The tag-set must be encoded as URL Query parameters.
 		values := url.Values{}
 		for k, v := range v.(map[string]interface{}) {
@@ -296,7 +316,7 @@ func resourceAwsS3BucketObjectRead(d *schema.ResourceData, meta interface{}) err
 		if err != nil {
 			return fmt.Errorf("Failed to get object tags (bucket: %s, key: %s): %s", bucket, key, err)
 		}
-		d.Set("tags", tagsToMapS3(tagResp.TagSet))
+		setTagsAll(d, meta, tagsToMapS3(tagResp.TagSet))
 	}
 
 	return nil
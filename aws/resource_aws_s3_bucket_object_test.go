@@ -58,6 +58,14 @@ func TestAccAWSS3BucketObject_content(t *testing.T) {
 				Config:    testAccAWSS3BucketObjectConfigContent(rInt),
 				Check:     testAccCheckAWSS3BucketObjectExists("aws_s3_bucket_object.object", &obj),
 			},
+
+			resource.TestStep{
+				ResourceName:            "aws_s3_bucket_object.object",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"acl", "content"},
+				ImportStateIdFunc:       testAccAWSS3BucketObjectImportStateIdFunc("aws_s3_bucket_object.object"),
+			},
 		},
 	})
 }
@@ -710,3 +718,14 @@ resource "aws_s3_bucket_object" "object" {
 }
 `, randInt)
 }
+
+func testAccAWSS3BucketObjectImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["bucket"], rs.Primary.Attributes["key"]), nil
+	}
+}
@@ -19,6 +19,10 @@ func resourceAwsS3BucketPolicy() *schema.Resource {
 		Update: resourceAwsS3BucketPolicyPut,
 		Delete: resourceAwsS3BucketPolicyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -85,6 +89,7 @@ func resourceAwsS3BucketPolicyRead(d *schema.ResourceData, meta interface{}) err
 	if err := d.Set("policy", v); err != nil {
 		return err
 	}
+	d.Set("bucket", d.Id())
 
 	return nil
 }
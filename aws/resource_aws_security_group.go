@@ -19,10 +19,11 @@ import (
 
 func resourceAwsSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsSecurityGroupCreate,
-		Read:   resourceAwsSecurityGroupRead,
-		Update: resourceAwsSecurityGroupUpdate,
-		Delete: resourceAwsSecurityGroupDelete,
+		Create:        resourceAwsSecurityGroupCreate,
+		Read:          resourceAwsSecurityGroupRead,
+		Update:        resourceAwsSecurityGroupUpdate,
+		Delete:        resourceAwsSecurityGroupDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsSecurityGroupImportState,
 		},
@@ -123,6 +124,12 @@ func resourceAwsSecurityGroup() *schema.Resource {
 							},
 						},
 
+						"prefix_list_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
 						"security_groups": {
 							Type:     schema.TypeSet,
 							Optional: true,
@@ -220,7 +227,8 @@ func resourceAwsSecurityGroup() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 
 			"revoke_rules_on_delete": {
 				Type:     schema.TypeBool,
@@ -387,7 +395,7 @@ func resourceAwsSecurityGroupRead(d *schema.ResourceData, meta interface{}) erro
 		log.Printf("[WARN] Error setting Egress rule set for (%s): %s", d.Id(), err)
 	}
 
-	d.Set("tags", tagsToMap(sg.Tags))
+	setTagsAll(d, meta, tagsToMap(sg.Tags))
 	return nil
 }
 
@@ -422,6 +430,7 @@ func resourceAwsSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) er
 			return err
 		}
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	return resourceAwsSecurityGroupRead(d, meta)
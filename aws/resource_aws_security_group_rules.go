@@ -0,0 +1,771 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsSecurityGroupRules() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSecurityGroupRulesCreate,
+		Read:   resourceAwsSecurityGroupRulesRead,
+		Update: resourceAwsSecurityGroupRulesUpdate,
+		Delete: resourceAwsSecurityGroupRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"ingress": securityGroupRulesSchema(),
+
+			"egress": securityGroupRulesSchema(),
+		},
+	}
+}
+
+func securityGroupRulesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from_port": {
+					Type:     schema.TypeInt,
+					Required: true,
+				},
+
+				"to_port": {
+					Type:     schema.TypeInt,
+					Required: true,
+				},
+
+				"protocol": {
+					Type:      schema.TypeString,
+					Required:  true,
+					StateFunc: protocolStateFunc,
+				},
+
+				"cidr_blocks": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateCIDRNetworkAddress,
+					},
+				},
+
+				"ipv6_cidr_blocks": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validateCIDRNetworkAddress,
+					},
+				},
+
+				"prefix_list_ids": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				"security_groups": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Set:      schema.HashString,
+				},
+
+				"self": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"description": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validateSecurityGroupRuleDescription,
+				},
+			},
+		},
+		Set: resourceAwsSecurityGroupRuleHash,
+	}
+}
+
+func resourceAwsSecurityGroupRulesCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	sg_id := d.Get("security_group_id").(string)
+
+	awsMutexKV.Lock(sg_id)
+	defer awsMutexKV.Unlock(sg_id)
+
+	sg, err := findResourceSecurityGroup(conn, sg_id)
+	if err != nil {
+		return err
+	}
+
+	for _, ruleType := range []string{"ingress", "egress"} {
+		blocks := d.Get(ruleType).(*schema.Set)
+		want, err := expandSecurityGroupRules(blocks.List(), sg)
+		if err != nil {
+			return fmt.Errorf("Error expanding %s rules of Security Group (%s): %s", ruleType, sg_id, err)
+		}
+		have := securityGroupRulesInPlace(sg, ruleType, blocks.Len() > 0)
+
+		if err := securityGroupRulesCheckConflicts(sg_id, ruleType, want, have, nil); err != nil {
+			return err
+		}
+
+		if err := securityGroupRulesUpdate(conn, sg, ruleType, want, have); err != nil {
+			return err
+		}
+	}
+
+	d.SetId(sg_id)
+
+	return resourceAwsSecurityGroupRulesRead(d, meta)
+}
+
+func resourceAwsSecurityGroupRulesRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	sg, err := findResourceSecurityGroup(conn, d.Id())
+	if _, notFound := err.(securityGroupNotFound); notFound {
+		log.Printf("[WARN] Security Group (%s) not found, removing rules from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading Security Group (%s) rules: %s", d.Id(), err)
+	}
+
+	d.Set("security_group_id", sg.GroupId)
+
+	for _, ruleType := range []string{"ingress", "egress"} {
+		blocks := d.Get(ruleType).(*schema.Set)
+		have := securityGroupRulesInPlace(sg, ruleType, blocks.Len() > 0)
+		rules := flattenSecurityGroupRulesBlocks(blocks.List(), have, sg)
+		if err := d.Set(ruleType, rules); err != nil {
+			return fmt.Errorf("Error setting %s rules of Security Group (%s): %s", ruleType, d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsSecurityGroupRulesUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	sg_id := d.Id()
+
+	awsMutexKV.Lock(sg_id)
+	defer awsMutexKV.Unlock(sg_id)
+
+	sg, err := findResourceSecurityGroup(conn, sg_id)
+	if err != nil {
+		return err
+	}
+
+	for _, ruleType := range []string{"ingress", "egress"} {
+		if !d.HasChange(ruleType) {
+			continue
+		}
+
+		o, n := d.GetChange(ruleType)
+		blocks := n.(*schema.Set)
+		want, err := expandSecurityGroupRules(blocks.List(), sg)
+		if err != nil {
+			return fmt.Errorf("Error expanding %s rules of Security Group (%s): %s", ruleType, sg_id, err)
+		}
+		managed, err := expandSecurityGroupRules(o.(*schema.Set).List(), sg)
+		if err != nil {
+			return fmt.Errorf("Error expanding %s rules of Security Group (%s): %s", ruleType, sg_id, err)
+		}
+		have := securityGroupRulesInPlace(sg, ruleType, blocks.Len() > 0)
+
+		if err := securityGroupRulesCheckConflicts(sg_id, ruleType, want, have, managed); err != nil {
+			return err
+		}
+
+		if err := securityGroupRulesUpdate(conn, sg, ruleType, want, have); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsSecurityGroupRulesRead(d, meta)
+}
+
+func resourceAwsSecurityGroupRulesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	sg_id := d.Id()
+
+	awsMutexKV.Lock(sg_id)
+	defer awsMutexKV.Unlock(sg_id)
+
+	sg, err := findResourceSecurityGroup(conn, sg_id)
+	if _, notFound := err.(securityGroupNotFound); notFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, ruleType := range []string{"ingress", "egress"} {
+		managed, err := expandSecurityGroupRules(d.Get(ruleType).(*schema.Set).List(), sg)
+		if err != nil {
+			return fmt.Errorf("Error expanding %s rules of Security Group (%s): %s", ruleType, sg_id, err)
+		}
+		managedKeys := make(map[string]bool, len(managed))
+		for _, r := range managed {
+			managedKeys[r.key()] = true
+		}
+
+		// Only revoke the managed rules which are still in place.
+		var revoke []*securityGroupRule
+		for _, r := range flattenSecurityGroupRules(securityGroupPermissions(sg, ruleType), sg) {
+			if managedKeys[r.key()] {
+				revoke = append(revoke, r)
+			}
+		}
+
+		if err := securityGroupRulesRevoke(conn, sg, ruleType, revoke); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// securityGroupRule is a single permission, for one protocol and port range,
+// granted to a single source. Reconciling rules at this level means that
+// changing one source of a multi-source block leaves the others in place.
+type securityGroupRule struct {
+	protocol    string
+	fromPort    int64
+	toPort      int64
+	kind        string
+	source      string
+	self        bool
+	description string
+}
+
+const (
+	securityGroupRuleKindCidr       = "cidr"
+	securityGroupRuleKindIpv6Cidr   = "ipv6_cidr"
+	securityGroupRuleKindPrefixList = "prefix_list"
+	securityGroupRuleKindGroup      = "group"
+)
+
+func newSecurityGroupRule(protocol string, fromPort, toPort int64, description string) securityGroupRule {
+	protocol = protocolForValue(protocol)
+	// Ports are meaningless when all protocols are allowed and aren't
+	// returned by the API.
+	if protocol == "-1" {
+		fromPort, toPort = 0, 0
+	}
+
+	return securityGroupRule{
+		protocol:    protocol,
+		fromPort:    fromPort,
+		toPort:      toPort,
+		description: description,
+	}
+}
+
+func (r *securityGroupRule) key() string {
+	return fmt.Sprintf("%s-%d-%d-%s-%s", r.protocol, r.fromPort, r.toPort, r.kind, r.source)
+}
+
+func (r *securityGroupRule) String() string {
+	return fmt.Sprintf("%s %d-%d %s", r.protocol, r.fromPort, r.toPort, r.source)
+}
+
+// ipPermission returns the API representation of the rule.
+func (r *securityGroupRule) ipPermission(sg *ec2.SecurityGroup) *ec2.IpPermission {
+	perm := &ec2.IpPermission{
+		IpProtocol: aws.String(r.protocol),
+		FromPort:   aws.Int64(r.fromPort),
+		ToPort:     aws.Int64(r.toPort),
+	}
+
+	var description *string
+	if r.description != "" {
+		description = aws.String(r.description)
+	}
+
+	switch r.kind {
+	case securityGroupRuleKindCidr:
+		perm.IpRanges = []*ec2.IpRange{{CidrIp: aws.String(r.source), Description: description}}
+	case securityGroupRuleKindIpv6Cidr:
+		perm.Ipv6Ranges = []*ec2.Ipv6Range{{CidrIpv6: aws.String(r.source), Description: description}}
+	case securityGroupRuleKindPrefixList:
+		perm.PrefixListIds = []*ec2.PrefixListId{{PrefixListId: aws.String(r.source), Description: description}}
+	case securityGroupRuleKindGroup:
+		pair := &ec2.UserIdGroupPair{Description: description}
+
+		id := r.source
+		if items := strings.Split(id, "/"); len(items) > 1 {
+			pair.UserId, id = aws.String(items[0]), items[1]
+		}
+		if isSecurityGroupVPC(sg) {
+			pair.GroupId = aws.String(id)
+		} else {
+			pair.GroupName = aws.String(id)
+		}
+
+		perm.UserIdGroupPairs = []*ec2.UserIdGroupPair{pair}
+	}
+
+	return perm
+}
+
+func isSecurityGroupVPC(sg *ec2.SecurityGroup) bool {
+	return sg.VpcId != nil && *sg.VpcId != ""
+}
+
+func securityGroupPermissions(sg *ec2.SecurityGroup, ruleType string) []*ec2.IpPermission {
+	if ruleType == "ingress" {
+		return sg.IpPermissions
+	}
+	return sg.IpPermissionsEgress
+}
+
+// expandSecurityGroupRules splits the ingress or egress blocks of the
+// configuration into single source rules.
+func expandSecurityGroupRules(blocks []interface{}, sg *ec2.SecurityGroup) ([]*securityGroupRule, error) {
+	var rules []*securityGroupRule
+
+	for _, raw := range blocks {
+		m := raw.(map[string]interface{})
+		rule := newSecurityGroupRule(m["protocol"].(string), int64(m["from_port"].(int)), int64(m["to_port"].(int)), m["description"].(string))
+
+		add := func(kind, source string) {
+			r := rule
+			r.kind, r.source = kind, source
+			rules = append(rules, &r)
+		}
+
+		n := len(rules)
+
+		for _, v := range m["cidr_blocks"].([]interface{}) {
+			cidr, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("empty element found in cidr_blocks - consider using the compact function")
+			}
+			add(securityGroupRuleKindCidr, cidr)
+		}
+
+		for _, v := range m["ipv6_cidr_blocks"].([]interface{}) {
+			cidr, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("empty element found in ipv6_cidr_blocks - consider using the compact function")
+			}
+			add(securityGroupRuleKindIpv6Cidr, cidr)
+		}
+
+		for _, v := range m["prefix_list_ids"].([]interface{}) {
+			id, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("empty element found in prefix_list_ids - consider using the compact function")
+			}
+			add(securityGroupRuleKindPrefixList, id)
+		}
+
+		for _, v := range m["security_groups"].(*schema.Set).List() {
+			add(securityGroupRuleKindGroup, v.(string))
+		}
+
+		if m["self"].(bool) {
+			r := rule
+			r.kind, r.source, r.self = securityGroupRuleKindGroup, *sg.GroupId, true
+			if !isSecurityGroupVPC(sg) {
+				r.source = *sg.GroupName
+			}
+			rules = append(rules, &r)
+		}
+
+		if len(rules) == n {
+			return nil, fmt.Errorf("One of ['cidr_blocks', 'ipv6_cidr_blocks', 'prefix_list_ids', 'security_groups', 'self'] must be set in each rule")
+		}
+	}
+
+	return rules, nil
+}
+
+// flattenSecurityGroupRules splits the permissions of a security group
+// into single source rules.
+func flattenSecurityGroupRules(perms []*ec2.IpPermission, sg *ec2.SecurityGroup) []*securityGroupRule {
+	var rules []*securityGroupRule
+
+	for _, perm := range perms {
+		rule := newSecurityGroupRule(aws.StringValue(perm.IpProtocol), aws.Int64Value(perm.FromPort), aws.Int64Value(perm.ToPort), "")
+
+		add := func(kind, source string, description *string) {
+			r := rule
+			r.kind, r.source, r.description = kind, source, aws.StringValue(description)
+			rules = append(rules, &r)
+		}
+
+		for _, ip := range perm.IpRanges {
+			add(securityGroupRuleKindCidr, aws.StringValue(ip.CidrIp), ip.Description)
+		}
+
+		for _, ip := range perm.Ipv6Ranges {
+			add(securityGroupRuleKindIpv6Cidr, aws.StringValue(ip.CidrIpv6), ip.Description)
+		}
+
+		for _, pl := range perm.PrefixListIds {
+			add(securityGroupRuleKindPrefixList, aws.StringValue(pl.PrefixListId), pl.Description)
+		}
+
+		for _, g := range flattenSecurityGroups(perm.UserIdGroupPairs, sg.OwnerId) {
+			if g.GroupName != nil {
+				add(securityGroupRuleKindGroup, *g.GroupName, g.Description)
+			} else {
+				add(securityGroupRuleKindGroup, aws.StringValue(g.GroupId), g.Description)
+			}
+
+			r := rules[len(rules)-1]
+			r.self = r.source == aws.StringValue(sg.GroupId) || (!isSecurityGroupVPC(sg) && r.source == aws.StringValue(sg.GroupName))
+		}
+	}
+
+	return rules
+}
+
+// flattenSecurityGroupRulesBlocks rebuilds the ingress or egress blocks of
+// the configuration from the rules in place. Configured blocks keep their
+// shape, with any missing sources left out, and rules which aren't part of
+// any configured block are grouped into blocks of their own.
+func flattenSecurityGroupRulesBlocks(blocks []interface{}, have []*securityGroupRule, sg *ec2.SecurityGroup) []map[string]interface{} {
+	haveByKey := make(map[string]*securityGroupRule, len(have))
+	for _, r := range have {
+		haveByKey[r.key()] = r
+	}
+	remaining := make(map[string]*securityGroupRule, len(have))
+	for k, r := range haveByKey {
+		remaining[k] = r
+	}
+
+	result := make([]map[string]interface{}, 0)
+
+	for _, raw := range blocks {
+		m := raw.(map[string]interface{})
+		rules, err := expandSecurityGroupRules([]interface{}{m}, sg)
+		if err != nil {
+			log.Printf("[WARN] Skipping Security Group (%s) rule: %s", aws.StringValue(sg.GroupId), err)
+			continue
+		}
+
+		var present []*securityGroupRule
+		for _, r := range rules {
+			if h, ok := haveByKey[r.key()]; ok && h.description == r.description {
+				present = append(present, r)
+				delete(remaining, r.key())
+			}
+		}
+
+		if len(present) > 0 {
+			result = append(result, securityGroupRulesBlock(m["protocol"].(string), m["from_port"].(int), m["to_port"].(int), m["description"].(string), present))
+		}
+	}
+
+	groups := make(map[string][]*securityGroupRule)
+	for _, r := range remaining {
+		k := fmt.Sprintf("%s-%d-%d-%s", r.protocol, r.fromPort, r.toPort, r.description)
+		groups[k] = append(groups[k], r)
+	}
+
+	keys := make([]string, 0, len(groups))
+	for k := range groups {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		rules := groups[k]
+		sort.Slice(rules, func(i, j int) bool { return rules[i].source < rules[j].source })
+
+		r := rules[0]
+		result = append(result, securityGroupRulesBlock(r.protocol, int(r.fromPort), int(r.toPort), r.description, rules))
+	}
+
+	return result
+}
+
+func securityGroupRulesBlock(protocol string, fromPort, toPort int, description string, rules []*securityGroupRule) map[string]interface{} {
+	cidrBlocks := make([]interface{}, 0)
+	ipv6CidrBlocks := make([]interface{}, 0)
+	prefixListIds := make([]interface{}, 0)
+	securityGroups := schema.NewSet(schema.HashString, nil)
+	self := false
+
+	for _, r := range rules {
+		switch r.kind {
+		case securityGroupRuleKindCidr:
+			cidrBlocks = append(cidrBlocks, r.source)
+		case securityGroupRuleKindIpv6Cidr:
+			ipv6CidrBlocks = append(ipv6CidrBlocks, r.source)
+		case securityGroupRuleKindPrefixList:
+			prefixListIds = append(prefixListIds, r.source)
+		case securityGroupRuleKindGroup:
+			if r.self {
+				self = true
+			} else {
+				securityGroups.Add(r.source)
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"protocol":         protocol,
+		"from_port":        fromPort,
+		"to_port":          toPort,
+		"description":      description,
+		"cidr_blocks":      cidrBlocks,
+		"ipv6_cidr_blocks": ipv6CidrBlocks,
+		"prefix_list_ids":  prefixListIds,
+		"security_groups":  securityGroups,
+		"self":             self,
+	}
+}
+
+// securityGroupRulesDiff returns the rules which have to be authorized,
+// revoked and have their description updated to go from have to want.
+func securityGroupRulesDiff(want, have []*securityGroupRule) (authorize, revoke, describe []*securityGroupRule) {
+	wantByKey := make(map[string]*securityGroupRule, len(want))
+	for _, r := range want {
+		wantByKey[r.key()] = r
+	}
+	haveByKey := make(map[string]*securityGroupRule, len(have))
+	for _, r := range have {
+		haveByKey[r.key()] = r
+	}
+
+	for k, r := range wantByKey {
+		h, ok := haveByKey[k]
+		if !ok {
+			authorize = append(authorize, r)
+		} else if h.description != r.description {
+			describe = append(describe, r)
+		}
+	}
+
+	for k, r := range haveByKey {
+		if _, ok := wantByKey[k]; !ok {
+			revoke = append(revoke, r)
+		}
+	}
+
+	return
+}
+
+// isSecurityGroupDefaultEgressRule returns whether the rule is the allow all
+// egress rule every VPC security group is created with.
+func isSecurityGroupDefaultEgressRule(r *securityGroupRule) bool {
+	return r.protocol == "-1" && r.kind == securityGroupRuleKindCidr && r.source == "0.0.0.0/0"
+}
+
+// securityGroupRulesInPlace returns the ingress or egress rules of a security
+// group which the resource reconciles. Unless egress blocks are declared, the
+// allow all egress rule is left to the group and neither read nor revoked.
+func securityGroupRulesInPlace(sg *ec2.SecurityGroup, ruleType string, declared bool) []*securityGroupRule {
+	have := flattenSecurityGroupRules(securityGroupPermissions(sg, ruleType), sg)
+	if ruleType != "egress" || declared {
+		return have
+	}
+
+	var rules []*securityGroupRule
+	for _, r := range have {
+		if !isSecurityGroupDefaultEgressRule(r) {
+			rules = append(rules, r)
+		}
+	}
+	return rules
+}
+
+// securityGroupRulesConflicting lists the rules in place which would be
+// revoked although this resource doesn't manage them yet, other than the
+// allow all egress rule every VPC security group is created with.
+func securityGroupRulesConflicting(ruleType string, want, have, managed []*securityGroupRule) []string {
+	_, revoke, _ := securityGroupRulesDiff(want, have)
+
+	managedKeys := make(map[string]bool, len(managed))
+	for _, r := range managed {
+		managedKeys[r.key()] = true
+	}
+
+	var conflicts []string
+	for _, r := range revoke {
+		if managedKeys[r.key()] {
+			continue
+		}
+		if ruleType == "egress" && isSecurityGroupDefaultEgressRule(r) {
+			continue
+		}
+		conflicts = append(conflicts, r.String())
+	}
+	sort.Strings(conflicts)
+
+	return conflicts
+}
+
+// securityGroupRulesCheckConflicts fails if reconciling the rules would revoke
+// rules which aren't part of this resource. Those are most likely managed
+// elsewhere, and taking them over would have the two configurations undo each
+// other's changes on every apply.
+func securityGroupRulesCheckConflicts(sg_id, ruleType string, want, have, managed []*securityGroupRule) error {
+	conflicts := securityGroupRulesConflicting(ruleType, want, have, managed)
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("Security Group (%s) has %s rules which are not declared in this resource: %s. "+
+		"These are usually managed by inline %s blocks of an aws_security_group resource, or by "+
+		"aws_security_group_rule resources, and would conflict with this resource. Remove them from "+
+		"there or declare them here after importing the rules with `terraform import`.",
+		sg_id, ruleType, strings.Join(conflicts, ", "), ruleType)
+}
+
+// securityGroupRulesUpdate changes the ingress or egress rules of a
+// security group from have to want, leaving rules which are in both alone.
+// New rules are authorized before old ones are revoked so that traffic
+// moving from one rule to another isn't dropped in between.
+func securityGroupRulesUpdate(conn *ec2.EC2, sg *ec2.SecurityGroup, ruleType string, want, have []*securityGroupRule) error {
+	authorize, revoke, describe := securityGroupRulesDiff(want, have)
+	sg_id := aws.StringValue(sg.GroupId)
+
+	if len(authorize) > 0 {
+		perms := securityGroupRulesPermissions(authorize, sg)
+		log.Printf("[DEBUG] Authorizing Security Group (%s) %s rules: %s", sg_id, ruleType, perms)
+
+		var err error
+		switch ruleType {
+		case "ingress":
+			req := &ec2.AuthorizeSecurityGroupIngressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: perms,
+			}
+			if !isSecurityGroupVPC(sg) {
+				req.GroupId = nil
+				req.GroupName = sg.GroupName
+			}
+			_, err = conn.AuthorizeSecurityGroupIngress(req)
+		case "egress":
+			_, err = conn.AuthorizeSecurityGroupEgress(&ec2.AuthorizeSecurityGroupEgressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: perms,
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("Error authorizing Security Group (%s) %s rules: %s", sg_id, ruleType, err)
+		}
+	}
+
+	if len(describe) > 0 {
+		perms := securityGroupRulesPermissions(describe, sg)
+		log.Printf("[DEBUG] Updating Security Group (%s) %s rule descriptions: %s", sg_id, ruleType, perms)
+
+		var err error
+		switch ruleType {
+		case "ingress":
+			req := &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: perms,
+			}
+			if !isSecurityGroupVPC(sg) {
+				req.GroupId = nil
+				req.GroupName = sg.GroupName
+			}
+			_, err = conn.UpdateSecurityGroupRuleDescriptionsIngress(req)
+		case "egress":
+			_, err = conn.UpdateSecurityGroupRuleDescriptionsEgress(&ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+				GroupId:       sg.GroupId,
+				IpPermissions: perms,
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("Error updating Security Group (%s) %s rule descriptions: %s", sg_id, ruleType, err)
+		}
+	}
+
+	if err := securityGroupRulesRevoke(conn, sg, ruleType, revoke); err != nil {
+		return err
+	}
+
+	if len(authorize) == 0 {
+		return nil
+	}
+
+	// Wait for the new rules to show up, as the singular resource does.
+	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+		sg, err := findResourceSecurityGroup(conn, sg_id)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		have := flattenSecurityGroupRules(securityGroupPermissions(sg, ruleType), sg)
+		if authorize, _, _ := securityGroupRulesDiff(want, have); len(authorize) > 0 {
+			log.Printf("[DEBUG] Waiting for %d Security Group (%s) %s rules", len(authorize), sg_id, ruleType)
+			return resource.RetryableError(fmt.Errorf("Security Group (%s) %s rules not yet in place", sg_id, ruleType))
+		}
+
+		return nil
+	})
+}
+
+func securityGroupRulesRevoke(conn *ec2.EC2, sg *ec2.SecurityGroup, ruleType string, rules []*securityGroupRule) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	sg_id := aws.StringValue(sg.GroupId)
+	perms := securityGroupRulesPermissions(rules, sg)
+	log.Printf("[DEBUG] Revoking Security Group (%s) %s rules: %s", sg_id, ruleType, perms)
+
+	var err error
+	switch ruleType {
+	case "ingress":
+		req := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:       sg.GroupId,
+			IpPermissions: perms,
+		}
+		if !isSecurityGroupVPC(sg) {
+			req.GroupId = nil
+			req.GroupName = sg.GroupName
+		}
+		_, err = conn.RevokeSecurityGroupIngress(req)
+	case "egress":
+		_, err = conn.RevokeSecurityGroupEgress(&ec2.RevokeSecurityGroupEgressInput{
+			GroupId:       sg.GroupId,
+			IpPermissions: perms,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("Error revoking Security Group (%s) %s rules: %s", sg_id, ruleType, err)
+	}
+
+	return nil
+}
+
+func securityGroupRulesPermissions(rules []*securityGroupRule, sg *ec2.SecurityGroup) []*ec2.IpPermission {
+	perms := make([]*ec2.IpPermission, len(rules))
+	for i, r := range rules {
+		perms[i] = r.ipPermission(sg)
+	}
+	return perms
+}
@@ -0,0 +1,434 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestSecurityGroupRulesDiff(t *testing.T) {
+	sg := &ec2.SecurityGroup{
+		GroupId: aws.String("sg-11111111"),
+		OwnerId: aws.String("123456789012"),
+		VpcId:   aws.String("vpc-11111111"),
+	}
+
+	// One CIDR of a two CIDR block changes, and another block changes
+	// description only.
+	want, err := expandSecurityGroupRules([]interface{}{
+		map[string]interface{}{
+			"protocol":         "6",
+			"from_port":        443,
+			"to_port":          443,
+			"description":      "",
+			"cidr_blocks":      []interface{}{"10.0.0.0/16", "10.2.0.0/16"},
+			"ipv6_cidr_blocks": []interface{}{},
+			"prefix_list_ids":  []interface{}{},
+			"security_groups":  schema.NewSet(schema.HashString, nil),
+			"self":             false,
+		},
+		map[string]interface{}{
+			"protocol":         "-1",
+			"from_port":        0,
+			"to_port":          65535,
+			"description":      "peers",
+			"cidr_blocks":      []interface{}{},
+			"ipv6_cidr_blocks": []interface{}{},
+			"prefix_list_ids":  []interface{}{},
+			"security_groups":  schema.NewSet(schema.HashString, []interface{}{"sg-22222222"}),
+			"self":             true,
+		},
+	}, sg)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	have := flattenSecurityGroupRules([]*ec2.IpPermission{
+		{
+			IpProtocol: aws.String("tcp"),
+			FromPort:   aws.Int64(443),
+			ToPort:     aws.Int64(443),
+			IpRanges: []*ec2.IpRange{
+				{CidrIp: aws.String("10.0.0.0/16")},
+				{CidrIp: aws.String("10.1.0.0/16")},
+			},
+		},
+		{
+			IpProtocol: aws.String("-1"),
+			UserIdGroupPairs: []*ec2.UserIdGroupPair{
+				{GroupId: aws.String("sg-11111111"), UserId: aws.String("123456789012"), Description: aws.String("peers")},
+				{GroupId: aws.String("sg-22222222"), UserId: aws.String("123456789012")},
+			},
+		},
+	}, sg)
+
+	authorize, revoke, describe := securityGroupRulesDiff(want, have)
+
+	expected := map[string][]string{
+		"authorize": {"tcp 443-443 10.2.0.0/16"},
+		"revoke":    {"tcp 443-443 10.1.0.0/16"},
+		"describe":  {"-1 0-0 sg-22222222"},
+	}
+	actual := map[string][]string{
+		"authorize": securityGroupRulesStrings(authorize),
+		"revoke":    securityGroupRulesStrings(revoke),
+		"describe":  securityGroupRulesStrings(describe),
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected %#v, got %#v", expected, actual)
+	}
+
+	for _, r := range have {
+		if r.source == "sg-11111111" && !r.self {
+			t.Fatalf("Expected rule granting %s to be self referencing", r.source)
+		}
+	}
+}
+
+func TestSecurityGroupRulesConflicting(t *testing.T) {
+	have := []*securityGroupRule{
+		{protocol: "-1", kind: securityGroupRuleKindCidr, source: "0.0.0.0/0"},
+		{protocol: "tcp", fromPort: 22, toPort: 22, kind: securityGroupRuleKindCidr, source: "0.0.0.0/0"},
+	}
+
+	if conflicts := securityGroupRulesConflicting("egress", nil, have, nil); !reflect.DeepEqual(conflicts, []string{"tcp 22-22 0.0.0.0/0"}) {
+		t.Fatalf("Expected only the ssh rule to conflict, got %#v", conflicts)
+	}
+
+	if conflicts := securityGroupRulesConflicting("ingress", nil, have, nil); len(conflicts) != 2 {
+		t.Fatalf("Expected both ingress rules to conflict, got %#v", conflicts)
+	}
+
+	if conflicts := securityGroupRulesConflicting("ingress", have, have, nil); len(conflicts) != 0 {
+		t.Fatalf("Expected no conflicts for declared rules, got %#v", conflicts)
+	}
+
+	// Rules the resource managed before an update are revoked without conflict
+	if conflicts := securityGroupRulesConflicting("ingress", have[:1], have, have[1:]); len(conflicts) != 0 {
+		t.Fatalf("Expected no conflicts for previously declared rules, got %#v", conflicts)
+	}
+}
+
+func TestSecurityGroupRulesInPlace(t *testing.T) {
+	sg := &ec2.SecurityGroup{
+		GroupId: aws.String("sg-12345678"),
+		VpcId:   aws.String("vpc-12345678"),
+		IpPermissionsEgress: []*ec2.IpPermission{
+			{
+				IpProtocol: aws.String("-1"),
+				FromPort:   aws.Int64(0),
+				ToPort:     aws.Int64(0),
+				IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+			},
+			{
+				IpProtocol: aws.String("tcp"),
+				FromPort:   aws.Int64(443),
+				ToPort:     aws.Int64(443),
+				IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("10.0.0.0/16")}},
+			},
+		},
+	}
+
+	// Without egress blocks the allow all egress rule is left alone
+	if actual := securityGroupRulesStrings(securityGroupRulesInPlace(sg, "egress", false)); !reflect.DeepEqual(actual, []string{"tcp 443-443 10.0.0.0/16"}) {
+		t.Fatalf("Expected only the https rule, got %#v", actual)
+	}
+
+	if actual := securityGroupRulesInPlace(sg, "egress", true); len(actual) != 2 {
+		t.Fatalf("Expected both egress rules, got %#v", securityGroupRulesStrings(actual))
+	}
+}
+
+func securityGroupRulesStrings(rules []*securityGroupRule) []string {
+	s := make([]string, len(rules))
+	for i, r := range rules {
+		s[i] = r.String()
+	}
+	sort.Strings(s)
+	return s
+}
+
+func TestAccAWSSecurityGroupRules_basic(t *testing.T) {
+	var group ec2.SecurityGroup
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupRulesConfig(rInt, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.test", &group),
+					resource.TestCheckResourceAttr("aws_security_group_rules.test", "ingress.#", "2"),
+					resource.TestCheckResourceAttr("aws_security_group_rules.test", "egress.#", "1"),
+					testAccCheckAWSSecurityGroupRulesCount(&group, 4, 1),
+				),
+			},
+			{
+				Config: testAccAWSSecurityGroupRulesConfig(rInt, "10.2.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.test", &group),
+					resource.TestCheckResourceAttr("aws_security_group_rules.test", "ingress.#", "2"),
+					testAccCheckAWSSecurityGroupRulesCount(&group, 4, 1),
+					testAccCheckAWSSecurityGroupRulesSource(&group, "10.2.0.0/16", "app"),
+				),
+			},
+			{
+				ResourceName:      "aws_security_group_rules.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSecurityGroupRules_defaultEgress(t *testing.T) {
+	var group ec2.SecurityGroup
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupRulesConfigIngressOnly(rInt, "10.0.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.test", &group),
+					resource.TestCheckResourceAttr("aws_security_group_rules.test", "egress.#", "0"),
+					testAccCheckAWSSecurityGroupRulesCount(&group, 1, 1),
+				),
+			},
+			{
+				Config: testAccAWSSecurityGroupRulesConfigIngressOnly(rInt, "10.1.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.test", &group),
+					testAccCheckAWSSecurityGroupRulesCount(&group, 1, 1),
+				),
+			},
+			{
+				ResourceName:      "aws_security_group_rules.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAWSSecurityGroupRules_conflictingRulesOnUpdate(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupRulesConfigIngressOnly(rInt, "10.0.0.0/16"),
+			},
+			{
+				Config:      testAccAWSSecurityGroupRulesConfigInlineAdded(rInt),
+				ExpectError: regexp.MustCompile(`has ingress rules which are not declared in this resource`),
+			},
+		},
+	})
+}
+
+func TestAccAWSSecurityGroupRules_conflictingInlineRules(t *testing.T) {
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSecurityGroupRulesConfigInline(rInt),
+				ExpectError: regexp.MustCompile(`has ingress rules which are not declared in this resource`),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSSecurityGroupRulesCount(group *ec2.SecurityGroup, ingress, egress int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if n := len(flattenSecurityGroupRules(group.IpPermissions, group)); n != ingress {
+			return fmt.Errorf("Expected %d ingress rules, got %d", ingress, n)
+		}
+		if n := len(flattenSecurityGroupRules(group.IpPermissionsEgress, group)); n != egress {
+			return fmt.Errorf("Expected %d egress rules, got %d", egress, n)
+		}
+		return nil
+	}
+}
+
+func testAccCheckAWSSecurityGroupRulesSource(group *ec2.SecurityGroup, source, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, r := range flattenSecurityGroupRules(group.IpPermissions, group) {
+			if r.source == source {
+				if r.description != description {
+					return fmt.Errorf("Expected description %q for %s, got %q", description, source, r.description)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("No ingress rule found for %s", source)
+	}
+}
+
+func testAccAWSSecurityGroupRulesConfig(rInt int, appCidr string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-security-group-rules"
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = "terraform_acceptance_test_%d"
+  vpc_id = "${aws_vpc.test.id}"
+}
+
+resource "aws_security_group_rules" "test" {
+  security_group_id = "${aws_security_group.test.id}"
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 443
+    to_port     = 443
+    cidr_blocks = ["10.0.0.0/16", "10.3.0.0/16"]
+  }
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 443
+    to_port     = 443
+    cidr_blocks = ["%s"]
+    self        = true
+    description = "app"
+  }
+
+  egress {
+    protocol    = "tcp"
+    from_port   = 443
+    to_port     = 443
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+}
+`, rInt, appCidr)
+}
+
+func testAccAWSSecurityGroupRulesConfigIngressOnly(rInt int, cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-security-group-rules-ingress-only"
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = "terraform_acceptance_test_%d"
+  vpc_id = "${aws_vpc.test.id}"
+}
+
+resource "aws_security_group_rules" "test" {
+  security_group_id = "${aws_security_group.test.id}"
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 443
+    to_port     = 443
+    cidr_blocks = ["%s"]
+  }
+}
+`, rInt, cidr)
+}
+
+func testAccAWSSecurityGroupRulesConfigInlineAdded(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-security-group-rules-ingress-only"
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = "terraform_acceptance_test_%d"
+  vpc_id = "${aws_vpc.test.id}"
+}
+
+resource "aws_security_group_rule" "ssh" {
+  type              = "ingress"
+  security_group_id = "${aws_security_group.test.id}"
+  protocol          = "tcp"
+  from_port         = 22
+  to_port           = 22
+  cidr_blocks       = ["10.0.0.0/16"]
+}
+
+resource "aws_security_group_rules" "test" {
+  security_group_id = "${aws_security_group.test.id}"
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 443
+    to_port     = 443
+    cidr_blocks = ["10.1.0.0/16"]
+  }
+
+  depends_on = ["aws_security_group_rule.ssh"]
+}
+`, rInt)
+}
+
+func testAccAWSSecurityGroupRulesConfigInline(rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-security-group-rules-inline"
+  }
+}
+
+resource "aws_security_group" "test" {
+  name   = "terraform_acceptance_test_%d"
+  vpc_id = "${aws_vpc.test.id}"
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 22
+    to_port     = 22
+    cidr_blocks = ["10.0.0.0/16"]
+  }
+}
+
+resource "aws_security_group_rules" "test" {
+  security_group_id = "${aws_security_group.test.id}"
+
+  ingress {
+    protocol    = "tcp"
+    from_port   = 443
+    to_port     = 443
+    cidr_blocks = ["10.0.0.0/16"]
+  }
+}
+`, rInt)
+}
@@ -1420,6 +1420,36 @@ func TestAccAWSSecurityGroup_egressWithPrefixList(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecurityGroup_ingressWithManagedPrefixList(t *testing.T) {
+	var group ec2.SecurityGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecurityGroupConfigManagedPrefixListIngress("10.3.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.ingress", &group),
+					resource.TestCheckResourceAttr("aws_security_group.ingress", "ingress.#", "1"),
+					resource.TestCheckResourceAttr("aws_security_group_rule.ingress", "prefix_list_ids.#", "1"),
+				),
+			},
+			{
+				// Changing the entries of the list leaves the rules
+				// referencing it in place.
+				Config: testAccAWSSecurityGroupConfigManagedPrefixListIngress("10.4.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSecurityGroupExists("aws_security_group.ingress", &group),
+					resource.TestCheckResourceAttr("aws_security_group.ingress", "ingress.#", "1"),
+					resource.TestCheckResourceAttr("aws_security_group_rule.ingress", "prefix_list_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSSecurityGroup_ipv4andipv6Egress(t *testing.T) {
 	var group ec2.SecurityGroup
 
@@ -2752,3 +2782,53 @@ resource "aws_security_group" "egress" {
     }
 }
 `
+
+func testAccAWSSecurityGroupConfigManagedPrefixListIngress(cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "tf_sg_managed_prefix_list_ingress_test"
+  }
+}
+
+resource "aws_ec2_managed_prefix_list" "test" {
+  name           = "tf_sg_managed_prefix_list_ingress_test"
+  address_family = "IPv4"
+  max_entries    = 1
+
+  entry {
+    cidr = %q
+  }
+}
+
+resource "aws_security_group" "ingress" {
+  name        = "terraform_acceptance_test_managed_prefix_list_ingress"
+  description = "Used in the terraform acceptance tests"
+  vpc_id      = "${aws_vpc.test.id}"
+
+  ingress {
+    protocol        = "tcp"
+    from_port       = 443
+    to_port         = 443
+    prefix_list_ids = ["${aws_ec2_managed_prefix_list.test.id}"]
+  }
+}
+
+resource "aws_security_group" "rule" {
+  name        = "terraform_acceptance_test_managed_prefix_list_rule"
+  description = "Used in the terraform acceptance tests"
+  vpc_id      = "${aws_vpc.test.id}"
+}
+
+resource "aws_security_group_rule" "ingress" {
+  type              = "ingress"
+  protocol          = "tcp"
+  from_port         = 443
+  to_port           = 443
+  prefix_list_ids   = ["${aws_ec2_managed_prefix_list.test.id}"]
+  security_group_id = "${aws_security_group.rule.id}"
+}
+`, cidr)
+}
@@ -13,10 +13,11 @@ import (
 
 func resourceAwsServiceCatalogPortfolio() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsServiceCatalogPortfolioCreate,
-		Read:   resourceAwsServiceCatalogPortfolioRead,
-		Update: resourceAwsServiceCatalogPortfolioUpdate,
-		Delete: resourceAwsServiceCatalogPortfolioDelete,
+		Create:        resourceAwsServiceCatalogPortfolioCreate,
+		Read:          resourceAwsServiceCatalogPortfolioRead,
+		Update:        resourceAwsServiceCatalogPortfolioUpdate,
+		Delete:        resourceAwsServiceCatalogPortfolioDelete,
+		CustomizeDiff: setTagsAllDiff,
 
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -53,7 +54,8 @@ func resourceAwsServiceCatalogPortfolio() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validateServiceCatalogPortfolioProviderName,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -75,7 +77,7 @@ func resourceAwsServiceCatalogPortfolioCreate(d *schema.ResourceData, meta inter
 		input.ProviderName = aws.String(v.(string))
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		tags := []*servicecatalog.Tag{}
 		t := v.(map[string]interface{})
 		for k, v := range t {
@@ -127,7 +129,7 @@ func resourceAwsServiceCatalogPortfolioRead(d *schema.ResourceData, meta interfa
 	for _, tag := range resp.Tags {
 		tags[*tag.Key] = *tag.Value
 	}
-	d.Set("tags", tags)
+	setTagsAll(d, meta, tags)
 	return nil
 }
 
@@ -158,8 +160,8 @@ func resourceAwsServiceCatalogPortfolioUpdate(d *schema.ResourceData, meta inter
 		input.ProviderName = aws.String(v.(string))
 	}
 
-	if d.HasChange("tags") {
-		currentTags, requiredTags := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		currentTags, requiredTags := d.GetChange("tags_all")
 		log.Printf("[DEBUG] Current Tags: %#v", currentTags)
 		log.Printf("[DEBUG] Required Tags: %#v", requiredTags)
 
@@ -16,6 +16,10 @@ func resourceAwsSesActiveReceiptRuleSet() *schema.Resource {
 		Read:   resourceAwsSesActiveReceiptRuleSetRead,
 		Delete: resourceAwsSesActiveReceiptRuleSetDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"rule_set_name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -23,6 +23,12 @@ func TestAccAWSSESActiveReceiptRuleSet_basic(t *testing.T) {
 					testAccCheckAwsSESActiveReceiptRuleSetExists("aws_ses_active_receipt_rule_set.test"),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:      "aws_ses_active_receipt_rule_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -20,6 +21,10 @@ func resourceAwsSesReceiptRule() *schema.Resource {
 		Read:   resourceAwsSesReceiptRuleRead,
 		Delete: resourceAwsSesReceiptRuleDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSesReceiptRuleImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -354,6 +359,18 @@ func resourceAwsSesReceiptRule() *schema.Resource {
 	}
 }
 
+func resourceAwsSesReceiptRuleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), ":", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected RULE-SET-NAME:RULE-NAME", d.Id())
+	}
+
+	d.Set("rule_set_name", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsSesReceiptRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).sesConn
 
@@ -425,6 +442,7 @@ func resourceAwsSesReceiptRuleRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	d.Set("name", response.Rule.Name)
 	d.Set("enabled", *response.Rule.Enabled)
 	d.Set("recipients", flattenStringList(response.Rule.Recipients))
 	d.Set("scan_enabled", *response.Rule.ScanEnabled)
@@ -27,6 +27,13 @@ func TestAccAWSSESReceiptRule_basic(t *testing.T) {
 					testAccCheckAwsSESReceiptRuleExists("aws_ses_receipt_rule.basic"),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:      "aws_ses_receipt_rule.basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAwsSESReceiptRuleImportStateIdFunc("aws_ses_receipt_rule.basic"),
+			},
 		},
 	})
 }
@@ -292,3 +299,14 @@ resource "aws_ses_receipt_rule" "actions" {
     }
 }
 `, srrsRandomInt)
+
+func testAccAwsSESReceiptRuleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["rule_set_name"], rs.Primary.ID), nil
+	}
+}
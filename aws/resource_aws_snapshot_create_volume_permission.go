@@ -2,6 +2,7 @@ package aws
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -17,6 +18,10 @@ func resourceAwsSnapshotCreateVolumePermission() *schema.Resource {
 		Read:   resourceAwsSnapshotCreateVolumePermissionRead,
 		Delete: resourceAwsSnapshotCreateVolumePermissionDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSnapshotCreateVolumePermissionImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"snapshot_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -32,6 +37,20 @@ func resourceAwsSnapshotCreateVolumePermission() *schema.Resource {
 	}
 }
 
+func resourceAwsSnapshotCreateVolumePermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected SNAPSHOT-ID/ACCOUNT-ID", d.Id())
+	}
+
+	snapshotId, accountId := idParts[0], idParts[1]
+	d.Set("snapshot_id", snapshotId)
+	d.Set("account_id", accountId)
+	d.SetId(fmt.Sprintf("%s-%s", snapshotId, accountId))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsSnapshotCreateVolumePermissionExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -23,6 +23,12 @@ func TestAccAWSSnapshotCreateVolumePermission_Basic(t *testing.T) {
 					testAccAWSSnapshotCreateVolumePermissionExists(&accountId, &snapshotId),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_snapshot_create_volume_permission.self-test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSSnapshotCreateVolumePermissionImportStateIdFunc("aws_snapshot_create_volume_permission.self-test"),
+			},
 			// Drop just create volume permission to test destruction
 			resource.TestStep{
 				Config: testAccAWSSnapshotCreateVolumePermissionConfig(false),
@@ -87,3 +93,14 @@ resource "aws_snapshot_create_volume_permission" "self-test" {
 }
 `)
 }
+
+func testAccAWSSnapshotCreateVolumePermissionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["snapshot_id"], rs.Primary.Attributes["account_id"]), nil
+	}
+}
@@ -115,7 +115,7 @@ func resourceAwsSnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
 					// Retry the update in the event of an eventually consistent style of
 					// error, where say an IAM resource is successfully created but not
 					// actually available. See https://github.com/hashicorp/terraform/issues/3660
-					_, err := retryOnAwsCode("InvalidParameter", func() (interface{}, error) {
+					_, err := retryOnAwsCode(meta.(*AWSClient).retryTimeout, "InvalidParameter", func() (interface{}, error) {
 						return conn.SetTopicAttributes(&req)
 					})
 					return err
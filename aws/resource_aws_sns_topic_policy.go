@@ -19,6 +19,10 @@ func resourceAwsSnsTopicPolicy() *schema.Resource {
 		Update: resourceAwsSnsTopicPolicyUpsert,
 		Delete: resourceAwsSnsTopicPolicyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -49,7 +53,7 @@ func resourceAwsSnsTopicPolicyUpsert(d *schema.ResourceData, meta interface{}) e
 	// error, where say an IAM resource is successfully created but not
 	// actually available. See https://github.com/hashicorp/terraform/issues/3660
 	conn := meta.(*AWSClient).snsconn
-	_, err := retryOnAwsCode("InvalidParameter", func() (interface{}, error) {
+	_, err := retryOnAwsCode(meta.(*AWSClient).retryTimeout, "InvalidParameter", func() (interface{}, error) {
 		return conn.SetTopicAttributes(&req)
 	})
 	if err != nil {
@@ -89,6 +93,7 @@ func resourceAwsSnsTopicPolicyRead(d *schema.ResourceData, meta interface{}) err
 		return nil
 	}
 
+	d.Set("arn", d.Id())
 	d.Set("policy", policy)
 
 	return nil
@@ -114,7 +119,7 @@ func resourceAwsSnsTopicPolicyDelete(d *schema.ResourceData, meta interface{}) e
 	// actually available. See https://github.com/hashicorp/terraform/issues/3660
 	log.Printf("[DEBUG] Resetting SNS Topic Policy to default: %s", req)
 	conn := meta.(*AWSClient).snsconn
-	_, err = retryOnAwsCode("InvalidParameter", func() (interface{}, error) {
+	_, err = retryOnAwsCode(meta.(*AWSClient).retryTimeout, "InvalidParameter", func() (interface{}, error) {
 		return conn.SetTopicAttributes(&req)
 	})
 	return err
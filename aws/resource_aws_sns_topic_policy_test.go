@@ -21,6 +21,13 @@ func TestAccAWSSNSTopicPolicy_basic(t *testing.T) {
 						regexp.MustCompile("^{\"Version\":\"2012-10-17\".+")),
 				),
 			},
+
+			{
+				ResourceName:            "aws_sns_topic_policy.custom",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy"},
+			},
 		},
 	})
 }
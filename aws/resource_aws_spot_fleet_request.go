@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsSpotFleetRequest() *schema.Resource {
@@ -20,13 +21,19 @@ func resourceAwsSpotFleetRequest() *schema.Resource {
 		Create: resourceAwsSpotFleetRequestCreate,
 		Read:   resourceAwsSpotFleetRequestRead,
 		Delete: resourceAwsSpotFleetRequestDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Update: resourceAwsSpotFleetRequestUpdate,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 		MigrateState:  resourceAwsSpotFleetRequestMigrateState,
 
 		Schema: map[string]*schema.Schema{
@@ -50,9 +57,10 @@ func resourceAwsSpotFleetRequest() *schema.Resource {
 			// http://docs.aws.amazon.com/sdk-for-go/api/service/ec2.html#type-SpotFleetLaunchSpecification
 			// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_SpotFleetLaunchSpecification.html
 			"launch_specification": {
-				Type:     schema.TypeSet,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"launch_template_config"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"vpc_security_group_ids": {
@@ -265,6 +273,83 @@ func resourceAwsSpotFleetRequest() *schema.Resource {
 				},
 				Set: hashLaunchSpecification,
 			},
+			"launch_template_config": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"launch_specification"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"launch_template_specification": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"overrides": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"availability_zone": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"instance_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"spot_price": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"subnet_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"weighted_capacity": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+										ForceNew: true,
+									},
+									"priority": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			// Everything on a spot fleet is ForceNew except target_capacity
 			"target_capacity": {
 				Type:     schema.TypeInt,
@@ -274,7 +359,48 @@ func resourceAwsSpotFleetRequest() *schema.Resource {
 			"allocation_strategy": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  "lowestPrice",
+				Default:  ec2.AllocationStrategyLowestPrice,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.AllocationStrategyLowestPrice,
+					ec2.AllocationStrategyDiversified,
+					ec2.AllocationStrategyCapacityOptimized,
+				}, false),
+			},
+			// Only used with the lowestPrice allocation strategy
+			"instance_pools_to_use_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			// On-Demand capacity is only launched from launch_template_config
+			"on_demand_target_capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ForceNew:     false,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"on_demand_allocation_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ec2.OnDemandAllocationStrategyLowestPrice,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.OnDemandAllocationStrategyLowestPrice,
+					ec2.OnDemandAllocationStrategyPrioritized,
+				}, false),
+			},
+			"on_demand_max_total_price": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"spot_max_total_price": {
+				Type:     schema.TypeString,
+				Optional: true,
 				ForceNew: true,
 			},
 			"excess_capacity_termination_policy": {
@@ -564,15 +690,9 @@ func resourceAwsSpotFleetRequestCreate(d *schema.ResourceData, meta interface{})
 	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_RequestSpotFleet.html
 	conn := meta.(*AWSClient).ec2conn
 
-	launch_specs, err := buildAwsSpotFleetLaunchSpecifications(d, meta)
-	if err != nil {
-		return err
-	}
-
 	// http://docs.aws.amazon.com/sdk-for-go/api/service/ec2.html#type-SpotFleetRequestConfigData
 	spotFleetConfig := &ec2.SpotFleetRequestConfigData{
 		IamFleetRole:                     aws.String(d.Get("iam_fleet_role").(string)),
-		LaunchSpecifications:             launch_specs,
 		SpotPrice:                        aws.String(d.Get("spot_price").(string)),
 		TargetCapacity:                   aws.Int64(int64(d.Get("target_capacity").(int))),
 		ClientToken:                      aws.String(resource.UniqueId()),
@@ -581,6 +701,20 @@ func resourceAwsSpotFleetRequestCreate(d *schema.ResourceData, meta interface{})
 		InstanceInterruptionBehavior:     aws.String(d.Get("instance_interruption_behaviour").(string)),
 	}
 
+	if v, ok := d.GetOk("launch_template_config"); ok {
+		spotFleetConfig.LaunchTemplateConfigs = expandSpotFleetLaunchTemplateConfigs(v.([]interface{}), func(i int) bool {
+			return d.HasChange(fmt.Sprintf("launch_template_config.%d.launch_template_specification.0.name", i))
+		})
+	} else if d.Get("launch_specification").(*schema.Set).Len() > 0 {
+		launch_specs, err := buildAwsSpotFleetLaunchSpecifications(d, meta)
+		if err != nil {
+			return err
+		}
+		spotFleetConfig.LaunchSpecifications = launch_specs
+	} else {
+		return fmt.Errorf("One of launch_specification or launch_template_config must be set for a Spot Fleet Request")
+	}
+
 	if v, ok := d.GetOk("excess_capacity_termination_policy"); ok {
 		spotFleetConfig.ExcessCapacityTerminationPolicy = aws.String(v.(string))
 	}
@@ -588,7 +722,27 @@ func resourceAwsSpotFleetRequestCreate(d *schema.ResourceData, meta interface{})
 	if v, ok := d.GetOk("allocation_strategy"); ok {
 		spotFleetConfig.AllocationStrategy = aws.String(v.(string))
 	} else {
-		spotFleetConfig.AllocationStrategy = aws.String("lowestPrice")
+		spotFleetConfig.AllocationStrategy = aws.String(ec2.AllocationStrategyLowestPrice)
+	}
+
+	if aws.StringValue(spotFleetConfig.AllocationStrategy) == ec2.AllocationStrategyLowestPrice {
+		spotFleetConfig.InstancePoolsToUseCount = aws.Int64(int64(d.Get("instance_pools_to_use_count").(int)))
+	}
+
+	if v, ok := d.GetOk("on_demand_target_capacity"); ok {
+		if spotFleetConfig.LaunchTemplateConfigs == nil {
+			return fmt.Errorf("on_demand_target_capacity requires launch_template_config to be set for a Spot Fleet Request")
+		}
+		spotFleetConfig.OnDemandTargetCapacity = aws.Int64(int64(v.(int)))
+		spotFleetConfig.OnDemandAllocationStrategy = aws.String(d.Get("on_demand_allocation_strategy").(string))
+	}
+
+	if v, ok := d.GetOk("on_demand_max_total_price"); ok {
+		spotFleetConfig.OnDemandMaxTotalPrice = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("spot_max_total_price"); ok {
+		spotFleetConfig.SpotMaxTotalPrice = aws.String(v.(string))
 	}
 
 	if v, ok := d.GetOk("valid_from"); ok {
@@ -621,7 +775,7 @@ func resourceAwsSpotFleetRequestCreate(d *schema.ResourceData, meta interface{})
 	// Since IAM is eventually consistent, we retry creation as a newly created role may not
 	// take effect immediately, resulting in an InvalidSpotFleetRequestConfig error
 	var resp *ec2.RequestSpotFleetOutput
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
 		var err error
 		resp, err = conn.RequestSpotFleet(spotFleetOpts)
 
@@ -662,17 +816,7 @@ func resourceAwsSpotFleetRequestCreate(d *schema.ResourceData, meta interface{})
 
 	if d.Get("wait_for_fulfillment").(bool) {
 		log.Println("[INFO] Waiting for Spot Fleet Request to be fulfilled")
-		spotStateConf := &resource.StateChangeConf{
-			Pending:    []string{"pending_fulfillment"},
-			Target:     []string{"fulfilled"},
-			Refresh:    resourceAwsSpotFleetRequestFulfillmentRefreshFunc(d.Id(), meta.(*AWSClient).ec2conn),
-			Timeout:    d.Timeout(schema.TimeoutCreate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
-		}
-
-		_, err = spotStateConf.WaitForState()
-
+		err = waitForFleetFulfillment(resourceAwsSpotFleetRequestFulfillmentRefreshFunc(d.Id(), conn), d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return err
 		}
@@ -681,6 +825,23 @@ func resourceAwsSpotFleetRequestCreate(d *schema.ResourceData, meta interface{})
 	return resourceAwsSpotFleetRequestRead(d, meta)
 }
 
+// waitForFleetFulfillment waits until a Spot Fleet request or EC2 Fleet,
+// whose activity status is returned by refresh, has launched its target
+// capacity.
+func waitForFleetFulfillment(refresh resource.StateRefreshFunc, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending_fulfillment"},
+		Target:     []string{"fulfilled"},
+		Refresh:    refresh,
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
 func resourceAwsSpotFleetRequestStateRefreshFunc(d *schema.ResourceData, meta interface{}) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		conn := meta.(*AWSClient).ec2conn
@@ -730,7 +891,7 @@ func resourceAwsSpotFleetRequestFulfillmentRefreshFunc(id string, conn *ec2.EC2)
 
 		spotFleetRequest := resp.SpotFleetRequestConfigs[0]
 
-		return spotFleetRequest, *spotFleetRequest.ActivityStatus, nil
+		return spotFleetRequest, aws.StringValue(spotFleetRequest.ActivityStatus), nil
 	}
 }
 
@@ -778,6 +939,19 @@ func resourceAwsSpotFleetRequestRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("allocation_strategy", aws.StringValue(config.AllocationStrategy))
 	}
 
+	if config.InstancePoolsToUseCount != nil {
+		d.Set("instance_pools_to_use_count", aws.Int64Value(config.InstancePoolsToUseCount))
+	}
+
+	d.Set("on_demand_target_capacity", aws.Int64Value(config.OnDemandTargetCapacity))
+
+	if config.OnDemandAllocationStrategy != nil {
+		d.Set("on_demand_allocation_strategy", aws.StringValue(config.OnDemandAllocationStrategy))
+	}
+
+	d.Set("on_demand_max_total_price", config.OnDemandMaxTotalPrice)
+	d.Set("spot_max_total_price", config.SpotMaxTotalPrice)
+
 	if config.ClientToken != nil {
 		d.Set("client_token", aws.StringValue(config.ClientToken))
 	}
@@ -817,10 +991,98 @@ func resourceAwsSpotFleetRequestRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("replace_unhealthy_instances", config.ReplaceUnhealthyInstances)
 	d.Set("instance_interruption_behaviour", config.InstanceInterruptionBehavior)
 	d.Set("launch_specification", launchSpecsToSet(config.LaunchSpecifications, conn))
+	if err := d.Set("launch_template_config", flattenSpotFleetLaunchTemplateConfigs(config.LaunchTemplateConfigs)); err != nil {
+		return fmt.Errorf("Error setting launch_template_config: %s", err)
+	}
 
 	return nil
 }
 
+// expandSpotFleetLaunchTemplateConfigs returns the launch template configs of
+// the launch_template_config blocks. Only one of the id and name of a launch
+// template may be sent: the name when byName reports it was changed for the
+// block at that index, and otherwise the id.
+func expandSpotFleetLaunchTemplateConfigs(l []interface{}, byName func(int) bool) []*ec2.LaunchTemplateConfig {
+	var configs []*ec2.LaunchTemplateConfig
+	for i, raw := range l {
+		m := raw.(map[string]interface{})
+
+		spec := &ec2.FleetLaunchTemplateSpecification{}
+		if specs := m["launch_template_specification"].([]interface{}); len(specs) > 0 && specs[0] != nil {
+			sm := specs[0].(map[string]interface{})
+			if v := sm["name"].(string); byName(i) && v != "" {
+				spec.LaunchTemplateName = aws.String(v)
+			} else if v := sm["id"].(string); v != "" {
+				spec.LaunchTemplateId = aws.String(v)
+			} else if v := sm["name"].(string); v != "" {
+				spec.LaunchTemplateName = aws.String(v)
+			}
+			if v := sm["version"].(string); v != "" {
+				spec.Version = aws.String(v)
+			}
+		}
+
+		config := &ec2.LaunchTemplateConfig{
+			LaunchTemplateSpecification: spec,
+		}
+		for _, raw := range m["overrides"].([]interface{}) {
+			om := raw.(map[string]interface{})
+			override := &ec2.LaunchTemplateOverrides{}
+			if v := om["availability_zone"].(string); v != "" {
+				override.AvailabilityZone = aws.String(v)
+			}
+			if v := om["instance_type"].(string); v != "" {
+				override.InstanceType = aws.String(v)
+			}
+			if v := om["spot_price"].(string); v != "" {
+				override.SpotPrice = aws.String(v)
+			}
+			if v := om["subnet_id"].(string); v != "" {
+				override.SubnetId = aws.String(v)
+			}
+			if v := om["weighted_capacity"].(float64); v > 0 {
+				override.WeightedCapacity = aws.Float64(v)
+			}
+			if v := om["priority"].(float64); v > 0 {
+				override.Priority = aws.Float64(v)
+			}
+			config.Overrides = append(config.Overrides, override)
+		}
+		configs = append(configs, config)
+	}
+	return configs
+}
+
+func flattenSpotFleetLaunchTemplateConfigs(configs []*ec2.LaunchTemplateConfig) []interface{} {
+	var result []interface{}
+	for _, config := range configs {
+		m := map[string]interface{}{}
+		if spec := config.LaunchTemplateSpecification; spec != nil {
+			m["launch_template_specification"] = []interface{}{
+				map[string]interface{}{
+					"id":      aws.StringValue(spec.LaunchTemplateId),
+					"name":    aws.StringValue(spec.LaunchTemplateName),
+					"version": aws.StringValue(spec.Version),
+				},
+			}
+		}
+		var overrides []interface{}
+		for _, override := range config.Overrides {
+			overrides = append(overrides, map[string]interface{}{
+				"availability_zone": aws.StringValue(override.AvailabilityZone),
+				"instance_type":     aws.StringValue(override.InstanceType),
+				"spot_price":        aws.StringValue(override.SpotPrice),
+				"subnet_id":         aws.StringValue(override.SubnetId),
+				"weighted_capacity": aws.Float64Value(override.WeightedCapacity),
+				"priority":          aws.Float64Value(override.Priority),
+			})
+		}
+		m["overrides"] = overrides
+		result = append(result, m)
+	}
+	return result
+}
+
 func launchSpecsToSet(launchSpecs []*ec2.SpotFleetLaunchSpecification, conn *ec2.EC2) *schema.Set {
 	specSet := &schema.Set{F: hashLaunchSpecification}
 	for _, spec := range launchSpecs {
@@ -1027,6 +1289,10 @@ func resourceAwsSpotFleetRequestUpdate(d *schema.ResourceData, meta interface{})
 		req.TargetCapacity = aws.Int64(int64(val.(int)))
 	}
 
+	if d.HasChange("on_demand_target_capacity") {
+		req.OnDemandTargetCapacity = aws.Int64(int64(d.Get("on_demand_target_capacity").(int)))
+	}
+
 	if val, ok := d.GetOk("excess_capacity_termination_policy"); ok {
 		req.ExcessCapacityTerminationPolicy = aws.String(val.(string))
 	}
@@ -1036,6 +1302,28 @@ func resourceAwsSpotFleetRequestUpdate(d *schema.ResourceData, meta interface{})
 		// TODO: rollback to old values?
 	}
 
+	capacityChanged := d.HasChange("target_capacity") || d.HasChange("on_demand_target_capacity")
+	if err == nil && capacityChanged && d.Get("wait_for_fulfillment").(bool) {
+		log.Println("[INFO] Waiting for Spot Fleet Request to be modified")
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"modifying"},
+			Target:     []string{"active"},
+			Refresh:    resourceAwsSpotFleetRequestStateRefreshFunc(d, meta),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			MinTimeout: 10 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return err
+		}
+
+		log.Println("[INFO] Waiting for Spot Fleet Request to be fulfilled")
+		err = waitForFleetFulfillment(resourceAwsSpotFleetRequestFulfillmentRefreshFunc(d.Id(), conn), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
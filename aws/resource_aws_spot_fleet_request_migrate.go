@@ -12,7 +12,14 @@ func resourceAwsSpotFleetRequestMigrateState(
 	switch v {
 	case 0:
 		log.Println("[INFO] Found AWS Spot Fleet Request State v0; migrating to v1")
-		return migrateSpotFleetRequestV0toV1(is)
+		is, err := migrateSpotFleetRequestV0toV1(is)
+		if err != nil {
+			return is, err
+		}
+		return migrateSpotFleetRequestV1toV2(is)
+	case 1:
+		log.Println("[INFO] Found AWS Spot Fleet Request State v1; migrating to v2")
+		return migrateSpotFleetRequestV1toV2(is)
 	default:
 		return is, fmt.Errorf("Unexpected schema version: %d", v)
 	}
@@ -31,3 +38,28 @@ func migrateSpotFleetRequestV0toV1(is *terraform.InstanceState) (*terraform.Inst
 	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
 	return is, nil
 }
+
+func migrateSpotFleetRequestV1toV2(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		log.Println("[DEBUG] Empty Spot Fleet Request State; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] Attributes before migration: %#v", is.Attributes)
+
+	// Default the attributes added in v2 to the values AWS assumed for
+	// requests made before they existed, so they don't force a new request.
+	defaults := map[string]string{
+		"instance_pools_to_use_count":   "1",
+		"on_demand_target_capacity":     "0",
+		"on_demand_allocation_strategy": "lowestPrice",
+	}
+	for k, v := range defaults {
+		if _, ok := is.Attributes[k]; !ok {
+			is.Attributes[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
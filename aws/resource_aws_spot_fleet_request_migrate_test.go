@@ -11,7 +11,7 @@ func TestAWSSpotFleetRequestMigrateState(t *testing.T) {
 		StateVersion int
 		ID           string
 		Attributes   map[string]string
-		Expected     string
+		Expected     map[string]string
 		Meta         interface{}
 	}{
 		"v0_1": {
@@ -20,7 +20,37 @@ func TestAWSSpotFleetRequestMigrateState(t *testing.T) {
 			Attributes: map[string]string{
 				"associate_public_ip_address": "true",
 			},
-			Expected: "false",
+			Expected: map[string]string{
+				"associate_public_ip_address":   "false",
+				"instance_pools_to_use_count":   "1",
+				"on_demand_target_capacity":     "0",
+				"on_demand_allocation_strategy": "lowestPrice",
+			},
+		},
+		"v1_1": {
+			StateVersion: 1,
+			ID:           "some_id",
+			Attributes: map[string]string{
+				"allocation_strategy": "lowestPrice",
+			},
+			Expected: map[string]string{
+				"allocation_strategy":           "lowestPrice",
+				"instance_pools_to_use_count":   "1",
+				"on_demand_target_capacity":     "0",
+				"on_demand_allocation_strategy": "lowestPrice",
+			},
+		},
+		"v1_2": {
+			StateVersion: 1,
+			ID:           "some_id",
+			Attributes: map[string]string{
+				"on_demand_target_capacity": "2",
+			},
+			Expected: map[string]string{
+				"instance_pools_to_use_count":   "1",
+				"on_demand_target_capacity":     "2",
+				"on_demand_allocation_strategy": "lowestPrice",
+			},
 		},
 	}
 
@@ -36,8 +66,10 @@ func TestAWSSpotFleetRequestMigrateState(t *testing.T) {
 			t.Fatalf("bad: %s, err: %#v", tn, err)
 		}
 
-		if is.Attributes["associate_public_ip_address"] != tc.Expected {
-			t.Fatalf("bad Spot Fleet Request Migrate: %s\n\n expected: %s", is.Attributes["associate_public_ip_address"], tc.Expected)
+		for k, v := range tc.Expected {
+			if is.Attributes[k] != v {
+				t.Fatalf("bad Spot Fleet Request Migrate: %s\n\n %s expected: %s, got: %s", tn, k, v, is.Attributes[k])
+			}
 		}
 	}
 }
@@ -37,6 +37,85 @@ func TestAccAWSSpotFleetRequest_associatePublicIpAddress(t *testing.T) {
 						"aws_spot_fleet_request.foo", "launch_specification.24370212.associate_public_ip_address", "true"),
 				),
 			},
+
+			{
+				ResourceName:            "aws_spot_fleet_request.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_fulfillment"},
+			},
+		},
+	})
+}
+
+func TestAccAWSSpotFleetRequest_launchTemplate(t *testing.T) {
+	var sfr ec2.SpotFleetRequestConfig
+	rName := acctest.RandString(10)
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSpotFleetRequestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSpotFleetRequestConfigLaunchTemplate(rName, rInt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSpotFleetRequestExists(
+						"aws_spot_fleet_request.foo", &sfr),
+					resource.TestCheckResourceAttr(
+						"aws_spot_fleet_request.foo", "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(
+						"aws_spot_fleet_request.foo", "launch_specification.#", "0"),
+					resource.TestCheckResourceAttr(
+						"aws_spot_fleet_request.foo", "launch_template_config.#", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_spot_fleet_request.foo", "launch_template_config.0.overrides.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSpotFleetRequest_launchTemplateOnDemandCapacity(t *testing.T) {
+	var before, after ec2.SpotFleetRequestConfig
+	rName := acctest.RandString(10)
+	rInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSpotFleetRequestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSpotFleetRequestConfigLaunchTemplateOnDemandCapacity(rName, rInt, 2, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSpotFleetRequestExists(
+						"aws_spot_fleet_request.foo", &before),
+					resource.TestCheckResourceAttr(
+						"aws_spot_fleet_request.foo", "spot_request_state", "active"),
+					resource.TestCheckResourceAttr(
+						"aws_spot_fleet_request.foo", "allocation_strategy", "capacityOptimized"),
+					resource.TestCheckResourceAttr(
+						"aws_spot_fleet_request.foo", "target_capacity", "2"),
+					resource.TestCheckResourceAttr(
+						"aws_spot_fleet_request.foo", "on_demand_target_capacity", "1"),
+					resource.TestCheckResourceAttr(
+						"aws_spot_fleet_request.foo", "on_demand_allocation_strategy", "prioritized"),
+				),
+			},
+			{
+				Config: testAccAWSSpotFleetRequestConfigLaunchTemplateOnDemandCapacity(rName, rInt, 3, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAWSSpotFleetRequestExists(
+						"aws_spot_fleet_request.foo", &after),
+					resource.TestCheckResourceAttr(
+						"aws_spot_fleet_request.foo", "target_capacity", "3"),
+					resource.TestCheckResourceAttr(
+						"aws_spot_fleet_request.foo", "on_demand_target_capacity", "2"),
+					testAccCheckAWSSpotFleetRequestConfigNotRecreated(t, &before, &after),
+				),
+			},
 		},
 	})
 }
@@ -441,6 +520,16 @@ func testAccCheckAWSSpotFleetRequestConfigRecreated(t *testing.T,
 	}
 }
 
+func testAccCheckAWSSpotFleetRequestConfigNotRecreated(t *testing.T,
+	before, after *ec2.SpotFleetRequestConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(before.SpotFleetRequestId) != aws.StringValue(after.SpotFleetRequestId) {
+			t.Fatalf("Expected Spot Fleet Request to be modified in place, but it was recreated as %s", aws.StringValue(after.SpotFleetRequestId))
+		}
+		return nil
+	}
+}
+
 func testAccCheckAWSSpotFleetRequestExists(
 	n string, sfr *ec2.SpotFleetRequestConfig) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -1582,3 +1671,175 @@ resource "aws_spot_fleet_request" "foo" {
 }
 `, rName, rInt, rInt, rName)
 }
+
+func testAccAWSSpotFleetRequestConfigLaunchTemplate(rName string, rInt int) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test-policy" {
+  name = "test-policy-%d"
+  path = "/"
+  description = "Spot Fleet Request ACCTest Policy"
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": [
+       "ec2:DescribeImages",
+       "ec2:DescribeSubnets",
+       "ec2:RequestSpotInstances",
+       "ec2:TerminateInstances",
+       "ec2:DescribeInstanceStatus",
+       "iam:PassRole"
+        ],
+    "Resource": ["*"]
+  }]
+}
+EOF
+}
+
+resource "aws_iam_policy_attachment" "test-attach" {
+    name = "test-attachment-%d"
+    roles = ["${aws_iam_role.test-role.name}"]
+    policy_arn = "${aws_iam_policy.test-policy.arn}"
+}
+
+resource "aws_iam_role" "test-role" {
+    name = "test-role-%s"
+    assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "spotfleet.amazonaws.com",
+          "ec2.amazonaws.com"
+        ]
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_launch_template" "foo" {
+    name = "test-lt-%s"
+    image_id = "ami-516b9131"
+    instance_type = "m1.small"
+}
+
+resource "aws_spot_fleet_request" "foo" {
+    iam_fleet_role = "${aws_iam_role.test-role.arn}"
+    spot_price = "0.005"
+    target_capacity = 2
+    terminate_instances_with_expiration = true
+    wait_for_fulfillment = true
+
+    launch_template_config {
+        launch_template_specification {
+            id = "${aws_launch_template.foo.id}"
+            version = "${aws_launch_template.foo.latest_version}"
+        }
+        overrides {
+            availability_zone = "us-west-2a"
+        }
+        overrides {
+            availability_zone = "us-west-2b"
+        }
+    }
+    depends_on = ["aws_iam_policy_attachment.test-attach"]
+}
+`, rInt, rInt, rName, rName)
+}
+
+func testAccAWSSpotFleetRequestConfigLaunchTemplateOnDemandCapacity(rName string, rInt, targetCapacity, onDemandTargetCapacity int) string {
+	return fmt.Sprintf(`
+resource "aws_iam_policy" "test-policy" {
+  name = "test-policy-%[2]d"
+  path = "/"
+  description = "Spot Fleet Request ACCTest Policy"
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": [
+       "ec2:DescribeImages",
+       "ec2:DescribeSubnets",
+       "ec2:RequestSpotInstances",
+       "ec2:RunInstances",
+       "ec2:TerminateInstances",
+       "ec2:DescribeInstanceStatus",
+       "iam:PassRole"
+        ],
+    "Resource": ["*"]
+  }]
+}
+EOF
+}
+
+resource "aws_iam_policy_attachment" "test-attach" {
+    name = "test-attachment-%[2]d"
+    roles = ["${aws_iam_role.test-role.name}"]
+    policy_arn = "${aws_iam_policy.test-policy.arn}"
+}
+
+resource "aws_iam_role" "test-role" {
+    name = "test-role-%[1]s"
+    assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "spotfleet.amazonaws.com",
+          "ec2.amazonaws.com"
+        ]
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_launch_template" "foo" {
+    name = "test-lt-%[1]s"
+    image_id = "ami-516b9131"
+    instance_type = "t2.micro"
+}
+
+resource "aws_spot_fleet_request" "foo" {
+    iam_fleet_role = "${aws_iam_role.test-role.arn}"
+    spot_price = "0.05"
+    allocation_strategy = "capacityOptimized"
+    target_capacity = %[3]d
+    on_demand_target_capacity = %[4]d
+    on_demand_allocation_strategy = "prioritized"
+    terminate_instances_with_expiration = true
+    wait_for_fulfillment = true
+
+    launch_template_config {
+        launch_template_specification {
+            id = "${aws_launch_template.foo.id}"
+            version = "${aws_launch_template.foo.latest_version}"
+        }
+        overrides {
+            availability_zone = "us-west-2a"
+            priority = 1
+        }
+        overrides {
+            availability_zone = "us-west-2b"
+            priority = 2
+        }
+    }
+    depends_on = ["aws_iam_policy_attachment.test-attach"]
+}
+`, rName, rInt, targetCapacity, onDemandTargetCapacity)
+}
@@ -14,10 +14,11 @@ import (
 
 func resourceAwsSpotInstanceRequest() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsSpotInstanceRequestCreate,
-		Read:   resourceAwsSpotInstanceRequestRead,
-		Delete: resourceAwsSpotInstanceRequestDelete,
-		Update: resourceAwsSpotInstanceRequestUpdate,
+		Create:        resourceAwsSpotInstanceRequestCreate,
+		Read:          resourceAwsSpotInstanceRequestRead,
+		Delete:        resourceAwsSpotInstanceRequestDelete,
+		Update:        resourceAwsSpotInstanceRequestUpdate,
+		CustomizeDiff: setTagsAllDiff,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -28,9 +29,21 @@ func resourceAwsSpotInstanceRequest() *schema.Resource {
 			// The Spot Instance Request Schema is based on the AWS Instance schema.
 			s := resourceAwsInstance().Schema
 
+			// Spot instances are not launched from a launch template, and
+			// their launch specification has no CPU, credit, hibernation or
+			// metadata options
+			for _, k := range []string{"launch_template", "cpu_core_count", "cpu_threads_per_core", "credit_specification", "hibernation", "metadata_options"} {
+				delete(s, k)
+			}
+			for _, k := range []string{"ami", "instance_type"} {
+				s[k].Required = true
+				s[k].Optional = false
+				s[k].Computed = false
+			}
+
 			// Everything on a spot instance is ForceNew except tags
 			for k, v := range s {
-				if k == "tags" {
+				if k == "tags" || k == "tags_all" {
 					continue
 				}
 				v.ForceNew = true
@@ -234,7 +247,7 @@ func resourceAwsSpotInstanceRequestRead(d *schema.ResourceData, meta interface{}
 	d.Set("spot_request_state", request.State)
 	d.Set("launch_group", request.LaunchGroup)
 	d.Set("block_duration_minutes", request.BlockDurationMinutes)
-	d.Set("tags", tagsToMap(request.Tags))
+	setTagsAll(d, meta, tagsToMap(request.Tags))
 	d.Set("instance_interruption_behaviour", request.InstanceInterruptionBehavior)
 
 	return nil
@@ -322,6 +335,7 @@ func resourceAwsSpotInstanceRequestUpdate(d *schema.ResourceData, meta interface
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
@@ -36,10 +36,11 @@ var sqsQueueAttributeMap = map[string]string{
 // default values specified below)
 func resourceAwsSqsQueue() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsSqsQueueCreate,
-		Read:   resourceAwsSqsQueueRead,
-		Update: resourceAwsSqsQueueUpdate,
-		Delete: resourceAwsSqsQueueDelete,
+		Create:        resourceAwsSqsQueueCreate,
+		Read:          resourceAwsSqsQueueRead,
+		Update:        resourceAwsSqsQueueUpdate,
+		Delete:        resourceAwsSqsQueueDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -122,7 +123,8 @@ func resourceAwsSqsQueue() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -304,7 +306,7 @@ func resourceAwsSqsQueueRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	d.Set("tags", tagsToMapGeneric(listTagsOutput.Tags))
+	setTagsAll(d, meta, tagsToMapGeneric(listTagsOutput.Tags))
 
 	return nil
 }
@@ -338,8 +340,8 @@ func extractNameFromSqsQueueUrl(queue string) (string, error) {
 }
 
 func setTagsSQS(conn *sqs.SQS, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
 
 		if len(remove) > 0 {
@@ -17,6 +17,10 @@ func resourceAwsSqsQueuePolicy() *schema.Resource {
 		Update: resourceAwsSqsQueuePolicyUpsert,
 		Delete: resourceAwsSqsQueuePolicyDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSqsQueuePolicyImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"queue_url": &schema.Schema{
 				Type:     schema.TypeString,
@@ -34,6 +38,13 @@ func resourceAwsSqsQueuePolicy() *schema.Resource {
 	}
 }
 
+func resourceAwsSqsQueuePolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("queue_url", d.Id())
+	d.SetId("sqs-policy-" + d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsSqsQueuePolicyUpsert(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).sqsconn
 	url := d.Get("queue_url").(string)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccAWSSQSQueuePolicy_basic(t *testing.T) {
@@ -24,6 +25,14 @@ func TestAccAWSSQSQueuePolicy_basic(t *testing.T) {
 						regexp.MustCompile("^{\"Version\":\"2012-10-17\".+")),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:            "aws_sqs_queue_policy.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy"},
+				ImportStateIdFunc:       testAccAWSSQSQueuePolicyImportStateIdFunc("aws_sqs_queue_policy.test"),
+			},
 		},
 	})
 }
@@ -61,3 +70,14 @@ resource "aws_sqs_queue_policy" "test" {
 POLICY
 }
 `
+
+func testAccAWSSQSQueuePolicyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["queue_url"], nil
+	}
+}
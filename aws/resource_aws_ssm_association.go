@@ -17,6 +17,10 @@ func resourceAwsSsmAssociation() *schema.Resource {
 		Update: resourceAwsSsmAssocationUpdate,
 		Delete: resourceAwsSsmAssociationDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		MigrateState:  resourceAwsSsmAssociationMigrateState,
 		SchemaVersion: 1,
 
@@ -25,6 +25,12 @@ func TestAccAWSSSMAssociation_basic(t *testing.T) {
 					testAccCheckAWSSSMAssociationExists("aws_ssm_association.foo"),
 				),
 			},
+
+			{
+				ResourceName:      "aws_ssm_association.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -26,6 +26,10 @@ func resourceAwsSsmDocument() *schema.Resource {
 		Update: resourceAwsSsmDocumentUpdate,
 		Delete: resourceAwsSsmDocumentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSsmDocumentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -130,6 +134,22 @@ func resourceAwsSsmDocument() *schema.Resource {
 	}
 }
 
+func resourceAwsSsmDocumentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ssmconn := meta.(*AWSClient).ssmconn
+
+	resp, err := ssmconn.GetDocument(&ssm.GetDocumentInput{
+		Name: aws.String(d.Id()),
+	})
+	if err != nil {
+		return nil, errwrap.Wrapf("[ERROR] Error reading SSM document: {{err}}", err)
+	}
+
+	d.Set("name", d.Id())
+	d.Set("content", resp.Content)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsSsmDocumentCreate(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
@@ -25,6 +25,12 @@ func TestAccAWSSSMDocument_basic(t *testing.T) {
 					testAccCheckAWSSSMDocumentExists("aws_ssm_document.foo"),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:      "aws_ssm_document.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -15,6 +16,10 @@ func resourceAwsSsmMaintenanceWindowTarget() *schema.Resource {
 		Read:   resourceAwsSsmMaintenanceWindowTargetRead,
 		Delete: resourceAwsSsmMaintenanceWindowTargetDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSsmMaintenanceWindowTargetImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"window_id": {
 				Type:     schema.TypeString,
@@ -57,6 +62,18 @@ func resourceAwsSsmMaintenanceWindowTarget() *schema.Resource {
 	}
 }
 
+func resourceAwsSsmMaintenanceWindowTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected WINDOW-ID/WINDOW-TARGET-ID", d.Id())
+	}
+
+	d.Set("window_id", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsSsmMaintenanceWindowTargetCreate(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
@@ -25,6 +25,13 @@ func TestAccAWSSSMMaintenanceWindowTarget_basic(t *testing.T) {
 					testAccCheckAWSSSMMaintenanceWindowTargetExists("aws_ssm_maintenance_window_target.target"),
 				),
 			},
+
+			{
+				ResourceName:      "aws_ssm_maintenance_window_target.target",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSSSMMaintenanceWindowTargetImportStateIdFunc("aws_ssm_maintenance_window_target.target"),
+			},
 		},
 	})
 }
@@ -120,3 +127,14 @@ resource "aws_ssm_maintenance_window_target" "target" {
 }
 `, rName)
 }
+
+func testAccAWSSSMMaintenanceWindowTargetImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["window_id"], rs.Primary.ID), nil
+	}
+}
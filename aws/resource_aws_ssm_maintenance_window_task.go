@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -15,6 +16,10 @@ func resourceAwsSsmMaintenanceWindowTask() *schema.Resource {
 		Read:   resourceAwsSsmMaintenanceWindowTaskRead,
 		Delete: resourceAwsSsmMaintenanceWindowTaskDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSsmMaintenanceWindowTaskImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"window_id": {
 				Type:     schema.TypeString,
@@ -126,6 +131,18 @@ func resourceAwsSsmMaintenanceWindowTask() *schema.Resource {
 	}
 }
 
+func resourceAwsSsmMaintenanceWindowTaskImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected WINDOW-ID/WINDOW-TASK-ID", d.Id())
+	}
+
+	d.Set("window_id", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func expandAwsSsmMaintenanceWindowLoggingInfo(config []interface{}) *ssm.LoggingInfo {
 
 	loggingConfig := config[0].(map[string]interface{})
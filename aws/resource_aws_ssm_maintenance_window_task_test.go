@@ -27,6 +27,13 @@ func TestAccAWSSSMMaintenanceWindowTask_basic(t *testing.T) {
 					testAccCheckAWSSSMMaintenanceWindowTaskExists("aws_ssm_maintenance_window_task.target", &task),
 				),
 			},
+
+			{
+				ResourceName:      "aws_ssm_maintenance_window_task.target",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSSSMMaintenanceWindowTaskImportStateIdFunc("aws_ssm_maintenance_window_task.target"),
+			},
 		},
 	})
 }
@@ -270,3 +277,14 @@ EOF
 
 `, rName, rName, rName)
 }
+
+func testAccAWSSSMMaintenanceWindowTaskImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["window_id"], rs.Primary.ID), nil
+	}
+}
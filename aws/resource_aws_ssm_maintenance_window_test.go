@@ -32,6 +32,11 @@ func TestAccAWSSSMMaintenanceWindow_basic(t *testing.T) {
 						"aws_ssm_maintenance_window.foo", "name", fmt.Sprintf("maintenance-window-%s", name)),
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_maintenance_window.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSSSMMaintenanceWindowBasicConfigUpdated(name),
 				Check: resource.ComposeTestCheckFunc(
@@ -14,6 +14,10 @@ func resourceAwsSsmPatchGroup() *schema.Resource {
 		Read:   resourceAwsSsmPatchGroupRead,
 		Delete: resourceAwsSsmPatchGroupDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"baseline_id": {
 				Type:     schema.TypeString,
@@ -15,6 +15,10 @@ func resourceAwsSsmResourceDataSync() *schema.Resource {
 		Read:   resourceAwsSsmResourceDataSyncRead,
 		Delete: resourceAwsSsmResourceDataSyncDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsSsmResourceDataSyncImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -56,6 +60,12 @@ func resourceAwsSsmResourceDataSync() *schema.Resource {
 	}
 }
 
+func resourceAwsSsmResourceDataSyncImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsSsmResourceDataSyncCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ssmconn
 
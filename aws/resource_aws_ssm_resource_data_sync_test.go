@@ -22,6 +22,12 @@ func TestAccAWSSsmResourceDataSync_basic(t *testing.T) {
 					testAccCheckAWSSsmResourceDataSyncExists("aws_ssm_resource_data_sync.foo"),
 				),
 			},
+
+			{
+				ResourceName:      "aws_ssm_resource_data_sync.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"net"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,10 +15,11 @@ import (
 
 func resourceAwsSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsSubnetCreate,
-		Read:   resourceAwsSubnetRead,
-		Update: resourceAwsSubnetUpdate,
-		Delete: resourceAwsSubnetDelete,
+		Create:        resourceAwsSubnetCreate,
+		Read:          resourceAwsSubnetRead,
+		Update:        resourceAwsSubnetUpdate,
+		Delete:        resourceAwsSubnetDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -68,7 +70,8 @@ func resourceAwsSubnet() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -76,6 +79,10 @@ func resourceAwsSubnet() *schema.Resource {
 func resourceAwsSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
+	if err := subnetValidateCidrBlockInVpc(conn, d.Get("vpc_id").(string), d.Get("cidr_block").(string)); err != nil {
+		return err
+	}
+
 	createOpts := &ec2.CreateSubnetInput{
 		AvailabilityZone: aws.String(d.Get("availability_zone").(string)),
 		CidrBlock:        aws.String(d.Get("cidr_block").(string)),
@@ -154,7 +161,7 @@ func resourceAwsSubnetRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("ipv6_cidr_block", "")
 		}
 	}
-	d.Set("tags", tagsToMap(subnet.Tags))
+	setTagsAll(d, meta, tagsToMap(subnet.Tags))
 
 	return nil
 }
@@ -168,6 +175,7 @@ func resourceAwsSubnetUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("map_public_ip_on_launch") {
@@ -385,3 +393,77 @@ func SubnetIpv6CidrStateRefreshFunc(conn *ec2.EC2, id string, associationId stri
 		return nil, "", nil
 	}
 }
+
+// subnetValidateCidrBlockInVpc checks that a subnet's CIDR block lies within
+// one of the IPv4 CIDR blocks associated with its VPC, primary or secondary.
+// Should the block still be associating, it waits for the association to
+// complete so the subnet can be created right after it.
+func subnetValidateCidrBlockInVpc(conn *ec2.EC2, vpcId, cidrBlock string) error {
+	resp, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{
+		VpcIds: []*string{aws.String(vpcId)},
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidVpcID.NotFound", "") {
+			// Leave reporting a missing VPC to the subnet creation.
+			return nil
+		}
+		if isAWSErr(err, "UnauthorizedOperation", "") {
+			// The check is only a convenience, so don't require
+			// ec2:DescribeVpcs to create subnets.
+			log.Printf("[WARN] Skipping the check of subnet CIDR block %s against VPC %s: %s", cidrBlock, vpcId, err)
+			return nil
+		}
+		return fmt.Errorf("Error reading VPC (%s): %s", vpcId, err)
+	}
+	if len(resp.Vpcs) == 0 {
+		return nil
+	}
+
+	var vpcCidrBlocks []string
+	for _, cidrAssociation := range resp.Vpcs[0].CidrBlockAssociationSet {
+		vpcCidrBlock := aws.StringValue(cidrAssociation.CidrBlock)
+		state := aws.StringValue(cidrAssociation.CidrBlockState.State)
+		if state != ec2.VpcCidrBlockStateCodeAssociated && state != ec2.VpcCidrBlockStateCodeAssociating {
+			continue
+		}
+		vpcCidrBlocks = append(vpcCidrBlocks, vpcCidrBlock)
+
+		if !cidrBlockContains(vpcCidrBlock, cidrBlock) {
+			continue
+		}
+
+		if state == ec2.VpcCidrBlockStateCodeAssociating {
+			assocId := aws.StringValue(cidrAssociation.AssociationId)
+			log.Printf("[DEBUG] Waiting for IPv4 CIDR block association (%s) of subnet CIDR block %s", assocId, cidrBlock)
+			stateConf := &resource.StateChangeConf{
+				Pending: []string{ec2.VpcCidrBlockStateCodeAssociating},
+				Target:  []string{ec2.VpcCidrBlockStateCodeAssociated},
+				Refresh: vpcIpv4CidrBlockAssociationStateRefresh(conn, assocId),
+				Timeout: 10 * time.Minute,
+			}
+			if _, err := stateConf.WaitForState(); err != nil {
+				return fmt.Errorf("Error waiting for IPv4 CIDR block association (%s) to become available: %s", assocId, err)
+			}
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("Error creating subnet: CIDR block %s is not within any of the CIDR blocks associated with VPC %s: %v", cidrBlock, vpcId, vpcCidrBlocks)
+}
+
+// cidrBlockContains reports whether the CIDR block inner is a subset of outer.
+func cidrBlockContains(outer, inner string) bool {
+	_, outerNet, err := net.ParseCIDR(outer)
+	if err != nil {
+		return false
+	}
+	_, innerNet, err := net.ParseCIDR(inner)
+	if err != nil {
+		return false
+	}
+
+	outerOnes, outerBits := outerNet.Mask.Size()
+	innerOnes, innerBits := innerNet.Mask.Size()
+	return outerBits == innerBits && innerOnes >= outerOnes && outerNet.Contains(innerNet.IP)
+}
@@ -63,6 +63,57 @@ func testSweepSubnets(region string) error {
 	return nil
 }
 
+func TestCidrBlockContains(t *testing.T) {
+	cases := []struct {
+		Outer    string
+		Inner    string
+		Expected bool
+	}{
+		{"10.0.0.0/16", "10.0.1.0/24", true},
+		{"10.0.0.0/16", "10.0.0.0/16", true},
+		{"10.0.0.0/16", "10.1.0.0/24", false},
+		{"10.0.0.0/16", "10.0.0.0/8", false},
+		{"100.64.0.0/16", "100.64.255.0/24", true},
+		{"10.0.0.0/16", "2600:1f14::/64", false},
+		{"10.0.0.0/16", "not-a-cidr", false},
+	}
+
+	for _, tc := range cases {
+		if actual := cidrBlockContains(tc.Outer, tc.Inner); actual != tc.Expected {
+			t.Fatalf("cidrBlockContains(%q, %q): expected %t, got %t", tc.Outer, tc.Inner, tc.Expected, actual)
+		}
+	}
+}
+
+func TestSubnetValidateCidrBlockInVpc_unauthorized(t *testing.T) {
+	ec2Endpoints := []*awsMockEndpoint{
+		&awsMockEndpoint{
+			Request:  &awsMockRequest{"POST", "/", "Action=DescribeVpcs&Version=2016-11-15&VpcId.1=vpc-1a2b3c4d"},
+			Response: &awsMockResponse{403, test_ec2_unauthorizedOperation_response, "text/xml"},
+		},
+	}
+	closeFunc, sess, err := getMockedAwsApiSession("EC2", ec2Endpoints)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeFunc()
+
+	if err := subnetValidateCidrBlockInVpc(ec2.New(sess), "vpc-1a2b3c4d", "10.0.1.0/24"); err != nil {
+		t.Fatalf("Expected the check to be skipped, received: %s", err)
+	}
+}
+
+var test_ec2_unauthorizedOperation_response = `<?xml version="1.0" encoding="UTF-8"?>
+<Response>
+  <Errors>
+    <Error>
+      <Code>UnauthorizedOperation</Code>
+      <Message>You are not authorized to perform this operation.</Message>
+    </Error>
+  </Errors>
+  <RequestID>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</RequestID>
+</Response>`
+
 func TestAccAWSSubnet_basic(t *testing.T) {
 	var v ec2.Subnet
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -20,6 +21,10 @@ func resourceAwsVolumeAttachment() *schema.Resource {
 		Read:   resourceAwsVolumeAttachmentRead,
 		Delete: resourceAwsVolumeAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVolumeAttachmentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"device_name": {
 				Type:     schema.TypeString,
@@ -53,6 +58,21 @@ func resourceAwsVolumeAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsVolumeAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), ":", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected DEVICE-NAME:VOLUME-ID:INSTANCE-ID", d.Id())
+	}
+
+	deviceName, volumeId, instanceId := idParts[0], idParts[1], idParts[2]
+	d.Set("device_name", deviceName)
+	d.Set("volume_id", volumeId)
+	d.Set("instance_id", instanceId)
+	d.SetId(volumeAttachmentID(deviceName, volumeId, instanceId))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsVolumeAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	name := d.Get("device_name").(string)
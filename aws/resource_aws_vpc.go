@@ -14,10 +14,11 @@ import (
 
 func resourceAwsVpc() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsVpcCreate,
-		Read:   resourceAwsVpcRead,
-		Update: resourceAwsVpcUpdate,
-		Delete: resourceAwsVpcDelete,
+		Create:        resourceAwsVpcCreate,
+		Read:          resourceAwsVpcRead,
+		Update:        resourceAwsVpcUpdate,
+		Delete:        resourceAwsVpcDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: resourceAwsVpcInstanceImport,
 		},
@@ -105,7 +106,8 @@ func resourceAwsVpc() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -180,7 +182,7 @@ func resourceAwsVpcRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("instance_tenancy", vpc.InstanceTenancy)
 
 	// Tags
-	d.Set("tags", tagsToMap(vpc.Tags))
+	setTagsAll(d, meta, tagsToMap(vpc.Tags))
 
 	for _, a := range vpc.Ipv6CidrBlockAssociationSet {
 		if *a.Ipv6CidrBlockState.State == "associated" { //we can only ever have 1 IPv6 block associated at once
@@ -456,6 +458,7 @@ func resourceAwsVpcUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	d.Partial(false)
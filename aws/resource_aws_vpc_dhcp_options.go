@@ -15,10 +15,11 @@ import (
 
 func resourceAwsVpcDhcpOptions() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsVpcDhcpOptionsCreate,
-		Read:   resourceAwsVpcDhcpOptionsRead,
-		Update: resourceAwsVpcDhcpOptionsUpdate,
-		Delete: resourceAwsVpcDhcpOptionsDelete,
+		Create:        resourceAwsVpcDhcpOptionsCreate,
+		Read:          resourceAwsVpcDhcpOptionsRead,
+		Update:        resourceAwsVpcDhcpOptionsUpdate,
+		Delete:        resourceAwsVpcDhcpOptionsDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -61,6 +62,8 @@ func resourceAwsVpcDhcpOptions() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -166,7 +169,7 @@ func resourceAwsVpcDhcpOptionsRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	opts := resp.DhcpOptions[0]
-	d.Set("tags", tagsToMap(opts.Tags))
+	setTagsAll(d, meta, tagsToMap(opts.Tags))
 
 	for _, cfg := range opts.DhcpConfigurations {
 		tfKey := strings.Replace(*cfg.Key, "-", "_", -1)
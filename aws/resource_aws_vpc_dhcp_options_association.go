@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,6 +16,10 @@ func resourceAwsVpcDhcpOptionsAssociation() *schema.Resource {
 		Update: resourceAwsVpcDhcpOptionsAssociationUpdate,
 		Delete: resourceAwsVpcDhcpOptionsAssociationDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpcDhcpOptionsAssociationImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -29,6 +34,26 @@ func resourceAwsVpcDhcpOptionsAssociation() *schema.Resource {
 	}
 }
 
+func resourceAwsVpcDhcpOptionsAssociationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*AWSClient).ec2conn
+
+	vpcId := d.Id()
+	vpcRaw, _, err := VPCStateRefreshFunc(conn, vpcId)()
+	if err != nil {
+		return nil, err
+	}
+	if vpcRaw == nil {
+		return nil, fmt.Errorf("VPC %s not found", vpcId)
+	}
+
+	vpc := vpcRaw.(*ec2.Vpc)
+	d.Set("vpc_id", vpc.VpcId)
+	d.Set("dhcp_options_id", vpc.DhcpOptionsId)
+	d.SetId(*vpc.DhcpOptionsId + "-" + *vpc.VpcId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsVpcDhcpOptionsAssociationCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -26,6 +26,13 @@ func TestAccAWSDHCPOptionsAssociation_basic(t *testing.T) {
 					testAccCheckDHCPOptionsAssociationExist("aws_vpc_dhcp_options_association.foo", &v),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:      "aws_vpc_dhcp_options_association.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSDHCPOptionsAssociationImportStateIdFunc("aws_vpc_dhcp_options_association.foo"),
+			},
 		},
 	})
 }
@@ -97,3 +104,14 @@ resource "aws_vpc_dhcp_options_association" "foo" {
 	dhcp_options_id = "${aws_vpc_dhcp_options.foo.id}"
 }
 `
+
+func testAccAWSDHCPOptionsAssociationImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["vpc_id"], nil
+	}
+}
@@ -3,12 +3,15 @@ package aws
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceAwsVpcEndpoint() *schema.Resource {
@@ -21,6 +24,12 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"policy": &schema.Schema{
 				Type:         schema.TypeString,
@@ -37,6 +46,16 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"vpc_endpoint_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  ec2.VpcEndpointTypeGateway,
+				ValidateFunc: validation.StringInSlice([]string{
+					ec2.VpcEndpointTypeGateway,
+					ec2.VpcEndpointTypeInterface,
+				}, false),
+			},
 			"service_name": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
@@ -49,6 +68,29 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			"subnet_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"security_group_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"private_dns_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"prefix_list_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Computed: true,
@@ -58,6 +100,28 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"network_interface_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"dns_entry": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dns_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosted_zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -65,8 +129,9 @@ func resourceAwsVpcEndpoint() *schema.Resource {
 func resourceAwsVPCEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 	input := &ec2.CreateVpcEndpointInput{
-		VpcId:       aws.String(d.Get("vpc_id").(string)),
-		ServiceName: aws.String(d.Get("service_name").(string)),
+		VpcId:           aws.String(d.Get("vpc_id").(string)),
+		VpcEndpointType: aws.String(d.Get("vpc_endpoint_type").(string)),
+		ServiceName:     aws.String(d.Get("service_name").(string)),
 	}
 
 	if v, ok := d.GetOk("route_table_ids"); ok {
@@ -76,6 +141,18 @@ func resourceAwsVPCEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if *input.VpcEndpointType == ec2.VpcEndpointTypeInterface {
+		input.PrivateDnsEnabled = aws.Bool(d.Get("private_dns_enabled").(bool))
+
+		if v, ok := d.GetOk("subnet_ids"); ok {
+			input.SubnetIds = expandStringSet(v.(*schema.Set))
+		}
+
+		if v, ok := d.GetOk("security_group_ids"); ok {
+			input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+		}
+	}
+
 	if v, ok := d.GetOk("policy"); ok {
 		policy, err := normalizeJsonString(v)
 		if err != nil {
@@ -93,6 +170,10 @@ func resourceAwsVPCEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(*output.VpcEndpoint.VpcEndpointId)
 
+	if err := vpcEndpointWaitUntilAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
 	return resourceAwsVPCEndpointRead(d, meta)
 }
 
@@ -127,41 +208,56 @@ func resourceAwsVPCEndpointRead(d *schema.ResourceData, meta interface{}) error
 
 	vpce := output.VpcEndpoints[0]
 
-	// A VPC Endpoint is associated with exactly one prefix list name (also called Service Name).
-	// The prefix list ID can be used in security groups, so retrieve it to support that capability.
-	prefixListServiceName := *vpce.ServiceName
-	prefixListInput := &ec2.DescribePrefixListsInput{
-		Filters: []*ec2.Filter{
-			{Name: aws.String("prefix-list-name"), Values: []*string{aws.String(prefixListServiceName)}},
-		},
+	switch aws.StringValue(vpce.State) {
+	case "deleted", "deleting", "failed", "rejected", "expired":
+		log.Printf("[WARN] VPC Endpoint (%s) in state (%s), removing from state", d.Id(), *vpce.State)
+		d.SetId("")
+		return nil
 	}
 
-	log.Printf("[DEBUG] Reading VPC Endpoint prefix list: %s", prefixListServiceName)
-	prefixListsOutput, err := conn.DescribePrefixLists(prefixListInput)
+	d.Set("vpc_id", vpce.VpcId)
+	d.Set("vpc_endpoint_type", vpce.VpcEndpointType)
+	d.Set("service_name", vpce.ServiceName)
+	d.Set("state", vpce.State)
+	d.Set("private_dns_enabled", vpce.PrivateDnsEnabled)
 
-	if err != nil {
-		_, ok := err.(awserr.Error)
-		if !ok {
-			return fmt.Errorf("Error reading VPC Endpoint prefix list: %s", err.Error())
+	if vpce.PolicyDocument != nil {
+		policy, err := normalizeJsonString(*vpce.PolicyDocument)
+		if err != nil {
+			return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
 		}
+		d.Set("policy", policy)
 	}
 
-	if len(prefixListsOutput.PrefixLists) != 1 {
-		return fmt.Errorf("There are multiple prefix lists associated with the service name '%s'. Unexpected", prefixListServiceName)
+	if err := d.Set("route_table_ids", aws.StringValueSlice(vpce.RouteTableIds)); err != nil {
+		return err
+	}
+	if err := d.Set("subnet_ids", aws.StringValueSlice(vpce.SubnetIds)); err != nil {
+		return err
+	}
+	if err := d.Set("security_group_ids", flattenVpcEndpointSecurityGroupIds(vpce.Groups)); err != nil {
+		return err
+	}
+	if err := d.Set("network_interface_ids", aws.StringValueSlice(vpce.NetworkInterfaceIds)); err != nil {
+		return err
+	}
+	if err := d.Set("dns_entry", flattenVpcEndpointDnsEntries(vpce.DnsEntries)); err != nil {
+		return err
 	}
 
-	policy, err := normalizeJsonString(*vpce.PolicyDocument)
-	if err != nil {
-		return errwrap.Wrapf("policy contains an invalid JSON: {{err}}", err)
+	// Interface endpoints have no prefix list
+	if aws.StringValue(vpce.VpcEndpointType) != ec2.VpcEndpointTypeGateway {
+		d.Set("prefix_list_id", "")
+		d.Set("cidr_blocks", []string{})
+		return nil
 	}
 
-	d.Set("vpc_id", vpce.VpcId)
-	d.Set("policy", policy)
-	d.Set("service_name", vpce.ServiceName)
-	if err := d.Set("route_table_ids", aws.StringValueSlice(vpce.RouteTableIds)); err != nil {
+	// A VPC Endpoint is associated with exactly one prefix list name (also called Service Name).
+	// The prefix list ID can be used in security groups, so retrieve it to support that capability.
+	pl, err := vpcEndpointPrefixList(conn, *vpce.ServiceName)
+	if err != nil {
 		return err
 	}
-	pl := prefixListsOutput.PrefixLists[0]
 	d.Set("prefix_list_id", pl.PrefixListId)
 	d.Set("cidr_blocks", aws.StringValueSlice(pl.Cidrs))
 
@@ -175,19 +271,19 @@ func resourceAwsVPCEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if d.HasChange("route_table_ids") {
-		o, n := d.GetChange("route_table_ids")
-		os := o.(*schema.Set)
-		ns := n.(*schema.Set)
+		input.AddRouteTableIds, input.RemoveRouteTableIds = vpcEndpointSetChange(d, "route_table_ids")
+	}
 
-		add := expandStringList(ns.Difference(os).List())
-		if len(add) > 0 {
-			input.AddRouteTableIds = add
-		}
+	if d.HasChange("subnet_ids") {
+		input.AddSubnetIds, input.RemoveSubnetIds = vpcEndpointSetChange(d, "subnet_ids")
+	}
 
-		remove := expandStringList(os.Difference(ns).List())
-		if len(remove) > 0 {
-			input.RemoveRouteTableIds = remove
-		}
+	if d.HasChange("security_group_ids") {
+		input.AddSecurityGroupIds, input.RemoveSecurityGroupIds = vpcEndpointSetChange(d, "security_group_ids")
+	}
+
+	if d.HasChange("private_dns_enabled") {
+		input.PrivateDnsEnabled = aws.Bool(d.Get("private_dns_enabled").(bool))
 	}
 
 	if d.HasChange("policy") {
@@ -205,6 +301,10 @@ func resourceAwsVPCEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 	}
 	log.Printf("[DEBUG] VPC Endpoint %q updated", input.VpcEndpointId)
 
+	if err := vpcEndpointWaitUntilAvailable(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
 	return resourceAwsVPCEndpointRead(d, meta)
 }
 
@@ -230,8 +330,158 @@ func resourceAwsVPCEndpointDelete(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "pending", "pendingAcceptance", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    vpcEndpointStateRefresh(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint %q to be deleted: %s", d.Id(), err)
+	}
+
+	// The network interfaces of interface endpoints are released
+	// asynchronously and block the deletion of their subnets and
+	// security groups until then.
+	if v, ok := d.GetOk("network_interface_ids"); ok && v.(*schema.Set).Len() > 0 {
+		eniIds := expandStringSet(v.(*schema.Set))
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"attached"},
+			Target:     []string{"detached"},
+			Refresh:    vpcEndpointNetworkInterfacesRefresh(conn, eniIds),
+			Timeout:    d.Timeout(schema.TimeoutDelete),
+			Delay:      5 * time.Second,
+			MinTimeout: 5 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for the network interfaces of VPC Endpoint %q to be deleted: %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[DEBUG] VPC Endpoint %q deleted", d.Id())
 	d.SetId("")
 
 	return nil
 }
+
+func vpcEndpointStateRefresh(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Reading VPC Endpoint: %s", id)
+		resp, err := conn.DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+			VpcEndpointIds: aws.StringSlice([]string{id}),
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidVpcEndpointId.NotFound", "") {
+				return "", "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		if len(resp.VpcEndpoints) == 0 {
+			return "", "deleted", nil
+		}
+
+		vpce := resp.VpcEndpoints[0]
+		state := aws.StringValue(vpce.State)
+		// No use in retrying if the endpoint is in a failed state.
+		if state == "failed" {
+			return nil, state, fmt.Errorf("VPC Endpoint %s is in a failed state", id)
+		}
+		return vpce, state, nil
+	}
+}
+
+// vpcEndpointWaitUntilAvailable waits for an endpoint to be usable, which for
+// endpoints of services requiring acceptance means pending acceptance.
+func vpcEndpointWaitUntilAvailable(conn *ec2.EC2, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{"available", "pendingAcceptance"},
+		Refresh:    vpcEndpointStateRefresh(conn, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint %q to become available: %s", id, err)
+	}
+
+	return nil
+}
+
+func vpcEndpointNetworkInterfacesRefresh(conn *ec2.EC2, eniIds []*string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeNetworkInterfaces(&ec2.DescribeNetworkInterfacesInput{
+			Filters: []*ec2.Filter{
+				{
+					Name:   aws.String("network-interface-id"),
+					Values: eniIds,
+				},
+			},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(resp.NetworkInterfaces) > 0 {
+			return resp, "attached", nil
+		}
+		return resp, "detached", nil
+	}
+}
+
+func vpcEndpointPrefixList(conn *ec2.EC2, serviceName string) (*ec2.PrefixList, error) {
+	prefixListInput := &ec2.DescribePrefixListsInput{
+		Filters: []*ec2.Filter{
+			{Name: aws.String("prefix-list-name"), Values: []*string{aws.String(serviceName)}},
+		},
+	}
+
+	log.Printf("[DEBUG] Reading VPC Endpoint prefix list: %s", serviceName)
+	prefixListsOutput, err := conn.DescribePrefixLists(prefixListInput)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading VPC Endpoint prefix list: %s", err.Error())
+	}
+
+	if len(prefixListsOutput.PrefixLists) != 1 {
+		return nil, fmt.Errorf("There are multiple prefix lists associated with the service name '%s'. Unexpected", serviceName)
+	}
+
+	return prefixListsOutput.PrefixLists[0], nil
+}
+
+func vpcEndpointSetChange(d *schema.ResourceData, key string) (add, remove []*string) {
+	o, n := d.GetChange(key)
+	os := o.(*schema.Set)
+	ns := n.(*schema.Set)
+
+	if l := ns.Difference(os).List(); len(l) > 0 {
+		add = expandStringList(l)
+	}
+	if l := os.Difference(ns).List(); len(l) > 0 {
+		remove = expandStringList(l)
+	}
+	return
+}
+
+func flattenVpcEndpointSecurityGroupIds(groups []*ec2.SecurityGroupIdentifier) []string {
+	ids := make([]string, 0, len(groups))
+	for _, group := range groups {
+		ids = append(ids, aws.StringValue(group.GroupId))
+	}
+	return ids
+}
+
+func flattenVpcEndpointDnsEntries(dnsEntries []*ec2.DnsEntry) []interface{} {
+	entries := make([]interface{}, 0, len(dnsEntries))
+	for _, dnsEntry := range dnsEntries {
+		entries = append(entries, map[string]interface{}{
+			"dns_name":       aws.StringValue(dnsEntry.DnsName),
+			"hosted_zone_id": aws.StringValue(dnsEntry.HostedZoneId),
+		})
+	}
+	return entries
+}
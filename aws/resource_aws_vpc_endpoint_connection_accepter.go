@@ -0,0 +1,173 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsVpcEndpointConnectionAccepter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcEndpointConnectionAccepterCreate,
+		Read:   resourceAwsVpcEndpointConnectionAccepterRead,
+		Delete: resourceAwsVpcEndpointConnectionAccepterDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpcEndpointConnectionAccepterImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_endpoint_service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_endpoint_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsVpcEndpointConnectionAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	svcId := d.Get("vpc_endpoint_service_id").(string)
+	vpceId := d.Get("vpc_endpoint_id").(string)
+
+	log.Printf("[DEBUG] Accepting VPC Endpoint connection %s to service %s", vpceId, svcId)
+	resp, err := conn.AcceptVpcEndpointConnections(&ec2.AcceptVpcEndpointConnectionsInput{
+		ServiceId:      aws.String(svcId),
+		VpcEndpointIds: aws.StringSlice([]string{vpceId}),
+	})
+	if err != nil {
+		return fmt.Errorf("Error accepting VPC Endpoint connection: %s", err.Error())
+	}
+	for _, item := range resp.Unsuccessful {
+		if item.Error != nil {
+			return fmt.Errorf("Error accepting VPC Endpoint connection (%s): %s", vpceId, aws.StringValue(item.Error.Message))
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", svcId, vpceId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pendingAcceptance", "pending"},
+		Target:     []string{"available"},
+		Refresh:    vpcEndpointConnectionStateRefresh(conn, svcId, vpceId),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint connection %s to become available: %s", d.Id(), err.Error())
+	}
+
+	return resourceAwsVpcEndpointConnectionAccepterRead(d, meta)
+}
+
+func resourceAwsVpcEndpointConnectionAccepterRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	svcId := d.Get("vpc_endpoint_service_id").(string)
+	vpceId := d.Get("vpc_endpoint_id").(string)
+
+	_, state, err := vpcEndpointConnectionStateRefresh(conn, svcId, vpceId)()
+	if err != nil && state != "failed" {
+		return fmt.Errorf("Error reading VPC Endpoint connection (%s): %s", d.Id(), err.Error())
+	}
+
+	if state == "deleted" || state == "rejected" || state == "failed" {
+		log.Printf("[WARN] VPC Endpoint connection (%s) in state (%s), removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("vpc_endpoint_state", state)
+
+	return nil
+}
+
+func resourceAwsVpcEndpointConnectionAccepterDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	svcId := d.Get("vpc_endpoint_service_id").(string)
+	vpceId := d.Get("vpc_endpoint_id").(string)
+
+	resp, err := conn.RejectVpcEndpointConnections(&ec2.RejectVpcEndpointConnectionsInput{
+		ServiceId:      aws.String(svcId),
+		VpcEndpointIds: aws.StringSlice([]string{vpceId}),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidVpcEndpointServiceId.NotFound", "") {
+			log.Printf("[DEBUG] VPC Endpoint connection %s is already gone", d.Id())
+			return nil
+		}
+		return fmt.Errorf("Error rejecting VPC Endpoint connection: %s", err.Error())
+	}
+	for _, item := range resp.Unsuccessful {
+		if item.Error != nil {
+			if aws.StringValue(item.Error.Code) == "InvalidVpcEndpoint.NotFound" {
+				continue
+			}
+			return fmt.Errorf("Error rejecting VPC Endpoint connection (%s): %s", vpceId, aws.StringValue(item.Error.Message))
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsVpcEndpointConnectionAccepterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "_")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected VPC-ENDPOINT-SERVICE-ID_VPC-ENDPOINT-ID", d.Id())
+	}
+
+	d.Set("vpc_endpoint_service_id", parts[0])
+	d.Set("vpc_endpoint_id", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func vpcEndpointConnectionStateRefresh(conn *ec2.EC2, svcId, vpceId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Reading VPC Endpoint connection: %s_%s", svcId, vpceId)
+		resp, err := conn.DescribeVpcEndpointConnections(&ec2.DescribeVpcEndpointConnectionsInput{
+			Filters: buildEC2AttributeFilterList(map[string]string{
+				"service-id":      svcId,
+				"vpc-endpoint-id": vpceId,
+			}),
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidVpcEndpointServiceId.NotFound", "") {
+				return false, "deleted", nil
+			}
+
+			return nil, "", err
+		}
+
+		if len(resp.VpcEndpointConnections) == 0 {
+			return false, "deleted", nil
+		}
+
+		vpceConn := resp.VpcEndpointConnections[0]
+		state := aws.StringValue(vpceConn.VpcEndpointState)
+		// No use in retrying if the connection is in a failed state.
+		if state == "failed" {
+			return nil, state, fmt.Errorf("VPC Endpoint connection %s_%s is in a failed state", svcId, vpceId)
+		}
+		return vpceConn, state, nil
+	}
+}
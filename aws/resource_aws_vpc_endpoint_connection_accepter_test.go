@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSVpcEndpointConnectionAccepter_basic(t *testing.T) {
+	lbName := fmt.Sprintf("tf-acc-lb-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcEndpointConnectionAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointConnectionAccepterConfig(lbName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_vpc_endpoint_connection_accepter.foo", "vpc_endpoint_state", "available"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.foo", "state", "available"),
+				),
+			},
+			{
+				ResourceName:      "aws_vpc_endpoint_connection_accepter.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVpcEndpointConnectionAccepterDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_endpoint_connection_accepter" {
+			continue
+		}
+
+		_, state, err := vpcEndpointConnectionStateRefresh(conn, rs.Primary.Attributes["vpc_endpoint_service_id"], rs.Primary.Attributes["vpc_endpoint_id"])()
+		if err != nil {
+			return err
+		}
+		if state != "deleted" && state != "rejected" {
+			return fmt.Errorf("VPC Endpoint connection %s still exists in state %s", rs.Primary.ID, state)
+		}
+	}
+
+	return nil
+}
+
+func testAccVpcEndpointConnectionAccepterConfig(lbName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "nlb_test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-connection-accepter"
+  }
+}
+
+resource "aws_lb" "nlb_test" {
+  name = "%s"
+
+  subnets = [
+    "${aws_subnet.nlb_test.id}",
+  ]
+
+  load_balancer_type         = "network"
+  internal                   = true
+  idle_timeout               = 60
+  enable_deletion_protection = false
+}
+
+resource "aws_subnet" "nlb_test" {
+  vpc_id            = "${aws_vpc.nlb_test.id}"
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = "us-west-2a"
+}
+
+resource "aws_security_group" "test" {
+  vpc_id = "${aws_vpc.nlb_test.id}"
+}
+
+resource "aws_vpc_endpoint_service" "foo" {
+  acceptance_required = true
+
+  network_load_balancer_arns = [
+    "${aws_lb.nlb_test.arn}",
+  ]
+}
+
+resource "aws_vpc_endpoint" "foo" {
+  vpc_id            = "${aws_vpc.nlb_test.id}"
+  service_name      = "${aws_vpc_endpoint_service.foo.service_name}"
+  vpc_endpoint_type = "Interface"
+
+  subnet_ids         = ["${aws_subnet.nlb_test.id}"]
+  security_group_ids = ["${aws_security_group.test.id}"]
+}
+
+resource "aws_vpc_endpoint_connection_accepter" "foo" {
+  vpc_endpoint_service_id = "${aws_vpc_endpoint_service.foo.id}"
+  vpc_endpoint_id         = "${aws_vpc_endpoint.foo.id}"
+}
+`, lbName)
+}
@@ -0,0 +1,294 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsVpcEndpointService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcEndpointServiceCreate,
+		Read:   resourceAwsVpcEndpointServiceRead,
+		Update: resourceAwsVpcEndpointServiceUpdate,
+		Delete: resourceAwsVpcEndpointServiceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"acceptance_required": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"network_load_balancer_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"allowed_principals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"private_dns_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"base_endpoint_dns_names": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceAwsVpcEndpointServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	req := &ec2.CreateVpcEndpointServiceConfigurationInput{
+		AcceptanceRequired:      aws.Bool(d.Get("acceptance_required").(bool)),
+		NetworkLoadBalancerArns: expandStringList(d.Get("network_load_balancer_arns").(*schema.Set).List()),
+	}
+
+	log.Printf("[DEBUG] Creating VPC Endpoint Service configuration: %#v", req)
+	resp, err := conn.CreateVpcEndpointServiceConfiguration(req)
+	if err != nil {
+		return fmt.Errorf("Error creating VPC Endpoint Service configuration: %s", err.Error())
+	}
+
+	d.SetId(aws.StringValue(resp.ServiceConfiguration.ServiceId))
+
+	if err := vpcEndpointServiceWaitUntilAvailable(d, conn, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("allowed_principals"); ok && v.(*schema.Set).Len() > 0 {
+		modifyPermReq := &ec2.ModifyVpcEndpointServicePermissionsInput{
+			ServiceId:            aws.String(d.Id()),
+			AddAllowedPrincipals: expandStringList(v.(*schema.Set).List()),
+		}
+		log.Printf("[DEBUG] Adding VPC Endpoint Service permissions: %#v", modifyPermReq)
+		if _, err := conn.ModifyVpcEndpointServicePermissions(modifyPermReq); err != nil {
+			return fmt.Errorf("Error adding VPC Endpoint Service permissions: %s", err.Error())
+		}
+	}
+
+	return resourceAwsVpcEndpointServiceRead(d, meta)
+}
+
+func resourceAwsVpcEndpointServiceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	svcCfgRaw, state, err := vpcEndpointServiceStateRefresh(conn, d.Id())()
+	if err != nil && state != ec2.ServiceStateFailed {
+		return fmt.Errorf("Error reading VPC Endpoint Service (%s): %s", d.Id(), err.Error())
+	}
+
+	terminalStates := map[string]bool{
+		ec2.ServiceStateDeleted:  true,
+		ec2.ServiceStateDeleting: true,
+		ec2.ServiceStateFailed:   true,
+	}
+	if terminalStates[state] {
+		log.Printf("[WARN] VPC Endpoint Service (%s) in state (%s), removing from state", d.Id(), state)
+		d.SetId("")
+		return nil
+	}
+
+	svcCfg := svcCfgRaw.(*ec2.ServiceConfiguration)
+	d.Set("acceptance_required", svcCfg.AcceptanceRequired)
+	if err := d.Set("network_load_balancer_arns", aws.StringValueSlice(svcCfg.NetworkLoadBalancerArns)); err != nil {
+		return err
+	}
+	d.Set("state", svcCfg.ServiceState)
+	d.Set("service_name", svcCfg.ServiceName)
+	if len(svcCfg.ServiceType) > 0 {
+		d.Set("service_type", svcCfg.ServiceType[0].ServiceType)
+	}
+	if err := d.Set("availability_zones", aws.StringValueSlice(svcCfg.AvailabilityZones)); err != nil {
+		return err
+	}
+	d.Set("private_dns_name", svcCfg.PrivateDnsName)
+	if err := d.Set("base_endpoint_dns_names", aws.StringValueSlice(svcCfg.BaseEndpointDnsNames)); err != nil {
+		return err
+	}
+
+	resp, err := conn.DescribeVpcEndpointServicePermissions(&ec2.DescribeVpcEndpointServicePermissionsInput{
+		ServiceId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading VPC Endpoint Service permissions (%s): %s", d.Id(), err.Error())
+	}
+	if err := d.Set("allowed_principals", flattenVpcEndpointServiceAllowedPrincipals(resp.AllowedPrincipals)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAwsVpcEndpointServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	if d.HasChange("acceptance_required") || d.HasChange("network_load_balancer_arns") {
+		modifyCfgReq := &ec2.ModifyVpcEndpointServiceConfigurationInput{
+			ServiceId: aws.String(d.Id()),
+		}
+
+		if d.HasChange("acceptance_required") {
+			modifyCfgReq.AcceptanceRequired = aws.Bool(d.Get("acceptance_required").(bool))
+		}
+
+		if d.HasChange("network_load_balancer_arns") {
+			modifyCfgReq.AddNetworkLoadBalancerArns, modifyCfgReq.RemoveNetworkLoadBalancerArns = vpcEndpointSetChange(d, "network_load_balancer_arns")
+		}
+
+		log.Printf("[DEBUG] Modifying VPC Endpoint Service configuration: %#v", modifyCfgReq)
+		if _, err := conn.ModifyVpcEndpointServiceConfiguration(modifyCfgReq); err != nil {
+			return fmt.Errorf("Error modifying VPC Endpoint Service configuration: %s", err.Error())
+		}
+
+		if err := vpcEndpointServiceWaitUntilAvailable(d, conn, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("allowed_principals") {
+		modifyPermReq := &ec2.ModifyVpcEndpointServicePermissionsInput{
+			ServiceId: aws.String(d.Id()),
+		}
+		modifyPermReq.AddAllowedPrincipals, modifyPermReq.RemoveAllowedPrincipals = vpcEndpointSetChange(d, "allowed_principals")
+
+		log.Printf("[DEBUG] Modifying VPC Endpoint Service permissions: %#v", modifyPermReq)
+		if _, err := conn.ModifyVpcEndpointServicePermissions(modifyPermReq); err != nil {
+			return fmt.Errorf("Error modifying VPC Endpoint Service permissions: %s", err.Error())
+		}
+	}
+
+	return resourceAwsVpcEndpointServiceRead(d, meta)
+}
+
+func resourceAwsVpcEndpointServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	resp, err := conn.DeleteVpcEndpointServiceConfigurations(&ec2.DeleteVpcEndpointServiceConfigurationsInput{
+		ServiceIds: aws.StringSlice([]string{d.Id()}),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidVpcEndpointServiceId.NotFound", "") {
+			log.Printf("[DEBUG] VPC Endpoint Service %s is already gone", d.Id())
+			return nil
+		}
+		return fmt.Errorf("Error deleting VPC Endpoint Service: %s", err.Error())
+	}
+	// Services with active endpoint connections are not deleted, which is
+	// only reported in the unsuccessful items of the response.
+	for _, item := range resp.Unsuccessful {
+		if item.Error != nil {
+			return fmt.Errorf("Error deleting VPC Endpoint Service (%s): %s", d.Id(), aws.StringValue(item.Error.Message))
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.ServiceStateAvailable, ec2.ServiceStateDeleting},
+		Target:     []string{ec2.ServiceStateDeleted},
+		Refresh:    vpcEndpointServiceStateRefresh(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint Service %s to delete: %s", d.Id(), err.Error())
+	}
+
+	return nil
+}
+
+func vpcEndpointServiceStateRefresh(conn *ec2.EC2, svcId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		log.Printf("[DEBUG] Reading VPC Endpoint Service Configuration: %s", svcId)
+		resp, err := conn.DescribeVpcEndpointServiceConfigurations(&ec2.DescribeVpcEndpointServiceConfigurationsInput{
+			ServiceIds: aws.StringSlice([]string{svcId}),
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidVpcEndpointServiceId.NotFound", "") {
+				return false, ec2.ServiceStateDeleted, nil
+			}
+
+			return nil, "", err
+		}
+
+		if len(resp.ServiceConfigurations) == 0 {
+			return false, ec2.ServiceStateDeleted, nil
+		}
+
+		svcCfg := resp.ServiceConfigurations[0]
+		state := aws.StringValue(svcCfg.ServiceState)
+		// No use in retrying if the endpoint service is in a failed state.
+		if state == ec2.ServiceStateFailed {
+			return nil, state, fmt.Errorf("VPC Endpoint Service %s is in a failed state", svcId)
+		}
+		return svcCfg, state, nil
+	}
+}
+
+func vpcEndpointServiceWaitUntilAvailable(d *schema.ResourceData, conn *ec2.EC2, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.ServiceStatePending},
+		Target:     []string{ec2.ServiceStateAvailable},
+		Refresh:    vpcEndpointServiceStateRefresh(conn, d.Id()),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC Endpoint Service %s to become available: %s", d.Id(), err.Error())
+	}
+
+	return nil
+}
+
+func flattenVpcEndpointServiceAllowedPrincipals(allowedPrincipals []*ec2.AllowedPrincipal) []string {
+	principals := make([]string, 0, len(allowedPrincipals))
+	for _, allowedPrincipal := range allowedPrincipals {
+		if allowedPrincipal.Principal != nil {
+			principals = append(principals, aws.StringValue(allowedPrincipal.Principal))
+		}
+	}
+	return principals
+}
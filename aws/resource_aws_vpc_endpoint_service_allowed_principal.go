@@ -0,0 +1,112 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsVpcEndpointServiceAllowedPrincipal() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcEndpointServiceAllowedPrincipalCreate,
+		Read:   resourceAwsVpcEndpointServiceAllowedPrincipalRead,
+		Delete: resourceAwsVpcEndpointServiceAllowedPrincipalDelete,
+
+		Schema: map[string]*schema.Schema{
+			"vpc_endpoint_service_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"principal_arn": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsVpcEndpointServiceAllowedPrincipalCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	svcId := d.Get("vpc_endpoint_service_id").(string)
+	arn := d.Get("principal_arn").(string)
+
+	// Permissions of a service are modified one change at a time, so
+	// concurrent additions are serialized.
+	awsMutexKV.Lock(svcId)
+	defer awsMutexKV.Unlock(svcId)
+
+	_, err := conn.ModifyVpcEndpointServicePermissions(&ec2.ModifyVpcEndpointServicePermissionsInput{
+		ServiceId:            aws.String(svcId),
+		AddAllowedPrincipals: aws.StringSlice([]string{arn}),
+	})
+	if err != nil {
+		return fmt.Errorf("Error adding VPC Endpoint Service allowed principal: %s", err.Error())
+	}
+
+	d.SetId(vpcEndpointServiceIdPrincipalArnHash(svcId, arn))
+
+	return resourceAwsVpcEndpointServiceAllowedPrincipalRead(d, meta)
+}
+
+func resourceAwsVpcEndpointServiceAllowedPrincipalRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	svcId := d.Get("vpc_endpoint_service_id").(string)
+	arn := d.Get("principal_arn").(string)
+
+	resp, err := conn.DescribeVpcEndpointServicePermissions(&ec2.DescribeVpcEndpointServicePermissionsInput{
+		ServiceId: aws.String(svcId),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidVpcEndpointServiceId.NotFound", "") {
+			log.Printf("[WARN] VPC Endpoint Service (%s) not found, removing VPC Endpoint Service allowed principal (%s) from state", svcId, d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading VPC Endpoint Service permissions (%s): %s", svcId, err.Error())
+	}
+
+	for _, principal := range flattenVpcEndpointServiceAllowedPrincipals(resp.AllowedPrincipals) {
+		if principal == arn {
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] VPC Endpoint Service allowed principal (%s) not found, removing from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceAwsVpcEndpointServiceAllowedPrincipalDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	svcId := d.Get("vpc_endpoint_service_id").(string)
+	arn := d.Get("principal_arn").(string)
+
+	awsMutexKV.Lock(svcId)
+	defer awsMutexKV.Unlock(svcId)
+
+	_, err := conn.ModifyVpcEndpointServicePermissions(&ec2.ModifyVpcEndpointServicePermissionsInput{
+		ServiceId:               aws.String(svcId),
+		RemoveAllowedPrincipals: aws.StringSlice([]string{arn}),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidVpcEndpointServiceId.NotFound", "") {
+			log.Printf("[DEBUG] VPC Endpoint Service allowed principal %s is already gone", d.Id())
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting VPC Endpoint Service allowed principal: %s", err.Error())
+	}
+
+	return nil
+}
+
+func vpcEndpointServiceIdPrincipalArnHash(svcId, arn string) string {
+	return fmt.Sprintf("a-%s%d", svcId, hashcode.String(arn))
+}
@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSVpcEndpointServiceAllowedPrincipal_basic(t *testing.T) {
+	lbName := fmt.Sprintf("tf-acc-lb-%s", acctest.RandString(8))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcEndpointServiceAllowedPrincipalDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcEndpointServiceAllowedPrincipalBasicConfig(lbName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointServiceAllowedPrincipalExists("aws_vpc_endpoint_service_allowed_principal.foo"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVpcEndpointServiceAllowedPrincipalDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).ec2conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_endpoint_service_allowed_principal" {
+			continue
+		}
+
+		resp, err := conn.DescribeVpcEndpointServicePermissions(&ec2.DescribeVpcEndpointServicePermissionsInput{
+			ServiceId: aws.String(rs.Primary.Attributes["vpc_endpoint_service_id"]),
+		})
+		if err != nil {
+			if isAWSErr(err, "InvalidVpcEndpointServiceId.NotFound", "") {
+				continue
+			}
+			return err
+		}
+
+		for _, principal := range flattenVpcEndpointServiceAllowedPrincipals(resp.AllowedPrincipals) {
+			if principal == rs.Primary.Attributes["principal_arn"] {
+				return fmt.Errorf("VPC Endpoint Service allowed principal still exists")
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVpcEndpointServiceAllowedPrincipalExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Endpoint Service allowed principal ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		resp, err := conn.DescribeVpcEndpointServicePermissions(&ec2.DescribeVpcEndpointServicePermissionsInput{
+			ServiceId: aws.String(rs.Primary.Attributes["vpc_endpoint_service_id"]),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, principal := range flattenVpcEndpointServiceAllowedPrincipals(resp.AllowedPrincipals) {
+			if principal == rs.Primary.Attributes["principal_arn"] {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("VPC Endpoint Service allowed principal not found")
+	}
+}
+
+func testAccVpcEndpointServiceAllowedPrincipalBasicConfig(lbName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "nlb_test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags {
+    Name = "terraform-testacc-vpc-endpoint-service-allowed-principal"
+  }
+}
+
+resource "aws_lb" "nlb_test_1" {
+  name = "%s"
+
+  subnets = [
+    "${aws_subnet.nlb_test_1.id}",
+  ]
+
+  load_balancer_type         = "network"
+  internal                   = true
+  idle_timeout               = 60
+  enable_deletion_protection = false
+}
+
+resource "aws_subnet" "nlb_test_1" {
+  vpc_id            = "${aws_vpc.nlb_test.id}"
+  cidr_block        = "10.0.1.0/24"
+  availability_zone = "us-west-2a"
+}
+
+data "aws_caller_identity" "current" {}
+
+resource "aws_vpc_endpoint_service" "foo" {
+  acceptance_required = false
+
+  network_load_balancer_arns = [
+    "${aws_lb.nlb_test_1.id}",
+  ]
+}
+
+resource "aws_vpc_endpoint_service_allowed_principal" "foo" {
+  vpc_endpoint_service_id = "${aws_vpc_endpoint_service.foo.id}"
+
+  principal_arn = "${data.aws_caller_identity.current.arn}"
+}
+`, lbName)
+}
@@ -0,0 +1,157 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsVpcEndpointSubnetAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcEndpointSubnetAssociationCreate,
+		Read:   resourceAwsVpcEndpointSubnetAssociationRead,
+		Delete: resourceAwsVpcEndpointSubnetAssociationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_endpoint_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAwsVpcEndpointSubnetAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	endpointId := d.Get("vpc_endpoint_id").(string)
+	snId := d.Get("subnet_id").(string)
+
+	_, err := findResourceVPCEndpoint(conn, endpointId)
+	if err != nil {
+		return err
+	}
+
+	log.Printf(
+		"[INFO] Creating VPC Endpoint/Subnet association: %s => %s",
+		endpointId, snId)
+
+	// An endpoint can only be modified one change at a time, so concurrent
+	// associations are serialized.
+	awsMutexKV.Lock(endpointId)
+	defer awsMutexKV.Unlock(endpointId)
+
+	input := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId: aws.String(endpointId),
+		AddSubnetIds:  aws.StringSlice([]string{snId}),
+	}
+
+	_, err = conn.ModifyVpcEndpoint(input)
+	if err != nil {
+		return fmt.Errorf("Error creating VPC Endpoint/Subnet association: %s", err.Error())
+	}
+	id := vpcEndpointIdSubnetIdHash(endpointId, snId)
+	log.Printf("[DEBUG] VPC Endpoint/Subnet association %q created.", id)
+
+	d.SetId(id)
+
+	if err := vpcEndpointWaitUntilAvailable(conn, endpointId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceAwsVpcEndpointSubnetAssociationRead(d, meta)
+}
+
+func resourceAwsVpcEndpointSubnetAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	endpointId := d.Get("vpc_endpoint_id").(string)
+	snId := d.Get("subnet_id").(string)
+
+	vpce, err := findResourceVPCEndpoint(conn, endpointId)
+	if err != nil {
+		if err, ok := err.(awserr.Error); ok && err.Code() == "InvalidVpcEndpointId.NotFound" {
+			d.SetId("")
+			return nil
+		}
+
+		return err
+	}
+
+	found := false
+	for _, id := range vpce.SubnetIds {
+		if id != nil && *id == snId {
+			found = true
+			break
+		}
+	}
+	if !found {
+		// The association no longer exists.
+		log.Printf("[WARN] VPC Endpoint/Subnet association %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceAwsVpcEndpointSubnetAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	endpointId := d.Get("vpc_endpoint_id").(string)
+	snId := d.Get("subnet_id").(string)
+
+	awsMutexKV.Lock(endpointId)
+	defer awsMutexKV.Unlock(endpointId)
+
+	input := &ec2.ModifyVpcEndpointInput{
+		VpcEndpointId:   aws.String(endpointId),
+		RemoveSubnetIds: aws.StringSlice([]string{snId}),
+	}
+
+	_, err := conn.ModifyVpcEndpoint(input)
+	if err != nil {
+		ec2err, ok := err.(awserr.Error)
+		if !ok {
+			return fmt.Errorf("Error deleting VPC Endpoint/Subnet association: %s", err.Error())
+		}
+
+		switch ec2err.Code() {
+		case "InvalidVpcEndpointId.NotFound":
+			fallthrough
+		case "InvalidSubnetId.NotFound":
+			fallthrough
+		case "InvalidParameter":
+			log.Printf("[DEBUG] VPC Endpoint/Subnet association is already gone")
+			return nil
+		default:
+			return fmt.Errorf("Error deleting VPC Endpoint/Subnet association: %s", err.Error())
+		}
+	}
+
+	if err := vpcEndpointWaitUntilAvailable(conn, endpointId, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] VPC Endpoint/Subnet association %q deleted", d.Id())
+
+	return nil
+}
+
+func vpcEndpointIdSubnetIdHash(endpointId, snId string) string {
+	return fmt.Sprintf("a-%s%d", endpointId, hashcode.String(snId))
+}
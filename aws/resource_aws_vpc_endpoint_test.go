@@ -82,6 +82,44 @@ func TestAccAWSVpcEndpoint_WithoutRouteTableOrPolicyConfig(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpcEndpoint_interfaceBasic(t *testing.T) {
+	var endpoint ec2.VpcEndpoint
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:      func() { testAccPreCheck(t) },
+		IDRefreshName: "aws_vpc_endpoint.ec2",
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckVpcEndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcEndpointInterfaceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.ec2", &endpoint),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "vpc_endpoint_type", "Interface"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "state", "available"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "route_table_ids.#", "0"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "subnet_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "network_interface_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "private_dns_enabled", "false"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "prefix_list_id", ""),
+					resource.TestCheckResourceAttrSet("aws_vpc_endpoint.ec2", "dns_entry.0.dns_name"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcEndpointInterfaceConfigModified,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcEndpointExists("aws_vpc_endpoint.ec2", &endpoint),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "subnet_ids.#", "2"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "network_interface_ids.#", "2"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "security_group_ids.#", "2"),
+					resource.TestCheckResourceAttr("aws_vpc_endpoint.ec2", "private_dns_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAWSVpcEndpoint_removed(t *testing.T) {
 	var endpoint ec2.VpcEndpoint
 
@@ -136,7 +174,7 @@ func testAccCheckVpcEndpointDestroy(s *terraform.State) error {
 			}
 			return err
 		}
-		if len(resp.VpcEndpoints) > 0 {
+		if len(resp.VpcEndpoints) > 0 && aws.StringValue(resp.VpcEndpoints[0].State) != "deleted" {
 			return fmt.Errorf("VPC Endpoints still exist.")
 		}
 
@@ -305,3 +343,76 @@ resource "aws_vpc_endpoint" "second-private-s3" {
     service_name = "com.amazonaws.us-west-2.s3"
 }
 `
+
+const testAccVpcEndpointInterfaceConfig = `
+resource "aws_vpc" "foo" {
+    cidr_block = "10.0.0.0/16"
+    enable_dns_support = true
+    enable_dns_hostnames = true
+}
+
+resource "aws_subnet" "sn1" {
+    vpc_id = "${aws_vpc.foo.id}"
+    cidr_block = "10.0.0.0/17"
+    availability_zone = "us-west-2a"
+}
+
+resource "aws_subnet" "sn2" {
+    vpc_id = "${aws_vpc.foo.id}"
+    cidr_block = "10.0.128.0/17"
+    availability_zone = "us-west-2b"
+}
+
+resource "aws_security_group" "sg1" {
+    vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_security_group" "sg2" {
+    vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_vpc_endpoint" "ec2" {
+    vpc_id = "${aws_vpc.foo.id}"
+    vpc_endpoint_type = "Interface"
+    service_name = "com.amazonaws.us-west-2.ec2"
+    subnet_ids = ["${aws_subnet.sn1.id}"]
+    security_group_ids = ["${aws_security_group.sg1.id}"]
+}
+`
+
+const testAccVpcEndpointInterfaceConfigModified = `
+resource "aws_vpc" "foo" {
+    cidr_block = "10.0.0.0/16"
+    enable_dns_support = true
+    enable_dns_hostnames = true
+}
+
+resource "aws_subnet" "sn1" {
+    vpc_id = "${aws_vpc.foo.id}"
+    cidr_block = "10.0.0.0/17"
+    availability_zone = "us-west-2a"
+}
+
+resource "aws_subnet" "sn2" {
+    vpc_id = "${aws_vpc.foo.id}"
+    cidr_block = "10.0.128.0/17"
+    availability_zone = "us-west-2b"
+}
+
+resource "aws_security_group" "sg1" {
+    vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_security_group" "sg2" {
+    vpc_id = "${aws_vpc.foo.id}"
+}
+
+resource "aws_vpc_endpoint" "ec2" {
+    vpc_id = "${aws_vpc.foo.id}"
+    vpc_endpoint_type = "Interface"
+    service_name = "com.amazonaws.us-west-2.ec2"
+    subnet_ids = ["${aws_subnet.sn1.id}", "${aws_subnet.sn2.id}"]
+    security_group_ids = ["${aws_security_group.sg1.id}", "${aws_security_group.sg2.id}"]
+    private_dns_enabled = true
+}
+`
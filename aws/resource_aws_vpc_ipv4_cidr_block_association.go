@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsVpcIpv4CidrBlockAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcIpv4CidrBlockAssociationCreate,
+		Read:   resourceAwsVpcIpv4CidrBlockAssociationRead,
+		Delete: resourceAwsVpcIpv4CidrBlockAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCIDRNetworkAddress,
+			},
+		},
+	}
+}
+
+func resourceAwsVpcIpv4CidrBlockAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	vpcId := d.Get("vpc_id").(string)
+
+	req := &ec2.AssociateVpcCidrBlockInput{
+		VpcId:     aws.String(vpcId),
+		CidrBlock: aws.String(d.Get("cidr_block").(string)),
+	}
+	log.Printf("[DEBUG] Creating VPC IPv4 CIDR block association: %#v", req)
+	resp, err := conn.AssociateVpcCidrBlock(req)
+	if err != nil {
+		return fmt.Errorf("Error creating VPC IPv4 CIDR block association: %s", err)
+	}
+
+	d.SetId(aws.StringValue(resp.CidrBlockAssociation.AssociationId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.VpcCidrBlockStateCodeAssociating},
+		Target:     []string{ec2.VpcCidrBlockStateCodeAssociated},
+		Refresh:    vpcIpv4CidrBlockAssociationStateRefresh(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for IPv4 CIDR block association (%s) to become available: %s", d.Id(), err)
+	}
+
+	return resourceAwsVpcIpv4CidrBlockAssociationRead(d, meta)
+}
+
+func resourceAwsVpcIpv4CidrBlockAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	vpcRaw, state, err := vpcIpv4CidrBlockAssociationStateRefresh(conn, d.Id())()
+	if err != nil {
+		return fmt.Errorf("Error reading IPv4 CIDR block association (%s): %s", d.Id(), err)
+	}
+
+	if vpcRaw == nil || state == ec2.VpcCidrBlockStateCodeDisassociated || state == ec2.VpcCidrBlockStateCodeFailed {
+		log.Printf("[WARN] IPv4 CIDR block association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	vpc := vpcRaw.(*ec2.Vpc)
+	d.Set("vpc_id", vpc.VpcId)
+	for _, cidrAssociation := range vpc.CidrBlockAssociationSet {
+		if aws.StringValue(cidrAssociation.AssociationId) == d.Id() {
+			d.Set("cidr_block", cidrAssociation.CidrBlock)
+			break
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsVpcIpv4CidrBlockAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	log.Printf("[DEBUG] Deleting VPC IPv4 CIDR block association: %s", d.Id())
+	_, err := conn.DisassociateVpcCidrBlock(&ec2.DisassociateVpcCidrBlockInput{
+		AssociationId: aws.String(d.Id()),
+	})
+	if err != nil {
+		if isAWSErr(err, "InvalidVpcCidrBlockAssociationID.NotFound", "") {
+			return nil
+		}
+		return fmt.Errorf("Error deleting VPC IPv4 CIDR block association: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.VpcCidrBlockStateCodeDisassociating},
+		Target:     []string{ec2.VpcCidrBlockStateCodeDisassociated},
+		Refresh:    vpcIpv4CidrBlockAssociationStateRefresh(conn, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for VPC IPv4 CIDR block association (%s) to be deleted: %s", d.Id(), err.Error())
+	}
+
+	return nil
+}
+
+// vpcIpv4CidrBlockAssociationStateRefresh watches an IPv4 CIDR block
+// association, returning the VPC it belongs to. Associations which
+// no longer exist are reported as disassociated.
+func vpcIpv4CidrBlockAssociationStateRefresh(conn *ec2.EC2, assocId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeVpcs(&ec2.DescribeVpcsInput{
+			Filters: buildEC2AttributeFilterList(map[string]string{
+				"cidr-block-association.association-id": assocId,
+			}),
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(resp.Vpcs) == 0 {
+			return "", ec2.VpcCidrBlockStateCodeDisassociated, nil
+		}
+
+		vpc := resp.Vpcs[0]
+		for _, cidrAssociation := range vpc.CidrBlockAssociationSet {
+			if aws.StringValue(cidrAssociation.AssociationId) == assocId {
+				return vpc, aws.StringValue(cidrAssociation.CidrBlockState.State), nil
+			}
+		}
+
+		return "", ec2.VpcCidrBlockStateCodeDisassociated, nil
+	}
+}
@@ -16,14 +16,21 @@ import (
 
 func resourceAwsVpcPeeringConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsVPCPeeringCreate,
-		Read:   resourceAwsVPCPeeringRead,
-		Update: resourceAwsVPCPeeringUpdate,
-		Delete: resourceAwsVPCPeeringDelete,
+		Create:        resourceAwsVPCPeeringCreate,
+		Read:          resourceAwsVPCPeeringRead,
+		Update:        resourceAwsVPCPeeringUpdate,
+		Delete:        resourceAwsVPCPeeringDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"peer_owner_id": {
 				Type:     schema.TypeString,
@@ -41,6 +48,12 @@ func resourceAwsVpcPeeringConnection() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"peer_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
 			"auto_accept": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -52,12 +65,14 @@ func resourceAwsVpcPeeringConnection() *schema.Resource {
 			"accepter":  vpcPeeringConnectionOptionsSchema(),
 			"requester": vpcPeeringConnectionOptionsSchema(),
 			"tags":      tagsSchema(),
+			"tags_all":  tagsSchemaAll(),
 		},
 	}
 }
 
 func resourceAwsVPCPeeringCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).ec2conn
+	client := meta.(*AWSClient)
+	conn := client.ec2conn
 
 	// Create the vpc peering connection
 	createOpts := &ec2.CreateVpcPeeringConnectionInput{
@@ -69,6 +84,15 @@ func resourceAwsVPCPeeringCreate(d *schema.ResourceData, meta interface{}) error
 		createOpts.PeerOwnerId = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("peer_region"); ok {
+		// Inter-region connections can only be accepted from the region of
+		// the accepter VPC.
+		if v.(string) != client.region && d.Get("auto_accept").(bool) {
+			return fmt.Errorf("peer_region cannot be set whilst auto_accept is true when creating an inter-region VPC Peering Connection")
+		}
+		createOpts.PeerRegion = aws.String(v.(string))
+	}
+
 	log.Printf("[DEBUG] VPC Peering Create options: %#v", createOpts)
 
 	resp, err := conn.CreateVpcPeeringConnection(createOpts)
@@ -81,18 +105,8 @@ func resourceAwsVPCPeeringCreate(d *schema.ResourceData, meta interface{}) error
 	d.SetId(*rt.VpcPeeringConnectionId)
 	log.Printf("[INFO] VPC Peering Connection ID: %s", d.Id())
 
-	// Wait for the vpc peering connection to become available
-	log.Printf("[DEBUG] Waiting for VPC Peering Connection (%s) to become available.", d.Id())
-	stateConf := &resource.StateChangeConf{
-		Pending: []string{"initiating-request", "provisioning", "pending"},
-		Target:  []string{"pending-acceptance", "active"},
-		Refresh: resourceAwsVPCPeeringConnectionStateRefreshFunc(conn, d.Id()),
-		Timeout: 1 * time.Minute,
-	}
-	if _, err := stateConf.WaitForState(); err != nil {
-		return errwrap.Wrapf(fmt.Sprintf(
-			"Error waiting for VPC Peering Connection (%s) to become available: {{err}}",
-			d.Id()), err)
+	if err := vpcPeeringConnectionWaitUntilAvailable(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
 	}
 
 	return resourceAwsVPCPeeringUpdate(d, meta)
@@ -119,35 +133,28 @@ func resourceAwsVPCPeeringRead(d *schema.ResourceData, meta interface{}) error {
 	// The failed status is a status that we can assume just means the
 	// connection is gone. Destruction isn't allowed, and it eventually
 	// just "falls off" the console. See GH-2322
-	if pc.Status != nil {
-		status := map[string]bool{
-			"deleted":  true,
-			"deleting": true,
-			"expired":  true,
-			"failed":   true,
-			"rejected": true,
-		}
-		if _, ok := status[*pc.Status.Code]; ok {
-			log.Printf("[DEBUG] VPC Peering Connection (%s) in state (%s), removing.",
-				d.Id(), *pc.Status.Code)
-			d.SetId("")
-			return nil
-		}
+	if vpcPeeringConnectionGone(pc) {
+		log.Printf("[DEBUG] VPC Peering Connection (%s) in state (%s), removing.",
+			d.Id(), *pc.Status.Code)
+		d.SetId("")
+		return nil
 	}
 	log.Printf("[DEBUG] VPC Peering Connection response: %#v", pc)
 
 	log.Printf("[DEBUG] Account ID %s, VPC PeerConn Requester %s, Accepter %s",
 		client.accountid, *pc.RequesterVpcInfo.OwnerId, *pc.AccepterVpcInfo.OwnerId)
 
-	if (client.accountid == *pc.AccepterVpcInfo.OwnerId) && (client.accountid != *pc.RequesterVpcInfo.OwnerId) {
+	if vpcPeeringConnectionIsAccepter(client, pc) {
 		// We're the accepter
 		d.Set("peer_owner_id", pc.RequesterVpcInfo.OwnerId)
 		d.Set("peer_vpc_id", pc.RequesterVpcInfo.VpcId)
+		d.Set("peer_region", pc.RequesterVpcInfo.Region)
 		d.Set("vpc_id", pc.AccepterVpcInfo.VpcId)
 	} else {
 		// We're the requester
 		d.Set("peer_owner_id", pc.AccepterVpcInfo.OwnerId)
 		d.Set("peer_vpc_id", pc.AccepterVpcInfo.VpcId)
+		d.Set("peer_region", pc.AccepterVpcInfo.Region)
 		d.Set("vpc_id", pc.RequesterVpcInfo.VpcId)
 	}
 
@@ -170,7 +177,7 @@ func resourceAwsVPCPeeringRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	err = d.Set("tags", tagsToMap(pc.Tags))
+	err = setTagsAll(d, meta, tagsToMap(pc.Tags))
 	if err != nil {
 		return errwrap.Wrapf("Error setting VPC Peering Connection tags: {{err}}", err)
 	}
@@ -201,20 +208,26 @@ func resourceVPCPeeringConnectionOptionsModify(d *schema.ResourceData, meta inte
 		VpcPeeringConnectionId: aws.String(d.Id()),
 	}
 
-	if v, ok := d.GetOk("accepter"); ok {
+	// Only the changed sides are sent, as the options of each side can
+	// only be modified by the account, and from the region, owning it.
+	if v, ok := d.GetOk("accepter"); ok && d.HasChange("accepter") {
 		if s := v.(*schema.Set); len(s.List()) > 0 {
 			co := s.List()[0].(map[string]interface{})
 			modifyOpts.AccepterPeeringConnectionOptions = expandPeeringOptions(co)
 		}
 	}
 
-	if v, ok := d.GetOk("requester"); ok {
+	if v, ok := d.GetOk("requester"); ok && d.HasChange("requester") {
 		if s := v.(*schema.Set); len(s.List()) > 0 {
 			co := s.List()[0].(map[string]interface{})
 			modifyOpts.RequesterPeeringConnectionOptions = expandPeeringOptions(co)
 		}
 	}
 
+	if modifyOpts.AccepterPeeringConnectionOptions == nil && modifyOpts.RequesterPeeringConnectionOptions == nil {
+		return nil
+	}
+
 	log.Printf("[DEBUG] VPC Peering Connection modify options: %#v", modifyOpts)
 	if _, err := conn.ModifyVpcPeeringConnectionOptions(modifyOpts); err != nil {
 		return err
@@ -230,6 +243,7 @@ func resourceAwsVPCPeeringUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	} else {
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	pcRaw, _, err := resourceAwsVPCPeeringConnectionStateRefreshFunc(conn, d.Id())()
@@ -250,6 +264,11 @@ func resourceAwsVPCPeeringUpdate(d *schema.ResourceData, meta interface{}) error
 				return errwrap.Wrapf("Unable to accept VPC Peering Connection: {{err}}", err)
 			}
 			log.Printf("[DEBUG] VPC Peering Connection accept status: %s", status)
+
+			if err := vpcPeeringConnectionWaitUntilActive(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+			pc.Status.Code = aws.String("active")
 		}
 	}
 
@@ -276,8 +295,28 @@ func resourceAwsVPCPeeringDelete(d *schema.ResourceData, meta interface{}) error
 		&ec2.DeleteVpcPeeringConnectionInput{
 			VpcPeeringConnectionId: aws.String(d.Id()),
 		})
+	if err != nil {
+		if isAWSErr(err, "InvalidVpcPeeringConnectionID.NotFound", "") {
+			return nil
+		}
+		return errwrap.Wrapf("Error deleting VPC Peering Connection: {{err}}", err)
+	}
+
+	// Wait for the vpc peering connection to delete
+	log.Printf("[DEBUG] Waiting for VPC Peering Connection (%s) to delete.", d.Id())
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"active", "pending-acceptance", "deleting"},
+		Target:  []string{"rejected", "deleted"},
+		Refresh: vpcPeeringConnectionDeletionRefreshFunc(conn, d.Id()),
+		Timeout: d.Timeout(schema.TimeoutDelete),
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return errwrap.Wrapf(fmt.Sprintf(
+			"Error waiting for VPC Peering Connection (%s) to be deleted: {{err}}",
+			d.Id()), err)
+	}
 
-	return err
+	return nil
 }
 
 // resourceAwsVPCPeeringConnectionStateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
@@ -296,7 +335,7 @@ func resourceAwsVPCPeeringConnectionStateRefreshFunc(conn *ec2.EC2, id string) r
 			}
 		}
 
-		if resp == nil {
+		if resp == nil || len(resp.VpcPeeringConnections) == 0 {
 			// Sometimes AWS just has consistency issues and doesn't see
 			// our instance yet. Return an empty state.
 			return nil, "", nil
@@ -315,6 +354,90 @@ func resourceAwsVPCPeeringConnectionStateRefreshFunc(conn *ec2.EC2, id string) r
 	}
 }
 
+// vpcPeeringConnectionDeletionRefreshFunc returns a resource.StateRefreshFunc
+// that is used to watch a VPC Peering Connection being deleted. Once the
+// connection can no longer be found it is reported as deleted.
+func vpcPeeringConnectionDeletionRefreshFunc(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	refresh := resourceAwsVPCPeeringConnectionStateRefreshFunc(conn, id)
+	return func() (interface{}, string, error) {
+		pc, status, err := refresh()
+		if err == nil && pc == nil {
+			return &ec2.VpcPeeringConnection{}, "deleted", nil
+		}
+		return pc, status, err
+	}
+}
+
+// vpcPeeringConnectionWaitUntilAvailable waits for a newly requested
+// connection to be either pending acceptance or, once accepted, active.
+func vpcPeeringConnectionWaitUntilAvailable(conn *ec2.EC2, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for VPC Peering Connection (%s) to become available.", id)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"initiating-request", "provisioning", "pending"},
+		Target:  []string{"pending-acceptance", "active"},
+		Refresh: resourceAwsVPCPeeringConnectionStateRefreshFunc(conn, id),
+		Timeout: timeout,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return errwrap.Wrapf(fmt.Sprintf(
+			"Error waiting for VPC Peering Connection (%s) to become available: {{err}}",
+			id), err)
+	}
+
+	return nil
+}
+
+// vpcPeeringConnectionWaitUntilActive waits for a connection to be accepted
+// and provisioned. For cross-account connections this may only happen when
+// the accepter's configuration is applied.
+func vpcPeeringConnectionWaitUntilActive(conn *ec2.EC2, id string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for VPC Peering Connection (%s) to become active.", id)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"initiating-request", "pending-acceptance", "provisioning", "pending"},
+		Target:  []string{"active"},
+		Refresh: resourceAwsVPCPeeringConnectionStateRefreshFunc(conn, id),
+		Timeout: timeout,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return errwrap.Wrapf(fmt.Sprintf(
+			"Error waiting for VPC Peering Connection (%s) to become active: {{err}}",
+			id), err)
+	}
+
+	return nil
+}
+
+// vpcPeeringConnectionGone reports whether a connection is in one of the
+// states a connection no longer returns from.
+func vpcPeeringConnectionGone(pc *ec2.VpcPeeringConnection) bool {
+	if pc.Status == nil {
+		return false
+	}
+
+	switch aws.StringValue(pc.Status.Code) {
+	case "deleted", "deleting", "expired", "failed", "rejected":
+		return true
+	}
+	return false
+}
+
+// vpcPeeringConnectionIsAccepter reports whether the client manages the
+// accepter side of a connection, which for inter-region connections within
+// one account is decided by the region.
+func vpcPeeringConnectionIsAccepter(client *AWSClient, pc *ec2.VpcPeeringConnection) bool {
+	accepter, requester := pc.AccepterVpcInfo, pc.RequesterVpcInfo
+	if client.accountid != aws.StringValue(accepter.OwnerId) {
+		return false
+	}
+	if client.accountid != aws.StringValue(requester.OwnerId) {
+		return true
+	}
+
+	return accepter.Region != nil && requester.Region != nil &&
+		aws.StringValue(accepter.Region) != aws.StringValue(requester.Region) &&
+		aws.StringValue(accepter.Region) == client.region
+}
+
 func vpcPeeringConnectionOptionsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -3,6 +3,7 @@ package aws
 import (
 	"errors"
 	"log"
+	"time"
 
 	"fmt"
 
@@ -11,10 +12,20 @@ import (
 
 func resourceAwsVpcPeeringConnectionAccepter() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsVPCPeeringAccepterCreate,
-		Read:   resourceAwsVPCPeeringRead,
-		Update: resourceAwsVPCPeeringUpdate,
-		Delete: resourceAwsVPCPeeringAccepterDelete,
+		Create:        resourceAwsVPCPeeringAccepterCreate,
+		Read:          resourceAwsVPCPeeringRead,
+		Update:        resourceAwsVPCPeeringUpdate,
+		Delete:        resourceAwsVPCPeeringAccepterDelete,
+		CustomizeDiff: setTagsAllDiff,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVPCPeeringAccepterImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_peering_connection_id": &schema.Schema{
@@ -43,13 +54,24 @@ func resourceAwsVpcPeeringConnectionAccepter() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"peer_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"accepter":  vpcPeeringConnectionOptionsSchema(),
 			"requester": vpcPeeringConnectionOptionsSchema(),
 			"tags":      tagsSchema(),
+			"tags_all":  tagsSchemaAll(),
 		},
 	}
 }
 
+func resourceAwsVPCPeeringAccepterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("vpc_peering_connection_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsVPCPeeringAccepterCreate(d *schema.ResourceData, meta interface{}) error {
 	id := d.Get("vpc_peering_connection_id").(string)
 	d.SetId(id)
@@ -61,9 +83,10 @@ func resourceAwsVPCPeeringAccepterCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("VPC Peering Connection %q not found", id)
 	}
 
-	// Ensure that this IS as cross-account VPC peering connection.
-	if d.Get("peer_owner_id").(string) == meta.(*AWSClient).accountid {
-		return errors.New("aws_vpc_peering_connection_accepter can only adopt into management cross-account VPC peering connections")
+	// Ensure that this IS as cross-account or inter-region VPC peering connection.
+	client := meta.(*AWSClient)
+	if d.Get("peer_owner_id").(string) == client.accountid && d.Get("peer_region").(string) == client.region {
+		return errors.New("aws_vpc_peering_connection_accepter can only adopt into management cross-account or inter-region VPC peering connections")
 	}
 
 	return resourceAwsVPCPeeringUpdate(d, meta)
@@ -0,0 +1,117 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsVpcPeeringConnectionOptions() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsVpcPeeringConnectionOptionsCreate,
+		Read:   resourceAwsVpcPeeringConnectionOptionsRead,
+		Update: resourceAwsVpcPeeringConnectionOptionsUpdate,
+		Delete: resourceAwsVpcPeeringConnectionOptionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpcPeeringConnectionOptionsImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"vpc_peering_connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"accepter":  vpcPeeringConnectionOptionsSchema(),
+			"requester": vpcPeeringConnectionOptionsSchema(),
+		},
+	}
+}
+
+func resourceAwsVpcPeeringConnectionOptionsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("vpc_peering_connection_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceAwsVpcPeeringConnectionOptionsCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+	d.SetId(d.Get("vpc_peering_connection_id").(string))
+
+	// Options can only be set once the connection has been accepted, which
+	// for cross-account connections happens outside of this configuration.
+	if err := vpcPeeringConnectionWaitUntilActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	if err := resourceVPCPeeringConnectionOptionsModify(d, meta); err != nil {
+		d.SetId("")
+		return errwrap.Wrapf("Error modifying VPC Peering Connection options: {{err}}", err)
+	}
+
+	return resourceAwsVpcPeeringConnectionOptionsRead(d, meta)
+}
+
+func resourceAwsVpcPeeringConnectionOptionsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).ec2conn
+
+	pcRaw, _, err := resourceAwsVPCPeeringConnectionStateRefreshFunc(conn, d.Id())()
+	if err != nil {
+		return fmt.Errorf("Error reading VPC Peering Connection (%s): %s", d.Id(), err)
+	}
+
+	if pcRaw == nil {
+		log.Printf("[WARN] VPC Peering Connection (%s) not found, removing options from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	pc := pcRaw.(*ec2.VpcPeeringConnection)
+	if vpcPeeringConnectionGone(pc) {
+		log.Printf("[WARN] VPC Peering Connection (%s) in state (%s), removing options from state",
+			d.Id(), *pc.Status.Code)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("vpc_peering_connection_id", pc.VpcPeeringConnectionId)
+
+	if pc.AccepterVpcInfo.PeeringOptions != nil {
+		if err := d.Set("accepter", flattenPeeringOptions(pc.AccepterVpcInfo.PeeringOptions)); err != nil {
+			return errwrap.Wrapf("Error setting VPC Peering Connection accepter information: {{err}}", err)
+		}
+	}
+
+	if pc.RequesterVpcInfo.PeeringOptions != nil {
+		if err := d.Set("requester", flattenPeeringOptions(pc.RequesterVpcInfo.PeeringOptions)); err != nil {
+			return errwrap.Wrapf("Error setting VPC Peering Connection requester information: {{err}}", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAwsVpcPeeringConnectionOptionsUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceVPCPeeringConnectionOptionsModify(d, meta); err != nil {
+		return errwrap.Wrapf("Error modifying VPC Peering Connection options: {{err}}", err)
+	}
+
+	return resourceAwsVpcPeeringConnectionOptionsRead(d, meta)
+}
+
+func resourceAwsVpcPeeringConnectionOptionsDelete(d *schema.ResourceData, meta interface{}) error {
+	// The options are a property of the VPC Peering Connection and are
+	// left as they are, so removing them from state is all that is needed.
+	log.Printf("[WARN] Will not modify VPC Peering Connection (%s) options on delete, removing from state", d.Id())
+
+	return nil
+}
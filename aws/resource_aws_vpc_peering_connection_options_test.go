@@ -0,0 +1,103 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSVpcPeeringConnectionOptions_basic(t *testing.T) {
+	var connection ec2.VpcPeeringConnection
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSVpcPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVpcPeeringConnectionOptionsConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcPeeringConnectionExists("aws_vpc_peering_connection.foo", &connection),
+					resource.TestCheckResourceAttrPair("aws_vpc_peering_connection_options.foo", "vpc_peering_connection_id", "aws_vpc_peering_connection.foo", "id"),
+					resource.TestCheckResourceAttr("aws_vpc_peering_connection_options.foo", "accepter.#", "1"),
+					resource.TestCheckResourceAttr("aws_vpc_peering_connection_options.foo", "requester.#", "1"),
+					testAccCheckAWSVpcPeeringConnectionOptions(
+						"aws_vpc_peering_connection.foo",
+						"accepter",
+						&ec2.VpcPeeringConnectionOptionsDescription{
+							AllowDnsResolutionFromRemoteVpc:            aws.Bool(true),
+							AllowEgressFromLocalClassicLinkToRemoteVpc: aws.Bool(false),
+							AllowEgressFromLocalVpcToRemoteClassicLink: aws.Bool(false),
+						},
+					),
+					testAccCheckAWSVpcPeeringConnectionOptions(
+						"aws_vpc_peering_connection.foo",
+						"requester",
+						&ec2.VpcPeeringConnectionOptionsDescription{
+							AllowDnsResolutionFromRemoteVpc:            aws.Bool(false),
+							AllowEgressFromLocalClassicLinkToRemoteVpc: aws.Bool(true),
+							AllowEgressFromLocalVpcToRemoteClassicLink: aws.Bool(true),
+						},
+					),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVpcPeeringConnectionOptionsConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSVpcPeeringConnectionOptions(
+						"aws_vpc_peering_connection.foo",
+						"accepter",
+						&ec2.VpcPeeringConnectionOptionsDescription{
+							AllowDnsResolutionFromRemoteVpc:            aws.Bool(false),
+							AllowEgressFromLocalClassicLinkToRemoteVpc: aws.Bool(false),
+							AllowEgressFromLocalVpcToRemoteClassicLink: aws.Bool(false),
+						},
+					),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "aws_vpc_peering_connection_options.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccVpcPeeringConnectionOptionsConfig(dnsResolution bool) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "foo" {
+	cidr_block = "10.0.0.0/16"
+	tags {
+		Name = "TestAccAWSVpcPeeringConnectionOptions_basic"
+	}
+}
+
+resource "aws_vpc" "bar" {
+	cidr_block = "10.1.0.0/16"
+	enable_dns_hostnames = true
+}
+
+resource "aws_vpc_peering_connection" "foo" {
+	vpc_id = "${aws_vpc.foo.id}"
+	peer_vpc_id = "${aws_vpc.bar.id}"
+	auto_accept = true
+}
+
+resource "aws_vpc_peering_connection_options" "foo" {
+	vpc_peering_connection_id = "${aws_vpc_peering_connection.foo.id}"
+
+	accepter {
+		allow_remote_vpc_dns_resolution = %t
+	}
+
+	requester {
+		allow_vpc_to_remote_classic_link = true
+		allow_classic_link_to_remote_vpc = true
+	}
+}
+`, dnsResolution)
+}
@@ -13,6 +13,51 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestVpcPeeringConnectionDeletionRefreshFunc(t *testing.T) {
+	cases := []*awsMockResponse{
+		{400, test_ec2_vpcPeeringConnectionNotFound_response, "text/xml"},
+		{200, test_ec2_describeVpcPeeringConnections_empty_response, "text/xml"},
+	}
+
+	for i, response := range cases {
+		ec2Endpoints := []*awsMockEndpoint{
+			&awsMockEndpoint{
+				Request:  &awsMockRequest{"POST", "/", "Action=DescribeVpcPeeringConnections&Version=2016-11-15&VpcPeeringConnectionId.1=pcx-1a2b3c4d"},
+				Response: response,
+			},
+		}
+		closeFunc, sess, err := getMockedAwsApiSession("EC2", ec2Endpoints)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, status, err := vpcPeeringConnectionDeletionRefreshFunc(ec2.New(sess), "pcx-1a2b3c4d")()
+		closeFunc()
+		if err != nil {
+			t.Fatalf("%d: Expected no error, received: %s", i, err)
+		}
+		if status != "deleted" {
+			t.Fatalf("%d: Expected status deleted, got %q", i, status)
+		}
+	}
+}
+
+var test_ec2_vpcPeeringConnectionNotFound_response = `<?xml version="1.0" encoding="UTF-8"?>
+<Response>
+  <Errors>
+    <Error>
+      <Code>InvalidVpcPeeringConnectionID.NotFound</Code>
+      <Message>The vpcPeeringConnection ID 'pcx-1a2b3c4d' does not exist</Message>
+    </Error>
+  </Errors>
+  <RequestID>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</RequestID>
+</Response>`
+
+var test_ec2_describeVpcPeeringConnections_empty_response = `<DescribeVpcPeeringConnectionsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
+  <vpcPeeringConnectionSet/>
+</DescribeVpcPeeringConnectionsResponse>`
+
 func TestAccAWSVPCPeeringConnection_basic(t *testing.T) {
 	var connection ec2.VpcPeeringConnection
 
@@ -206,6 +251,21 @@ func TestAccAWSVPCPeeringConnection_failedState(t *testing.T) {
 	})
 }
 
+func TestAccAWSVPCPeeringConnection_peerRegionAndAutoAccept(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:        func() { testAccPreCheck(t) },
+		IDRefreshIgnore: []string{"auto_accept"},
+		Providers:       testAccProviders,
+		CheckDestroy:    testAccCheckAWSVpcPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccVpcPeeringConfigRegionAutoAccept,
+				ExpectError: regexp.MustCompile(`peer_region cannot be set whilst auto_accept is true`),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSVpcPeeringConnectionDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).ec2conn
 
@@ -402,3 +462,33 @@ resource "aws_vpc_peering_connection" "foo" {
 	peer_vpc_id = "${aws_vpc.bar.id}"
 }
 `
+
+const testAccVpcPeeringConfigRegionAutoAccept = `
+provider "aws" {
+	region = "us-west-2"
+}
+
+provider "aws" {
+	alias = "peer"
+	region = "us-east-1"
+}
+
+resource "aws_vpc" "foo" {
+	cidr_block = "10.0.0.0/16"
+	tags {
+		Name = "TestAccAWSVPCPeeringConnection_peerRegionAndAutoAccept"
+	}
+}
+
+resource "aws_vpc" "bar" {
+	provider = "aws.peer"
+	cidr_block = "10.1.0.0/16"
+}
+
+resource "aws_vpc_peering_connection" "foo" {
+	vpc_id = "${aws_vpc.foo.id}"
+	peer_vpc_id = "${aws_vpc.bar.id}"
+	peer_region = "us-east-1"
+	auto_accept = true
+}
+`
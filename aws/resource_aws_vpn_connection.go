@@ -60,10 +60,11 @@ func (slice XmlVpnConnectionConfig) Swap(i, j int) {
 
 func resourceAwsVpnConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsVpnConnectionCreate,
-		Read:   resourceAwsVpnConnectionRead,
-		Update: resourceAwsVpnConnectionUpdate,
-		Delete: resourceAwsVpnConnectionDelete,
+		Create:        resourceAwsVpnConnectionCreate,
+		Read:          resourceAwsVpnConnectionRead,
+		Update:        resourceAwsVpnConnectionUpdate,
+		Delete:        resourceAwsVpnConnectionDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -94,7 +95,8 @@ func resourceAwsVpnConnection() *schema.Resource {
 				ForceNew: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 
 			// Begin read only attributes
 			"customer_gateway_configuration": {
@@ -352,7 +354,7 @@ func resourceAwsVpnConnectionRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("vpn_gateway_id", vpnConnection.VpnGatewayId)
 	d.Set("customer_gateway_id", vpnConnection.CustomerGatewayId)
 	d.Set("type", vpnConnection.Type)
-	d.Set("tags", tagsToMap(vpnConnection.Tags))
+	setTagsAll(d, meta, tagsToMap(vpnConnection.Tags))
 
 	if vpnConnection.Options != nil {
 		if err := d.Set("static_routes_only", vpnConnection.Options.StaticRoutesOnly); err != nil {
@@ -404,6 +406,7 @@ func resourceAwsVpnConnectionUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	return resourceAwsVpnConnectionRead(d, meta)
 }
@@ -22,6 +22,10 @@ func resourceAwsVpnConnectionRoute() *schema.Resource {
 		Read:   resourceAwsVpnConnectionRouteRead,
 		Delete: resourceAwsVpnConnectionRouteDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"destination_cidr_block": &schema.Schema{
 				Type:     schema.TypeString,
@@ -90,8 +94,12 @@ func resourceAwsVpnConnectionRouteRead(d *schema.ResourceData, meta interface{})
 	if route == nil {
 		// Something other than terraform eliminated the route.
 		d.SetId("")
+		return nil
 	}
 
+	d.Set("destination_cidr_block", cidrBlock)
+	d.Set("vpn_connection_id", vpnConnectionId)
+
 	return nil
 }
 
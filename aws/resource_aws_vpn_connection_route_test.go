@@ -31,6 +31,11 @@ func TestAccAWSVpnConnectionRoute_basic(t *testing.T) {
 					),
 				),
 			},
+			resource.TestStep{
+				ResourceName:      "aws_vpn_connection_route.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			resource.TestStep{
 				Config: testAccAwsVpnConnectionRouteConfigUpdate(rBgpAsn),
 				Check: resource.ComposeTestCheckFunc(
@@ -14,10 +14,11 @@ import (
 
 func resourceAwsVpnGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAwsVpnGatewayCreate,
-		Read:   resourceAwsVpnGatewayRead,
-		Update: resourceAwsVpnGatewayUpdate,
-		Delete: resourceAwsVpnGatewayDelete,
+		Create:        resourceAwsVpnGatewayCreate,
+		Read:          resourceAwsVpnGatewayRead,
+		Update:        resourceAwsVpnGatewayUpdate,
+		Delete:        resourceAwsVpnGatewayDelete,
+		CustomizeDiff: setTagsAllDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -35,7 +36,8 @@ func resourceAwsVpnGateway() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
 		},
 	}
 }
@@ -98,7 +100,7 @@ func resourceAwsVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	if vpnGateway.AvailabilityZone != nil && *vpnGateway.AvailabilityZone != "" {
 		d.Set("availability_zone", vpnGateway.AvailabilityZone)
 	}
-	d.Set("tags", tagsToMap(vpnGateway.Tags))
+	setTagsAll(d, meta, tagsToMap(vpnGateway.Tags))
 
 	return nil
 }
@@ -123,6 +125,7 @@ func resourceAwsVpnGatewayUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.SetPartial("tags")
+	d.SetPartial("tags_all")
 
 	return resourceAwsVpnGatewayRead(d, meta)
 }
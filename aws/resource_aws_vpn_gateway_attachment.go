@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,10 @@ func resourceAwsVpnGatewayAttachment() *schema.Resource {
 		Read:   resourceAwsVpnGatewayAttachmentRead,
 		Delete: resourceAwsVpnGatewayAttachmentDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpnGatewayAttachmentImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpc_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -34,6 +39,20 @@ func resourceAwsVpnGatewayAttachment() *schema.Resource {
 	}
 }
 
+func resourceAwsVpnGatewayAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected VPC-ID/VPN-GATEWAY-ID", d.Id())
+	}
+
+	vpcId, vgwId := idParts[0], idParts[1]
+	d.Set("vpc_id", vpcId)
+	d.Set("vpn_gateway_id", vgwId)
+	d.SetId(vpnGatewayAttachmentId(vpcId, vgwId))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsVpnGatewayAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -34,6 +34,13 @@ func TestAccAWSVpnGatewayAttachment_basic(t *testing.T) {
 						&vpc, &vgw),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:      "aws_vpn_gateway_attachment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccAWSVpnGatewayAttachmentImportStateIdFunc("aws_vpn_gateway_attachment.test"),
+			},
 		},
 	})
 }
@@ -161,3 +168,14 @@ resource "aws_vpn_gateway_attachment" "test" {
 	vpn_gateway_id = "${aws_vpn_gateway.test.id}"
 }
 `
+
+func testAccAWSVpnGatewayAttachmentImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["vpc_id"], rs.Primary.Attributes["vpn_gateway_id"]), nil
+	}
+}
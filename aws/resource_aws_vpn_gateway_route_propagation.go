@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -15,6 +16,10 @@ func resourceAwsVpnGatewayRoutePropagation() *schema.Resource {
 		Read:   resourceAwsVpnGatewayRoutePropagationRead,
 		Delete: resourceAwsVpnGatewayRoutePropagationDisable,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsVpnGatewayRoutePropagationImport,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -30,6 +35,18 @@ func resourceAwsVpnGatewayRoutePropagation() *schema.Resource {
 	}
 }
 
+func resourceAwsVpnGatewayRoutePropagationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "_", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected VPN-GATEWAY-ID_ROUTE-TABLE-ID", d.Id())
+	}
+
+	d.Set("vpn_gateway_id", idParts[0])
+	d.Set("route_table_id", idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsVpnGatewayRoutePropagationEnable(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
 
@@ -18,6 +18,10 @@ func resourceAwsWafByteMatchSet() *schema.Resource {
 		Update: resourceAwsWafByteMatchSetUpdate,
 		Delete: resourceAwsWafByteMatchSetDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -43,6 +43,12 @@ func TestAccAWSWafByteMatchSet_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_waf_byte_match_set.byte_set", "byte_match_tuples.839525137.text_transformation", "NONE"),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:      "aws_waf_byte_match_set.byte_set",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
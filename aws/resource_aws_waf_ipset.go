@@ -17,6 +17,10 @@ func resourceAwsWafIPSet() *schema.Resource {
 		Update: resourceAwsWafIPSetUpdate,
 		Delete: resourceAwsWafIPSetDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -17,6 +17,10 @@ func resourceAwsWafSizeConstraintSet() *schema.Resource {
 		Update: resourceAwsWafSizeConstraintSetUpdate,
 		Delete: resourceAwsWafSizeConstraintSetDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
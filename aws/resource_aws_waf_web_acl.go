@@ -17,6 +17,10 @@ func resourceAwsWafWebAcl() *schema.Resource {
 		Update: resourceAwsWafWebAclUpdate,
 		Delete: resourceAwsWafWebAclDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
@@ -135,6 +139,9 @@ func resourceAwsWafWebAclRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("name", resp.WebACL.Name)
 	d.Set("metric_name", resp.WebACL.MetricName)
+	if err := d.Set("rules", flattenWafWebAclRules(resp.WebACL.Rules)); err != nil {
+		return fmt.Errorf("error setting rules: %s", err)
+	}
 
 	return nil
 }
@@ -240,3 +247,20 @@ func flattenDefaultAction(n *waf.WafAction) []map[string]interface{} {
 	m.SetString("type", n.Type)
 	return m.MapList()
 }
+
+func flattenWafWebAclRules(ts []*waf.ActivatedRule) []interface{} {
+	out := make([]interface{}, len(ts), len(ts))
+	for i, r := range ts {
+		m := make(map[string]interface{})
+		m["action"] = []interface{}{
+			map[string]interface{}{
+				"type": *r.Action.Type,
+			},
+		}
+		m["priority"] = int(*r.Priority)
+		m["rule_id"] = *r.RuleId
+		m["type"] = *r.Type
+		out[i] = m
+	}
+	return out
+}
@@ -18,6 +18,10 @@ func resourceAwsWafXssMatchSet() *schema.Resource {
 		Update: resourceAwsWafXssMatchSetUpdate,
 		Delete: resourceAwsWafXssMatchSetDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
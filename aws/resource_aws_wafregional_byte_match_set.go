@@ -18,6 +18,10 @@ func resourceAwsWafRegionalByteMatchSet() *schema.Resource {
 		Update: resourceAwsWafRegionalByteMatchSetUpdate,
 		Delete: resourceAwsWafRegionalByteMatchSetDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:     schema.TypeString,
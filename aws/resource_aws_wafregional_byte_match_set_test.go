@@ -53,6 +53,12 @@ func TestAccAWSWafRegionalByteMatchSet_basic(t *testing.T) {
 						"aws_wafregional_byte_match_set.byte_set", "byte_match_tuple.839525137.text_transformation", "NONE"),
 				),
 			},
+
+			resource.TestStep{
+				ResourceName:      "aws_wafregional_byte_match_set.byte_set",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
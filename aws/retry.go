@@ -0,0 +1,163 @@
+package aws
+
+import (
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+const (
+	defaultRetryMinBackoff = 30 * time.Millisecond
+	defaultRetryMaxBackoff = 5 * time.Minute
+)
+
+// awsRetryer backs off exponentially between minBackoff and maxBackoff,
+// deferring the decision whether to retry at all to the SDK's
+// DefaultRetryer.
+type awsRetryer struct {
+	client.DefaultRetryer
+	minBackoff time.Duration
+	maxBackoff time.Duration
+}
+
+func newAwsRetryer(maxRetries int, minBackoff, maxBackoff time.Duration) awsRetryer {
+	if minBackoff <= 0 {
+		minBackoff = defaultRetryMinBackoff
+	}
+	if maxBackoff <= 0 {
+		maxBackoff = defaultRetryMaxBackoff
+	}
+
+	return awsRetryer{
+		DefaultRetryer: client.DefaultRetryer{NumMaxRetries: maxRetries},
+		minBackoff:     minBackoff,
+		maxBackoff:     maxBackoff,
+	}
+}
+
+// RetryRules returns a delay between half and all of the current backoff,
+// so that concurrent requests being throttled spread out their retries.
+func (r awsRetryer) RetryRules(req *request.Request) time.Duration {
+	backoff := r.minBackoff
+	for i := 0; i < req.RetryCount && backoff < r.maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > r.maxBackoff {
+		backoff = r.maxBackoff
+	}
+
+	half := int64(backoff / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// retryableErrorCodesHandler returns a Retry handler marking requests as
+// retryable when they failed with one of the error codes configured for
+// their service. The service is identified by its endpoint prefix,
+// e.g. "route53" or "ec2".
+func retryableErrorCodesHandler(codes map[string][]string) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform.RetryableErrorCodesHandler",
+		Fn: func(r *request.Request) {
+			err, ok := r.Error.(awserr.Error)
+			if !ok || err == nil {
+				return
+			}
+			for _, code := range codes[r.ClientInfo.ServiceName] {
+				if err.Code() == code {
+					r.Retryable = aws.Bool(true)
+					return
+				}
+			}
+		},
+	}
+}
+
+// retryReadOperationsOnCode returns a Retry handler marking Describe* and
+// List* requests that failed with the given error code as retryable.
+func retryReadOperationsOnCode(code string) func(*request.Request) {
+	return func(r *request.Request) {
+		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
+			return
+		}
+		err, ok := r.Error.(awserr.Error)
+		if !ok || err == nil {
+			return
+		}
+		if err.Code() == code {
+			r.Retryable = aws.Bool(true)
+		}
+	}
+}
+
+// tokenBucket is a client-side rate limiter shared by all API clients.
+// Tokens are reserved ahead of time, so callers are served in order even
+// when the bucket is empty.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+		now:    time.Now,
+		sleep:  time.Sleep,
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller
+// has to wait before the token becomes available.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// Wait blocks until a token is available.
+func (b *tokenBucket) Wait() {
+	if d := b.reserve(); d > 0 {
+		b.sleep(d)
+	}
+}
+
+// handler returns a Send handler which waits for a token before every
+// attempt of a request, including retries.
+func (b *tokenBucket) handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "terraform.TokenBucketHandler",
+		Fn: func(r *request.Request) {
+			b.Wait()
+		},
+	}
+}
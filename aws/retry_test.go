@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestAwsRetryerRetryRules(t *testing.T) {
+	r := newAwsRetryer(10, 100*time.Millisecond, time.Second)
+
+	cases := []struct {
+		RetryCount int
+		Min        time.Duration
+		Max        time.Duration
+	}{
+		{0, 50 * time.Millisecond, 100 * time.Millisecond},
+		{1, 100 * time.Millisecond, 200 * time.Millisecond},
+		{3, 400 * time.Millisecond, 800 * time.Millisecond},
+		{4, 500 * time.Millisecond, time.Second},
+		{50, 500 * time.Millisecond, time.Second},
+	}
+
+	for _, tc := range cases {
+		for i := 0; i < 100; i++ {
+			d := r.RetryRules(&request.Request{RetryCount: tc.RetryCount})
+			if d < tc.Min || d > tc.Max {
+				t.Fatalf("retry %d: expected delay between %s and %s, got %s", tc.RetryCount, tc.Min, tc.Max, d)
+			}
+		}
+	}
+
+	if r.MaxRetries() != 10 {
+		t.Fatalf("expected 10 max retries, got %d", r.MaxRetries())
+	}
+}
+
+func TestRetryableErrorCodesHandler(t *testing.T) {
+	handler := retryableErrorCodesHandler(map[string][]string{
+		"route53": []string{"PriorRequestNotComplete"},
+	})
+
+	cases := []struct {
+		Service   string
+		Err       error
+		Retryable bool
+	}{
+		{"route53", awserr.New("PriorRequestNotComplete", "", nil), true},
+		{"route53", awserr.New("InvalidInput", "", nil), false},
+		{"iam", awserr.New("PriorRequestNotComplete", "", nil), false},
+		{"route53", errors.New("PriorRequestNotComplete"), false},
+	}
+
+	for _, tc := range cases {
+		r := &request.Request{
+			ClientInfo: metadata.ClientInfo{ServiceName: tc.Service},
+			Error:      tc.Err,
+		}
+		handler.Fn(r)
+		if aws.BoolValue(r.Retryable) != tc.Retryable {
+			t.Fatalf("%s %s: expected retryable %t", tc.Service, tc.Err, tc.Retryable)
+		}
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Unix(0, 0)
+	var slept time.Duration
+
+	b := newTokenBucket(2, 2)
+	b.last = now
+	b.now = func() time.Time { return now }
+	b.sleep = func(d time.Duration) {
+		slept += d
+		now = now.Add(d)
+	}
+
+	// the burst is served immediately
+	b.Wait()
+	b.Wait()
+	if slept != 0 {
+		t.Fatalf("expected burst to be served without waiting, waited %s", slept)
+	}
+
+	// every further request waits for its token at 2 per second
+	b.Wait()
+	if slept != 500*time.Millisecond {
+		t.Fatalf("expected to wait 500ms, waited %s", slept)
+	}
+
+	// tokens accumulate up to the burst while idle
+	now = now.Add(time.Minute)
+	slept = 0
+	b.Wait()
+	b.Wait()
+	b.Wait()
+	if slept != 500*time.Millisecond {
+		t.Fatalf("expected to wait 500ms after idling, waited %s", slept)
+	}
+}
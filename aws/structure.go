@@ -148,6 +148,31 @@ func expandEcsLoadBalancers(configured []interface{}) []*ecs.LoadBalancer {
 	return loadBalancers
 }
 
+// Expands the network_configuration block of an ECS service into an
+// ecs.NetworkConfiguration for tasks using the awsvpc network mode
+func expandEcsNetworkConfiguration(configured []interface{}) *ecs.NetworkConfiguration {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+
+	awsVpcConfig := &ecs.AwsVpcConfiguration{
+		Subnets:        expandStringSet(data["subnets"].(*schema.Set)),
+		AssignPublicIp: aws.String(ecs.AssignPublicIpDisabled),
+	}
+
+	if v, ok := data["security_groups"]; ok && v.(*schema.Set).Len() > 0 {
+		awsVpcConfig.SecurityGroups = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := data["assign_public_ip"]; ok && v.(bool) {
+		awsVpcConfig.AssignPublicIp = aws.String(ecs.AssignPublicIpEnabled)
+	}
+
+	return &ecs.NetworkConfiguration{AwsvpcConfiguration: awsVpcConfig}
+}
+
 // Takes the result of flatmap.Expand for an array of ingress/egress security
 // group rules and returns EC2 API compatible objects. This function will error
 // if it finds invalid permissions input, namely a protocol of "-1" with either
@@ -614,6 +639,101 @@ func flattenEcsLoadBalancers(list []*ecs.LoadBalancer) []map[string]interface{}
 	return result
 }
 
+// Expands the service_registries block of an ECS service
+func expandEcsServiceRegistries(configured []interface{}) []*ecs.ServiceRegistry {
+	if len(configured) == 0 {
+		return nil
+	}
+
+	registries := make([]*ecs.ServiceRegistry, 0, len(configured))
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+
+		r := &ecs.ServiceRegistry{
+			RegistryArn: aws.String(data["registry_arn"].(string)),
+		}
+
+		if v, ok := data["port"]; ok && v.(int) != 0 {
+			r.Port = aws.Int64(int64(v.(int)))
+		}
+		if v, ok := data["container_name"]; ok && v.(string) != "" {
+			r.ContainerName = aws.String(v.(string))
+		}
+		if v, ok := data["container_port"]; ok && v.(int) != 0 {
+			r.ContainerPort = aws.Int64(int64(v.(int)))
+		}
+
+		registries = append(registries, r)
+	}
+
+	return registries
+}
+
+// Flattens an array of ECS ServiceRegistries into the service_registries block
+func flattenEcsServiceRegistries(list []*ecs.ServiceRegistry) []interface{} {
+	result := make([]interface{}, 0, len(list))
+	for _, r := range list {
+		m := map[string]interface{}{
+			"registry_arn": aws.StringValue(r.RegistryArn),
+		}
+
+		if r.Port != nil {
+			m["port"] = aws.Int64Value(r.Port)
+		}
+		if r.ContainerName != nil {
+			m["container_name"] = aws.StringValue(r.ContainerName)
+		}
+		if r.ContainerPort != nil {
+			m["container_port"] = aws.Int64Value(r.ContainerPort)
+		}
+
+		result = append(result, m)
+	}
+	return result
+}
+
+// Expands the deployment_controller block of an ECS service
+func expandEcsDeploymentController(configured []interface{}) *ecs.DeploymentController {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	data := configured[0].(map[string]interface{})
+
+	return &ecs.DeploymentController{
+		Type: aws.String(data["type"].(string)),
+	}
+}
+
+// Flattens an ECS DeploymentController into the deployment_controller block
+func flattenEcsDeploymentController(dc *ecs.DeploymentController) []interface{} {
+	if dc == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type": aws.StringValue(dc.Type),
+		},
+	}
+}
+
+// Flattens an ECS NetworkConfiguration into the network_configuration block
+func flattenEcsNetworkConfiguration(nc *ecs.NetworkConfiguration) []interface{} {
+	if nc == nil || nc.AwsvpcConfiguration == nil {
+		return nil
+	}
+
+	config := nc.AwsvpcConfiguration
+	result := map[string]interface{}{
+		"subnets":          schema.NewSet(schema.HashString, flattenStringList(config.Subnets)),
+		"security_groups":  schema.NewSet(schema.HashString, flattenStringList(config.SecurityGroups)),
+		"assign_public_ip": aws.StringValue(config.AssignPublicIp) == ecs.AssignPublicIpEnabled,
+	}
+
+	return []interface{}{result}
+}
+
 // Encodes an array of ecs.ContainerDefinitions into a JSON string
 func flattenEcsContainerDefinitions(definitions []*ecs.ContainerDefinition) (string, error) {
 	b, err := jsonutil.BuildJSON(definitions)
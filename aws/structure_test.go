@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/kinesis"
@@ -1322,3 +1323,41 @@ func TestNormalizeCloudFormationTemplate(t *testing.T) {
 		t.Fatalf("Got:\n\n%s\n\nExpected:\n\n%s\n", actual, validNormalizedYaml)
 	}
 }
+
+func TestExpandEcsServiceRegistries(t *testing.T) {
+	expanded := []interface{}{
+		map[string]interface{}{
+			"registry_arn":   "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-abcdefghijklmnop",
+			"port":           0,
+			"container_name": "web",
+			"container_port": 8080,
+		},
+	}
+
+	registries := expandEcsServiceRegistries(expanded)
+
+	expected := []*ecs.ServiceRegistry{
+		{
+			RegistryArn:   aws.String("arn:aws:servicediscovery:us-west-2:123456789012:service/srv-abcdefghijklmnop"),
+			ContainerName: aws.String("web"),
+			ContainerPort: aws.Int64(8080),
+		},
+	}
+
+	if !reflect.DeepEqual(registries, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", registries, expected)
+	}
+
+	flattened := flattenEcsServiceRegistries(registries)
+	if len(flattened) != 1 {
+		t.Fatalf("Expected 1 service registry, got %d", len(flattened))
+	}
+
+	m := flattened[0].(map[string]interface{})
+	if _, ok := m["port"]; ok {
+		t.Fatalf("Expected no port to be set, got %#v", m["port"])
+	}
+	if m["container_port"] != int64(8080) {
+		t.Fatalf("Expected container_port 8080, got %#v", m["container_port"])
+	}
+}
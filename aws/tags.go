@@ -2,7 +2,8 @@ package aws
 
 import (
 	"log"
-	"regexp"
+	"reflect"
+	"runtime"
 	"strings"
 	"time"
 
@@ -32,9 +33,101 @@ func tagsSchemaComputed() *schema.Schema {
 	}
 }
 
+// tagsSchemaAll returns the schema to use for tags_all, the effective set of
+// tags on a resource once the provider default_tags have been merged in.
+func tagsSchemaAll() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+}
+
+// mergeDefaultTags returns the provider default tags overlaid with the
+// resource's own tags. Resource tags win on conflicting keys.
+func mergeDefaultTags(defaultTags, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaultTags)+len(tags))
+	for k, v := range defaultTags {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// removeDefaultTags strips from the remote tags any key that is only present
+// because of the provider default_tags, so that it is not reported back as a
+// resource tag. Keys that are also set in the resource's own tags are kept.
+func removeDefaultTags(defaultTags, tags map[string]interface{}, remote map[string]string) map[string]string {
+	result := make(map[string]string, len(remote))
+	for k, v := range remote {
+		if dv, ok := defaultTags[k]; ok && dv.(string) == v {
+			if _, ok := tags[k]; !ok {
+				continue
+			}
+		}
+		result[k] = v
+	}
+
+	return result
+}
+
+// setTagsAllDiff is a CustomizeDiffFunc for resources with a tags_all
+// attribute. It only plans a change to tags_all when the merged set of
+// resource and default tags differs from what is in state.
+func setTagsAllDiff(d *schema.ResourceDiff, meta interface{}) error {
+	tags, ok := resourceDiffTags(d)
+	if !ok {
+		return d.SetNewComputed("tags_all")
+	}
+
+	all := mergeDefaultTags(meta.(*AWSClient).defaultTags, tags)
+	if reflect.DeepEqual(d.Get("tags_all").(map[string]interface{}), all) {
+		return nil
+	}
+
+	return d.SetNew("tags_all", all)
+}
+
+// resourceDiffTags returns the tags of an in-flight diff and whether they are
+// fully known. Absent tags read as an empty map. The diff reader panics on a
+// map that still holds unresolved interpolations, and the element count reads
+// empty for such a map just as it does for absent tags, so the failed type
+// assertion is what marks the tags as unknown.
+func resourceDiffTags(d *schema.ResourceDiff) (tags map[string]interface{}, known bool) {
+	if v := d.Get("tags.%").(string); v != "" {
+		return d.Get("tags").(map[string]interface{}), true
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(*runtime.TypeAssertionError); !ok {
+				panic(r)
+			}
+			tags, known = nil, false
+		}
+	}()
+
+	return d.Get("tags").(map[string]interface{}), true
+}
+
+// setTagsAll is a helper to set tags and tags_all on a resource from the
+// tags found on the remote resource, less any the provider ignores.
+func setTagsAll(d *schema.ResourceData, meta interface{}, remote map[string]string) error {
+	defaultTags := meta.(*AWSClient).defaultTags
+	remote = meta.(*AWSClient).ignoreTagsConfig.filter(remote)
+	tags := removeDefaultTags(defaultTags, d.Get("tags").(map[string]interface{}), remote)
+	if err := d.Set("tags", tags); err != nil {
+		return err
+	}
+
+	return d.Set("tags_all", remote)
+}
+
 func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffElbV2Tags(tagsFromMapELBv2(o), tagsFromMapELBv2(n))
@@ -127,10 +220,10 @@ func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 }
 
 // setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
+// merged tags field to be named "tags_all"
 func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
@@ -279,38 +372,90 @@ func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
 	return result
 }
 
-// tagIgnored compares a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnored(t *ec2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
+// tagsIgnoreConfig is the provider ignore_tags configuration: tag keys that
+// are managed outside of Terraform and so are neither read into state nor
+// removed on update.
+type tagsIgnoreConfig struct {
+	Keys        map[string]bool
+	KeyPrefixes []string
+}
+
+func newTagsIgnoreConfig(keys, keyPrefixes []interface{}) *tagsIgnoreConfig {
+	c := &tagsIgnoreConfig{
+		Keys:        make(map[string]bool, len(keys)),
+		KeyPrefixes: make([]string, 0, len(keyPrefixes)),
+	}
+	for _, k := range keys {
+		c.Keys[k.(string)] = true
+	}
+	for _, p := range keyPrefixes {
+		c.KeyPrefixes = append(c.KeyPrefixes, p.(string))
+	}
+
+	return c
+}
+
+// ignored checks if a tag key matches the ignore_tags configuration. A nil
+// configuration ignores nothing.
+func (c *tagsIgnoreConfig) ignored(k string) bool {
+	if c == nil {
+		return false
+	}
+
+	if c.Keys[k] {
+		log.Printf("[DEBUG] Found tag %s in ignore_tags keys, ignoring.\n", k)
+		return true
+	}
+	for _, prefix := range c.KeyPrefixes {
+		if strings.HasPrefix(k, prefix) {
+			log.Printf("[DEBUG] Found tag %s matching ignore_tags key prefix %s, ignoring.\n", k, prefix)
 			return true
 		}
 	}
+
 	return false
 }
 
-// and for ELBv2 as well
-func tagIgnoredELBv2(t *elbv2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
+// filter returns the tags whose keys are not ignored.
+func (c *tagsIgnoreConfig) filter(tags map[string]string) map[string]string {
+	result := make(map[string]string, len(tags))
+	for k, v := range tags {
+		if !c.ignored(k) {
+			result[k] = v
 		}
 	}
+
+	return result
+}
+
+// tagIgnoredKey checks if a tag key is reserved by AWS and should be ignored.
+func tagIgnoredKey(k string) bool {
+	if strings.HasPrefix(k, "aws:") {
+		log.Printf("[DEBUG] Found AWS specific tag %s, ignoring.\n", k)
+		return true
+	}
+
 	return false
 }
 
+// tagIgnored compares a tag against a list of strings and checks if it should
+// be ignored or not
+func tagIgnored(t *ec2.Tag) bool {
+	return tagIgnoredKey(*t.Key)
+}
+
+// and for ELBv2 as well
+func tagIgnoredELBv2(t *elbv2.Tag) bool {
+	return tagIgnoredKey(*t.Key)
+}
+
 // tagsToMapDynamoDb turns the list of tags into a map for dynamoDB
 func tagsToMapDynamoDb(ts []*dynamodb.Tag) map[string]string {
 	result := make(map[string]string)
 	for _, t := range ts {
-		result[*t.Key] = *t.Value
+		if !tagIgnoredKey(*t.Key) {
+			result[*t.Key] = *t.Value
+		}
 	}
 	return result
 }
@@ -323,7 +468,9 @@ func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
 		}
-		result = append(result, t)
+		if !tagIgnoredKey(k) {
+			result = append(result, t)
+		}
 	}
 	return result
 }
@@ -333,9 +480,9 @@ func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
 // method from the ec2 tag resource handling. Also the `UntagResource` method
 // for dynamoDB only requires a list of tag keys, instead of the full map of keys.
 func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		arn := d.Get("arn").(string)
-		oraw, nraw := d.GetChange("tags")
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsDynamoDb(tagsFromMapDynamoDb(o), tagsFromMapDynamoDb(n))
@@ -9,8 +9,8 @@ import (
 )
 
 func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsCloudFront(tagsFromMapCloudFront(o), tagsFromMapCloudFront(n))
@@ -74,6 +74,9 @@ func diffTagsCloudFront(oldTags, newTags *cloudfront.Tags) ([]*cloudfront.Tag, [
 func tagsFromMapCloudFront(m map[string]interface{}) *cloudfront.Tags {
 	result := make([]*cloudfront.Tag, 0, len(m))
 	for k, v := range m {
+		if tagIgnoredKey(k) {
+			continue
+		}
 		result = append(result, &cloudfront.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
@@ -91,7 +94,9 @@ func tagsToMapCloudFront(ts *cloudfront.Tags) map[string]string {
 	result := make(map[string]string)
 
 	for _, t := range ts.Items {
-		result[*t.Key] = *t.Value
+		if !tagIgnoredKey(*t.Key) {
+			result[*t.Key] = *t.Value
+		}
 	}
 
 	return result
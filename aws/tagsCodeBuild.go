@@ -1,9 +1,6 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codebuild"
 )
@@ -34,10 +31,13 @@ func diffTagsCodeBuild(oldTags, newTags []*codebuild.Tag) ([]*codebuild.Tag, []*
 func tagsFromMapCodeBuild(m map[string]interface{}) []*codebuild.Tag {
 	result := []*codebuild.Tag{}
 	for k, v := range m {
-		result = append(result, &codebuild.Tag{
+		t := &codebuild.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
-		})
+		}
+		if !tagIgnoredCodeBuild(t) {
+			result = append(result, t)
+		}
 	}
 
 	return result
@@ -46,7 +46,9 @@ func tagsFromMapCodeBuild(m map[string]interface{}) []*codebuild.Tag {
 func tagsToMapCodeBuild(ts []*codebuild.Tag) map[string]string {
 	result := map[string]string{}
 	for _, t := range ts {
-		result[*t.Key] = *t.Value
+		if !tagIgnoredCodeBuild(t) {
+			result[*t.Key] = *t.Value
+		}
 	}
 
 	return result
@@ -55,13 +57,5 @@ func tagsToMapCodeBuild(ts []*codebuild.Tag) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredCodeBuild(t *codebuild.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredKey(*t.Key)
 }
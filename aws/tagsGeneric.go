@@ -1,9 +1,6 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 )
 
@@ -14,12 +11,17 @@ func diffTagsGeneric(oldTags, newTags map[string]interface{}) (map[string]*strin
 	// First, we're creating everything we have
 	create := make(map[string]*string)
 	for k, v := range newTags {
-		create[k] = aws.String(v.(string))
+		if !tagIgnoredGeneric(k) {
+			create[k] = aws.String(v.(string))
+		}
 	}
 
 	// Build the map of what to remove
 	remove := make(map[string]*string)
 	for k, v := range oldTags {
+		if tagIgnoredGeneric(k) {
+			continue
+		}
 		old, ok := create[k]
 		if !ok || old != aws.String(v.(string)) {
 			// Delete it!
@@ -57,13 +59,5 @@ func tagsToMapGeneric(ts map[string]*string) map[string]string {
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredGeneric(k string) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, k)
-		if r, _ := regexp.MatchString(v, k); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s, ignoring.\n", k)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredKey(k)
 }
@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -11,10 +10,10 @@ import (
 )
 
 // setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
+// merged tags field to be named "tags_all"
 func setTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTagsRDS(tagsFromMapRDS(o), tagsFromMapRDS(n))
@@ -101,7 +100,7 @@ func tagsToMapRDS(ts []*rds.Tag) map[string]string {
 	return result
 }
 
-func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
+func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, meta interface{}, arn string) error {
 	resp, err := conn.ListTagsForResource(&rds.ListTagsForResourceInput{
 		ResourceName: aws.String(arn),
 	})
@@ -115,19 +114,11 @@ func saveTagsRDS(conn *rds.RDS, d *schema.ResourceData, arn string) error {
 		dt = resp.TagList
 	}
 
-	return d.Set("tags", tagsToMapRDS(dt))
+	return setTagsAll(d, meta, tagsToMapRDS(dt))
 }
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredRDS(t *rds.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagIgnoredKey(*t.Key)
 }
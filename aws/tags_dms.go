@@ -10,7 +10,9 @@ func dmsTagsToMap(tags []*dms.Tag) map[string]string {
 	result := make(map[string]string)
 
 	for _, tag := range tags {
-		result[*tag.Key] = *tag.Value
+		if !tagIgnoredKey(*tag.Key) {
+			result[*tag.Key] = *tag.Value
+		}
 	}
 
 	return result
@@ -20,6 +22,9 @@ func dmsTagsFromMap(m map[string]interface{}) []*dms.Tag {
 	result := make([]*dms.Tag, 0, len(m))
 
 	for k, v := range m {
+		if tagIgnoredKey(k) {
+			continue
+		}
 		result = append(result, &dms.Tag{
 			Key:   aws.String(k),
 			Value: aws.String(v.(string)),
@@ -59,8 +64,8 @@ func dmsGetTagKeys(tags []*dms.Tag) []*string {
 func dmsSetTags(arn string, d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dmsconn
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 
@@ -3,11 +3,14 @@ package aws
 import (
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -80,6 +83,271 @@ func TestIgnoringTags(t *testing.T) {
 	}
 }
 
+func TestIgnoringTagsConfigured(t *testing.T) {
+	c := newTagsIgnoreConfig(
+		[]interface{}{"LastScanned"},
+		[]interface{}{"kubernetes.io/"},
+	)
+
+	cases := map[string]bool{
+		"LastScanned":                 true,
+		"kubernetes.io/cluster/test":  true,
+		"Name":                        false,
+		"LastScannedBy":               false,
+		"example.com/kubernetes.io/x": false,
+	}
+	for k, expected := range cases {
+		if c.ignored(k) != expected {
+			t.Fatalf("Tag %q: expected ignored to be %t", k, expected)
+		}
+	}
+
+	tags := c.filter(map[string]string{
+		"Name":        "foo",
+		"LastScanned": "yesterday",
+	})
+	if len(tags) != 1 || tags["Name"] != "foo" {
+		t.Fatalf("Unexpected tags: %#v", tags)
+	}
+
+	var none *tagsIgnoreConfig
+	if none.ignored("LastScanned") {
+		t.Fatal("Expected a nil ignore_tags configuration to ignore nothing")
+	}
+}
+
+func TestSetTagsAllIgnoresConfiguredTags(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
+		},
+	}
+	d := r.TestResourceData()
+	meta := &AWSClient{
+		ignoreTagsConfig: newTagsIgnoreConfig([]interface{}{"LastScanned"}, nil),
+	}
+
+	err := setTagsAll(d, meta, map[string]string{
+		"Name":        "foo",
+		"LastScanned": "yesterday",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]interface{}{"Name": "foo"}
+	for _, k := range []string{"tags", "tags_all"} {
+		if actual := d.Get(k).(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("%s: expected %#v, got %#v", k, expected, actual)
+		}
+	}
+}
+
+func TestMergeDefaultTags(t *testing.T) {
+	cases := []struct {
+		Default, Tags, Expected map[string]interface{}
+	}{
+		{
+			Default:  map[string]interface{}{},
+			Tags:     map[string]interface{}{"foo": "bar"},
+			Expected: map[string]interface{}{"foo": "bar"},
+		},
+		{
+			Default:  map[string]interface{}{"owner": "platform"},
+			Tags:     map[string]interface{}{"foo": "bar"},
+			Expected: map[string]interface{}{"owner": "platform", "foo": "bar"},
+		},
+		// Resource tags take precedence
+		{
+			Default:  map[string]interface{}{"owner": "platform"},
+			Tags:     map[string]interface{}{"owner": "data"},
+			Expected: map[string]interface{}{"owner": "data"},
+		},
+		{
+			Default:  nil,
+			Tags:     nil,
+			Expected: map[string]interface{}{},
+		},
+	}
+
+	for i, tc := range cases {
+		actual := mergeDefaultTags(tc.Default, tc.Tags)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: expected %#v, got %#v", i, tc.Expected, actual)
+		}
+	}
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	cases := []struct {
+		Default, Tags map[string]interface{}
+		Remote        map[string]string
+		Expected      map[string]string
+	}{
+		{
+			Default:  map[string]interface{}{"owner": "platform"},
+			Tags:     map[string]interface{}{"foo": "bar"},
+			Remote:   map[string]string{"owner": "platform", "foo": "bar"},
+			Expected: map[string]string{"foo": "bar"},
+		},
+		// A default tag modified outside of Terraform is reported
+		{
+			Default:  map[string]interface{}{"owner": "platform"},
+			Tags:     map[string]interface{}{},
+			Remote:   map[string]string{"owner": "data"},
+			Expected: map[string]string{"owner": "data"},
+		},
+		// A resource tag with the same value as a default is kept
+		{
+			Default:  map[string]interface{}{"owner": "platform"},
+			Tags:     map[string]interface{}{"owner": "platform"},
+			Remote:   map[string]string{"owner": "platform"},
+			Expected: map[string]string{"owner": "platform"},
+		},
+	}
+
+	for i, tc := range cases {
+		actual := removeDefaultTags(tc.Default, tc.Tags, tc.Remote)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: expected %#v, got %#v", i, tc.Expected, actual)
+		}
+	}
+}
+
+func TestSetTagsAllDiff(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags":     tagsSchema(),
+			"tags_all": tagsSchemaAll(),
+		},
+		CustomizeDiff: setTagsAllDiff,
+	}
+	meta := &AWSClient{
+		defaultTags: map[string]interface{}{"owner": "platform"},
+	}
+
+	cases := []struct {
+		State    map[string]string
+		Config   interface{}
+		Expected map[string]string
+	}{
+		// New tags merged with the defaults
+		{
+			State:  map[string]string{},
+			Config: map[string]interface{}{"Name": "foo"},
+			Expected: map[string]string{
+				"tags_all.%":     "2",
+				"tags_all.Name":  "foo",
+				"tags_all.owner": "platform",
+			},
+		},
+		// No change when the merged set is already in state
+		{
+			State: map[string]string{
+				"tags.%":         "1",
+				"tags.Name":      "foo",
+				"tags_all.%":     "2",
+				"tags_all.Name":  "foo",
+				"tags_all.owner": "platform",
+			},
+			Config:   map[string]interface{}{"Name": "foo"},
+			Expected: map[string]string{},
+		},
+		// A default tag removed outside of Terraform is restored
+		{
+			State: map[string]string{
+				"tags.%":        "1",
+				"tags.Name":     "foo",
+				"tags_all.%":    "1",
+				"tags_all.Name": "foo",
+			},
+			Config: map[string]interface{}{"Name": "foo"},
+			Expected: map[string]string{
+				"tags_all.%":     "2",
+				"tags_all.owner": "platform",
+			},
+		},
+		// Defaults alone apply once the resource has no tags of its own
+		{
+			State: map[string]string{
+				"tags.%":        "1",
+				"tags.Name":     "foo",
+				"tags_all.%":    "1",
+				"tags_all.Name": "foo",
+			},
+			Config: map[string]interface{}{},
+			Expected: map[string]string{
+				"tags_all.Name":  "",
+				"tags_all.owner": "platform",
+			},
+		},
+		// A new resource without tags gets the defaults
+		{
+			State:  map[string]string{},
+			Config: nil,
+			Expected: map[string]string{
+				"tags_all.%":     "1",
+				"tags_all.owner": "platform",
+			},
+		},
+		// As does one with an empty tags map
+		{
+			State:  map[string]string{},
+			Config: map[string]interface{}{},
+			Expected: map[string]string{
+				"tags_all.%":     "1",
+				"tags_all.owner": "platform",
+			},
+		},
+		// Unknown tag values leave tags_all computed
+		{
+			State:  map[string]string{},
+			Config: map[string]interface{}{"Name": config.UnknownVariableValue},
+			Expected: map[string]string{
+				"tags_all.%": "",
+			},
+		},
+		// As does an unknown tags map
+		{
+			State:  map[string]string{},
+			Config: config.UnknownVariableValue,
+			Expected: map[string]string{
+				"tags_all.%": "",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		raw := map[string]interface{}{}
+		if tc.Config != nil {
+			raw["tags"] = tc.Config
+		}
+		rawConfig, err := config.NewRawConfig(raw)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		state := &terraform.InstanceState{ID: "foo", Attributes: tc.State}
+
+		diff, err := r.Diff(state, terraform.NewResourceConfig(rawConfig), meta)
+		if err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+
+		actual := make(map[string]string)
+		if diff != nil {
+			for k, v := range diff.Attributes {
+				if strings.HasPrefix(k, "tags_all.") {
+					actual[k] = v.New
+				}
+			}
+		}
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: expected %#v, got %#v", i, tc.Expected, actual)
+		}
+	}
+}
+
 // testAccCheckTags can be used to check the tags on a resource.
 func testAccCheckTags(
 	ts *[]*ec2.Tag, key string, value string) resource.TestCheckFunc {
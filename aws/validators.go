@@ -308,6 +308,20 @@ func validateIntegerInRange(min, max int) schema.SchemaValidateFunc {
 	}
 }
 
+func validateIntegerInSlice(valid []int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(int)
+		for _, i := range valid {
+			if value == i {
+				return
+			}
+		}
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of %v: %d", k, valid, value))
+		return
+	}
+}
+
 func validateCloudWatchEventTargetId(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if len(value) > 64 {
@@ -1972,3 +1986,44 @@ func validateDxConnectionBandWidth(v interface{}, k string) (ws []string, errors
 	errors = append(errors, fmt.Errorf("expected %s to be one of %v, got %s", k, validBandWidth, val))
 	return
 }
+
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be parsed as a duration: %s", k, err))
+	}
+	if duration < 0 {
+		errors = append(errors, fmt.Errorf(
+			"%q must not be negative", k))
+	}
+	return
+}
+
+func validateLaunchTemplateName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 3 {
+		errors = append(errors, fmt.Errorf("%q cannot be shorter than 3 characters", k))
+	} else if len(value) > 128 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 128 characters", k))
+	}
+	if !regexp.MustCompile(`^[0-9a-zA-Z()./_\-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only alphanumeric characters, periods, parentheses, forward slashes, underscores and hyphens allowed in %q", k))
+	}
+	return
+}
+
+func validateEksClusterName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if len(value) < 1 {
+		errors = append(errors, fmt.Errorf("%q cannot be empty", k))
+	} else if len(value) > 100 {
+		errors = append(errors, fmt.Errorf("%q cannot be longer than 100 characters", k))
+	}
+	if !regexp.MustCompile(`^[0-9a-zA-Z][0-9a-zA-Z_\-]*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must start with an alphanumeric character and only contain alphanumeric characters, underscores and hyphens", k))
+	}
+	return
+}
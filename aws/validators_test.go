@@ -754,6 +754,24 @@ func TestValidateIntegerInRange(t *testing.T) {
 	}
 }
 
+func TestValidateIntegerInSlice(t *testing.T) {
+	valid := []int{60, 600}
+	for _, v := range valid {
+		_, errors := validateIntegerInSlice(valid)(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be one of %v: %q", v, valid, errors)
+		}
+	}
+
+	invalidIntegers := []int{-60, 0, 61, 3600}
+	for _, v := range invalidIntegers {
+		_, errors := validateIntegerInSlice(valid)(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%d should not be one of %v", v, valid)
+		}
+	}
+}
+
 func TestResourceAWSElastiCacheClusterIdValidation(t *testing.T) {
 	cases := []struct {
 		Value    string
@@ -2772,3 +2790,89 @@ func TestValidateCognitoUserPoolReplyEmailAddress(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateDuration(t *testing.T) {
+	validValues := []string{
+		"0s",
+		"500ms",
+		"1m30s",
+	}
+
+	for _, s := range validValues {
+		_, errors := validateDuration(s, "timeout")
+		if len(errors) > 0 {
+			t.Fatalf("%q should be a valid duration: %v", s, errors)
+		}
+	}
+
+	invalidValues := []string{
+		"",
+		"10",
+		"-5s",
+		"one minute",
+	}
+
+	for _, s := range invalidValues {
+		_, errors := validateDuration(s, "timeout")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid duration", s)
+		}
+	}
+}
+
+func TestValidateLaunchTemplateName(t *testing.T) {
+	validNames := []string{
+		"tf-launch-template",
+		"web_server(1.0)",
+		"team/app",
+		strings.Repeat("W", 128),
+	}
+	for _, v := range validNames {
+		_, errors := validateLaunchTemplateName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Launch Template name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"ab",
+		"web server",
+		"tf:launch:template",
+		strings.Repeat("W", 129),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateLaunchTemplateName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Launch Template name", v)
+		}
+	}
+}
+
+func TestValidateEksClusterName(t *testing.T) {
+	validNames := []string{
+		"tf-acc-test",
+		"1cluster",
+		"my_cluster",
+		strings.Repeat("W", 100),
+	}
+	for _, v := range validNames {
+		_, errors := validateEksClusterName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid EKS cluster name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"-cluster",
+		"my cluster",
+		"my.cluster",
+		strings.Repeat("W", 101),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateEksClusterName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid EKS cluster name", v)
+		}
+	}
+}
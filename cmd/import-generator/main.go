@@ -0,0 +1,144 @@
+// Command import-generator enumerates existing AWS resources in a region
+// and writes matching Terraform configuration, "terraform import" commands
+// and a state file, so that a legacy account can be adopted without
+// hand-writing each resource block first.
+//
+// Credentials are resolved exactly as the provider resolves them.
+//
+//	go run ./cmd/import-generator -region us-west-2 -types aws_vpc,aws_subnet \
+//	    -tag Environment=production -out ./adopted
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/logging"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-aws/aws"
+)
+
+// keyValueFlag collects repeated KEY=VALUE flags.
+type keyValueFlag map[string]string
+
+func (f keyValueFlag) String() string {
+	var parts []string
+	for k, v := range f {
+		parts = append(parts, k+"="+v)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (f keyValueFlag) Set(s string) error {
+	parts := strings.SplitN(s, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return fmt.Errorf("expected KEY=VALUE, got %q", s)
+	}
+	f[parts[0]] = parts[1]
+	return nil
+}
+
+func main() {
+	region := flag.String("region", os.Getenv("AWS_DEFAULT_REGION"), "AWS region to enumerate")
+	profile := flag.String("profile", "", "shared credentials profile to use")
+	types := flag.String("types", "", "comma separated resource types to enumerate (default all supported)")
+	out := flag.String("out", ".", "directory to write imported.tf, import.sh and terraform.tfstate to")
+	list := flag.Bool("list", false, "list the supported resource types and exit")
+	filters := keyValueFlag{}
+	flag.Var(filters, "filter", "EC2 filter NAME=VALUE to restrict the enumeration (repeatable)")
+	tags := keyValueFlag{}
+	flag.Var(tags, "tag", "tag KEY=VALUE that enumerated resources must carry (repeatable)")
+	flag.Parse()
+
+	if *list {
+		for _, t := range aws.ImportGeneratorResourceTypes() {
+			fmt.Println(t)
+		}
+		return
+	}
+
+	logOutput, err := logging.LogOutput()
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.SetOutput(logOutput)
+
+	if err := run(*region, *profile, *types, *out, filters, tags); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(region, profile, types, out string, filters, tags map[string]string) error {
+	if region == "" {
+		return fmt.Errorf("-region is required")
+	}
+
+	raw := map[string]interface{}{
+		"region": region,
+	}
+	if profile != "" {
+		raw["profile"] = profile
+	}
+	rc, err := config.NewRawConfig(raw)
+	if err != nil {
+		return err
+	}
+
+	p := aws.Provider().(*schema.Provider)
+	if err := p.Configure(terraform.NewResourceConfig(rc)); err != nil {
+		return err
+	}
+
+	opts := aws.ImportGeneratorOptions{
+		Filters: filters,
+		Tags:    tags,
+	}
+	if types != "" {
+		opts.ResourceTypes = strings.Split(types, ",")
+	}
+
+	imports, err := aws.GenerateImports(p, opts)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(out, 0755); err != nil {
+		return err
+	}
+	files := []struct {
+		name  string
+		write func(io.Writer) error
+	}{
+		{"imported.tf", func(w io.Writer) error { return aws.WriteImportConfig(w, p, imports) }},
+		{"import.sh", func(w io.Writer) error { return aws.WriteImportCommands(w, imports) }},
+		{"terraform.tfstate", func(w io.Writer) error { return aws.WriteImportState(w, imports) }},
+	}
+	for _, f := range files {
+		if err := writeFile(filepath.Join(out, f.name), f.write); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Generated %d resources in %s\n", len(imports), out)
+	return nil
+}
+
+func writeFile(path string, write func(io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return fmt.Errorf("Error writing %s: %s", path, err)
+	}
+	return f.Close()
+}